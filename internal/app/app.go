@@ -21,10 +21,15 @@ import (
 	appmetrics "psa/internal/metrics"
 	"psa/internal/repository/postgresql"
 	"psa/internal/repository/redis"
+	"psa/internal/service/alias"
+	"psa/internal/service/archive"
+	"psa/internal/service/area"
 	"psa/internal/service/auth"
 	"psa/internal/service/cron"
 	"psa/internal/service/extractor"
+	"psa/internal/service/preview"
 	"psa/internal/service/provider"
+	"psa/internal/service/runner"
 	"psa/internal/service/scraper"
 	"psa/pkg/httpserver"
 	"psa/pkg/jwtmanager"
@@ -54,10 +59,30 @@ func Run(cfg *config.Config, log *slog.Logger) error {
 	})
 
 	// external services
-	hhClient := hh.NewAdapter(cfg, log)
+	hhClient := hh.NewAdapter(cfg, log, cache)
+
+	sources, err := vacancySources(cfg.Scraping, hhClient)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	extractorMode, err := extractor.ParseMode(cfg.Scraping.ExtractorMode)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
 	// services
-	skillExtractor := extractor.New()
+	skillExtractor := extractor.New(extractorMode)
+	skillAliases := alias.New(db)
+
+	// with the queue professions are scraped by the worker (cmd/worker), the API only enqueues them
+	var (
+		scrapingJobs scraper.JobQueue
+		runJobs      runner.JobProvider
+	)
+	if cfg.Scraping.Queue {
+		scrapingJobs, runJobs = db, db
+	}
 
 	scraping := scraper.New(
 		db,
@@ -65,17 +90,28 @@ func Run(cfg *config.Config, log *slog.Logger) error {
 		db,
 		db,
 		db,
-		hhClient,
+		db,
+		sources,
 		skillExtractor,
+		skillAliases,
 		cache,
+		cfg.Scraping.Areas,
+		cfg.Scraping.Concurrency,
+		scrapingJobs,
 	)
 
-	cronScheduler, err := cron.New(log, scraping)
+	scrapingRunner := runner.New(db, db, runJobs, cfg.Scraping.RunTimeout)
+
+	areaDictionary := area.New(hhClient, db)
+
+	cronScheduler, err := cron.New(log, scraping, scrapingRunner, areaDictionary, cfg.Scraping.RunTimeout)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	professionProvider := provider.New(db, db, db, db, cache, db)
+	vacancyArchive := archive.New(db, db, db)
+	queryPreview := preview.New(hhClient)
 
 	// health checks
 	healthChecks := []health.Check{
@@ -103,14 +139,24 @@ func Run(cfg *config.Config, log *slog.Logger) error {
 	// HTTP handlers v1
 	authPublicHandler := public.NewAuthHandler(authUC)
 	professionPublicHandler := public.NewProfessionHandler(professionProvider)
-	professionAdminHandler := admin.NewProfessionAdminHandler(professionProvider, scraping)
+	professionAdminHandler := admin.NewProfessionAdminHandler(professionProvider, scraping, scrapingRunner, queryPreview)
 	trendHandler := public.NewTrendHandler(professionProvider)
+	skillHandler := public.NewSkillHandler(professionProvider)
+	vacancyAdminHandler := admin.NewVacancyAdminHandler(vacancyArchive)
+	areaHandler := public.NewAreaHandler(areaDictionary)
+	scrapingRunAdminHandler := admin.NewScrapingRunAdminHandler(scrapingRunner)
+	skillAliasAdminHandler := admin.NewSkillAliasAdminHandler(skillAliases)
 
 	httpHandlers := controllerhttp.V1Handlers{
 		AuthPublic:       authPublicHandler,
 		ProfessionPublic: professionPublicHandler,
 		ProfessionAdmin:  professionAdminHandler,
 		Trend:            trendHandler,
+		Skill:            skillHandler,
+		VacancyAdmin:     vacancyAdminHandler,
+		Area:             areaHandler,
+		ScrapingRunAdmin: scrapingRunAdminHandler,
+		SkillAliasAdmin:  skillAliasAdminHandler,
 	}
 	metricsRegistry := appmetrics.NewRegistry()
 	httpMetrics := appmetrics.NewHTTPMetrics(metricsRegistry)
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"psa/internal/config"
+	"psa/internal/domain"
+	"psa/internal/integration/file"
+	"psa/internal/integration/hh"
+	"psa/internal/service/scraper"
+)
+
+// vacancySources builds the vacancy sources enabled in the config, in the configured order.
+func vacancySources(cfg config.Scraping, hhClient *hh.Adapter) ([]scraper.SupplierPort, error) {
+	registry := map[string]func() (scraper.SupplierPort, error){
+		domain.SourceHH: func() (scraper.SupplierPort, error) {
+			return hhClient, nil
+		},
+		domain.SourceFile: func() (scraper.SupplierPort, error) {
+			if cfg.FilePath == "" {
+				return nil, errors.New("file_path is not set")
+			}
+			return file.New(cfg.FilePath)
+		},
+	}
+
+	sources := make([]scraper.SupplierPort, 0, len(cfg.Sources))
+	enabled := make(map[string]bool, len(cfg.Sources))
+	for _, name := range cfg.Sources {
+		name = strings.TrimSpace(name)
+		if enabled[name] {
+			continue
+		}
+
+		newSource, ok := registry[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown vacancy source: %q", name)
+		}
+
+		source, err := newSource()
+		if err != nil {
+			return nil, fmt.Errorf("vacancy source %s: %w", name, err)
+		}
+
+		sources = append(sources, source)
+		enabled[name] = true
+	}
+
+	if len(sources) == 0 {
+		return nil, errors.New("no vacancy sources enabled")
+	}
+
+	return sources, nil
+}
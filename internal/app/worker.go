@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"psa/internal/app/closer"
+	"psa/internal/config"
+	"psa/internal/integration/hh"
+	"psa/internal/repository/postgresql"
+	"psa/internal/repository/redis"
+	"psa/internal/service/alias"
+	"psa/internal/service/extractor"
+	"psa/internal/service/scraper"
+	"psa/internal/service/worker"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+// RunWorker consumes the scraping job queue until SIGINT or SIGTERM. Jobs are processed by cfg.Scraping.Concurrency
+// goroutines; the hh.ru rate limit is shared by them and is per worker process.
+func RunWorker(cfg *config.Config, log *slog.Logger) error {
+	const op = "app.RunWorker"
+
+	if err := validateJobTimeouts(cfg.Scraping); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// infrastructure
+	db, err := postgresql.New(cfg.StoragePath)
+	if err != nil {
+		return fmt.Errorf("init storage: %w", err)
+	}
+	closer.Add("db", func(ctx context.Context) error {
+		// pgxpool.Close does not accept context; timeout is handled by closer.
+		db.Close()
+		return nil
+	})
+
+	cache, err := redis.New(cfg.Redis)
+	if err != nil {
+		return fmt.Errorf("init redis: %w", err)
+	}
+	closer.Add("cache", func(ctx context.Context) error {
+		return cache.Close()
+	})
+
+	// external services
+	hhClient := hh.NewAdapter(cfg, log, cache)
+
+	sources, err := vacancySources(cfg.Scraping, hhClient)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	extractorMode, err := extractor.ParseMode(cfg.Scraping.ExtractorMode)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// services: the worker processes jobs itself, so its scraper has no queue
+	scraping := scraper.New(
+		db,
+		db,
+		db,
+		db,
+		db,
+		db,
+		sources,
+		extractor.New(extractorMode),
+		alias.New(db),
+		cache,
+		cfg.Scraping.Areas,
+		cfg.Scraping.Concurrency,
+		nil,
+	)
+
+	jobWorker := worker.New(db, db, db, scraping, cfg.Scraping.Concurrency,
+		cfg.Scraping.JobTimeout, cfg.Scraping.JobLeaseTimeout)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	jobWorker.Run(loggerctx.WithLogger(ctx, log.With("component", "worker")))
+
+	// Second SIGINT → immediate exit (no graceful shutdown).
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		<-sig
+		os.Exit(1)
+	}()
+
+	closeCtx, closeCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer closeCancel()
+
+	if err = closer.CloseAll(closeCtx, log); err != nil {
+		log.Error("resource_close_failed", slogx.Err(err))
+	}
+
+	log.Info("app_stopped", "reason", "signal")
+	return nil
+}
+
+// validateJobTimeouts checks that an attempt of a job outlasts the hh.ru budgets of its profession and that its lease
+// outlasts the attempt, so that a job is neither cut short nor claimed by another worker while it runs.
+func validateJobTimeouts(cfg config.Scraping) error {
+	budget := cfg.ProfessionTimeout + cfg.RateLimitWaitTimeout
+	if cfg.JobTimeout < budget {
+		return fmt.Errorf("job_timeout %s is shorter than profession_timeout + rate_limit_wait_timeout %s",
+			cfg.JobTimeout, budget)
+	}
+	if cfg.JobLeaseTimeout <= cfg.JobTimeout {
+		return fmt.Errorf("job_lease_timeout %s is not longer than job_timeout %s", cfg.JobLeaseTimeout, cfg.JobTimeout)
+	}
+	return nil
+}
@@ -14,9 +14,10 @@ type Config struct {
 	StoragePath StoragePath `yaml:"storage_path"`
 	HTTPServer  HTTPServer  `yaml:"http_server"`
 	HHAuth      HHAuth
-	HHRetry     HHRetry `yaml:"hh_retry"`
-	Redis       Redis   `yaml:"redis"`
-	JWT         JWT     `yaml:"jwt"`
+	HHRetry     HHRetry  `yaml:"hh_retry"`
+	Scraping    Scraping `yaml:"scraping"`
+	Redis       Redis    `yaml:"redis"`
+	JWT         JWT      `yaml:"jwt"`
 }
 
 type HTTPServer struct {
@@ -66,6 +67,31 @@ type HHRetry struct {
 	MaxTotalTime time.Duration `yaml:"max_total_time" env-default:"45s"`
 }
 
+type Scraping struct {
+	// hh.ru area IDs every active profession is scraped for, e.g. 113 (Russia), 1 (Moscow), 2 (Saint Petersburg)
+	Areas []string `yaml:"areas" env:"SCRAPING_AREAS" env-separator:"," env-default:"113"`
+	// Vacancy sources every profession is scraped from and merged: hh (hh.ru API), file (a local dump at FilePath)
+	Sources []string `yaml:"sources" env:"SCRAPING_SOURCES" env-separator:"," env-default:"hh"`
+	// JSONL (.jsonl, .ndjson) or CSV (.csv) vacancy dump read by the file source
+	FilePath string `yaml:"file_path" env:"SCRAPING_FILE_PATH"`
+	// Professions (profession and area pairs) scraped at once. hh.ru requests of all of them share one rate limit
+	Concurrency int `yaml:"concurrency" env:"SCRAPING_CONCURRENCY" env-default:"1"`
+	// Hand professions over to the worker (cmd/worker) through the Postgres job queue instead of scraping them in the API
+	Queue bool `yaml:"queue" env:"SCRAPING_QUEUE" env-default:"false"`
+	// What an extracted skill count means: mentions (every mention in descriptions) or documents (vacancies mentioning it)
+	ExtractorMode string `yaml:"extractor_mode" env:"SCRAPING_EXTRACTOR_MODE" env-default:"mentions"`
+	// Time the hh.ru fetch of a profession in an area may spend working, not counting the wait for the rate limit
+	ProfessionTimeout time.Duration `yaml:"profession_timeout" env:"SCRAPING_PROFESSION_TIMEOUT" env-default:"15m"`
+	// Time the hh.ru fetch of a profession may spend waiting for the rate limit shared with the concurrent professions
+	RateLimitWaitTimeout time.Duration `yaml:"rate_limit_wait_timeout" env:"SCRAPING_RATE_LIMIT_WAIT_TIMEOUT" env-default:"15m"`
+	// Time a scraping run, started from the API or by the schedule, may take; a full archive run scrapes every profession and area
+	RunTimeout time.Duration `yaml:"run_timeout" env:"SCRAPING_RUN_TIMEOUT" env-default:"12h"`
+	// Time the worker may spend on an attempt of a queued job; not shorter than profession_timeout + rate_limit_wait_timeout
+	JobTimeout time.Duration `yaml:"job_timeout" env:"SCRAPING_JOB_TIMEOUT" env-default:"45m"`
+	// Time after which a running queued job of a crashed worker is claimed again; longer than job_timeout
+	JobLeaseTimeout time.Duration `yaml:"job_lease_timeout" env:"SCRAPING_JOB_LEASE_TIMEOUT" env-default:"90m"`
+}
+
 type JWT struct {
 	Secret          string        `env:"JWT_SECRET" env-required:"true"`
 	AccessTokenTTL  time.Duration `yaml:"access_token_ttl" env:"JWT_ACCESS_TOKEN_TTL" env-default:"15m"`
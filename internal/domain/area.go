@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// DefaultArea is the hh.ru area of the whole of Russia. It is used when no area is configured or requested.
+const DefaultArea = "113"
+
+// ProfessionArea identifies data of a profession collected for one area.
+type ProfessionArea struct {
+	ProfessionID uuid.UUID `json:"profession_id"`
+	Area         string    `json:"area"`
+}
+
+// Area is an entry of the hh.ru areas dictionary: a country, a region or a city.
+// ParentID is empty for countries.
+type Area struct {
+	ID       string `json:"id"`
+	ParentID string `json:"parent_id"`
+	Name     string `json:"name"`
+}
@@ -0,0 +1,27 @@
+package domain
+
+// Breakdown dimensions and the values used in derived shares. Values are hh.ru dictionary identifiers,
+// except for the source dimension whose values are vacancy source names.
+const (
+	BreakdownExperience = "experience"
+	BreakdownEmployment = "employment"
+	BreakdownSchedule   = "schedule"
+	BreakdownSource     = "source"
+
+	ExperienceBetween3And6 = "between3And6"
+	ScheduleRemote         = "remote"
+)
+
+type BreakdownItem struct {
+	Value string `json:"value"`
+	Count int32  `json:"count"`
+}
+
+// VacancyBreakdown is a distribution of vacancies by required experience, employment type, schedule and source.
+// Items are sorted by count, the most frequent first; vacancies without a value are not counted.
+type VacancyBreakdown struct {
+	Experience []BreakdownItem `json:"experience"`
+	Employment []BreakdownItem `json:"employment"`
+	Schedule   []BreakdownItem `json:"schedule"`
+	Source     []BreakdownItem `json:"source"`
+}
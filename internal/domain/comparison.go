@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidComparison = errors.New("invalid profession comparison")
+)
+
+type ComparedProfession struct {
+	ProfessionID   uuid.UUID       `json:"profession_id"`
+	ProfessionName string          `json:"profession_name"`
+	VacancyCount   int32           `json:"vacancy_count"`
+	TopSkills      []SkillResponse `json:"top_skills"`
+}
+
+// ComparisonTrendPoint holds vacancy counts of all compared professions for one day.
+// A profession without data for the day is absent from VacancyCounts.
+type ComparisonTrendPoint struct {
+	Date          time.Time           `json:"date"`
+	VacancyCounts map[uuid.UUID]int32 `json:"vacancy_counts"`
+}
+
+// SkillOverlap is a skill found in the top skills of at least two compared professions.
+type SkillOverlap struct {
+	Skill  string              `json:"skill"`
+	Counts map[uuid.UUID]int32 `json:"counts"`
+}
+
+type ProfessionComparison struct {
+	Area         string                 `json:"area"`
+	ScrapedAt    time.Time              `json:"scraped_at"`
+	Professions  []ComparedProfession   `json:"professions"`
+	Trend        []ComparisonTrendPoint `json:"trend"`
+	SkillOverlap []SkillOverlap         `json:"skill_overlap"`
+}
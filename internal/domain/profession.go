@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -28,10 +29,27 @@ type ActiveProfession struct {
 }
 
 type ProfessionDetail struct {
-	ProfessionID    uuid.UUID       `json:"profession_id"`
-	ProfessionName  string          `json:"profession_name"`
-	ScrapedAt       string          `json:"scraped_at"`
-	VacancyCount    int32           `json:"vacancy_count"`
-	FormalSkills    []SkillResponse `json:"formal_skills"`
-	ExtractedSkills []SkillResponse `json:"extracted_skills"`
+	ProfessionID    uuid.UUID         `json:"profession_id"`
+	ProfessionName  string            `json:"profession_name"`
+	Area            string            `json:"area"`
+	ScrapedAt       string            `json:"scraped_at"`
+	VacancyCount    int32             `json:"vacancy_count"`
+	FormalSkills    []SkillResponse   `json:"formal_skills"`
+	ExtractedSkills []SkillResponse   `json:"extracted_skills"`
+	Salary          *SalaryStat       `json:"salary,omitempty"`
+	Breakdown       *VacancyBreakdown `json:"breakdown,omitempty"`
+	Coverage        *FetchStats       `json:"coverage,omitempty"`
+}
+
+// QueryPreview is what a candidate vacancy query finds on hh.ru: the total found and a sample of the vacancies.
+type QueryPreview struct {
+	TotalFound int              `json:"total_found"`
+	Vacancies  []VacancyPreview `json:"vacancies"`
+}
+
+// VacancyPreview is a vacancy of a query preview. PublishedAt is zero when hh gave no valid date.
+type VacancyPreview struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	PublishedAt time.Time `json:"published_at"`
 }
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const CurrencyRUB = "RUB"
+
+var (
+	ErrSalaryStatNotFound = errors.New("salary stat not found")
+)
+
+// Salary is a salary fork published in a vacancy. From and To are in Currency, zero means the bound is not set.
+// RUB is a single estimate of the fork in roubles at the rate of the scraping moment, zero if it is unknown.
+type Salary struct {
+	From     int    `json:"from"`
+	To       int    `json:"to"`
+	Currency string `json:"currency"`
+	Gross    bool   `json:"gross"`
+	RUB      int    `json:"rub"`
+}
+
+// SalaryStat is a salary distribution of a profession in roubles.
+type SalaryStat struct {
+	SampleSize int32 `json:"sample_size"`
+	P25        int32 `json:"p25"`
+	Median     int32 `json:"median"`
+	P75        int32 `json:"p75"`
+}
+
+type SalaryTrendPoint struct {
+	ScrapedAt time.Time `json:"scraped_at"`
+	SalaryStat
+}
+
+type ProfessionSalaryTrend struct {
+	ProfessionID   uuid.UUID          `json:"profession_id"`
+	ProfessionName string             `json:"profession_name"`
+	Area           string             `json:"area"`
+	Data           []SalaryTrendPoint `json:"data"`
+}
+
+// SkillSalary compares median salaries in roubles of vacancies that mention a formal skill and of those that don't.
+type SkillSalary struct {
+	Skill         string `json:"skill"`
+	WithCount     int32  `json:"with_count"`
+	WithMedian    int32  `json:"with_median"`
+	WithoutCount  int32  `json:"without_count"`
+	WithoutMedian int32  `json:"without_median"`
+}
+
+type ProfessionSkillSalaries struct {
+	ProfessionID   uuid.UUID     `json:"profession_id"`
+	ProfessionName string        `json:"profession_name"`
+	Area           string        `json:"area"`
+	ScrapedAt      time.Time     `json:"scraped_at"`
+	Skills         []SkillSalary `json:"skills"`
+}
@@ -1,12 +1,42 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrProfessionRunNotFound = errors.New("profession run not found")
+	ErrScrapingComplete      = errors.New("scraping session is already complete")
+)
+
+// Scraping session statuses. Only complete sessions are served as the latest one.
+const (
+	// ScrapingStatusRunning is a session being scraped, or left by a process that died while scraping it.
+	ScrapingStatusRunning = "running"
+	// ScrapingStatusComplete is a session every active profession was scraped into.
+	ScrapingStatusComplete = "complete"
+	// ScrapingStatusPartial is a session some professions failed to be scraped into.
+	ScrapingStatusPartial = "partial"
+	// ScrapingStatusFailed is a session whose run was interrupted or scraped nothing.
+	ScrapingStatusFailed = "failed"
+)
+
 type Scraping struct {
 	ID        uuid.UUID `json:"id"`
 	ScrapedAt time.Time `json:"scraped_at"`
+	Status    string    `json:"status"`
+}
+
+// FetchStats describes the sample a profession was scraped from in an area: vacancies the search found,
+// vacancy IDs collected from its result pages, vacancies whose data was fetched, and what was lost
+// to failed result pages and vacancy requests.
+type FetchStats struct {
+	TotalFound       int `json:"total_found"`
+	IDsCollected     int `json:"ids_collected"`
+	VacanciesFetched int `json:"vacancies_fetched"`
+	PagesFailed      int `json:"pages_failed"`
+	VacanciesFailed  int `json:"vacancies_failed"`
 }
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNoScrapingJob is returned when no queued scraping job is due.
+	ErrNoScrapingJob = errors.New("no scraping job available")
+	// ErrWorkerStopped is the cause of a job context cancelled because the worker is stopping; the job is returned
+	// to the queue.
+	ErrWorkerStopped = errors.New("scraping worker stopped")
+)
+
+// Scraping job statuses.
+const (
+	ScrapingJobStatusPending   = "pending"
+	ScrapingJobStatusRunning   = "running"
+	ScrapingJobStatusDone      = "done"
+	ScrapingJobStatusFailed    = "failed"
+	ScrapingJobStatusCancelled = "cancelled"
+)
+
+// ScrapingJob is a queued scrape of a profession in an area within a run. SessionID is the archive session
+// the job saves to, or a temporary ID when SaveToDB is false. With Replace the profession's data already
+// stored in the session is replaced. Attempts counts the times the job was claimed, including the current one.
+type ScrapingJob struct {
+	ID           uuid.UUID `json:"id"`
+	RunID        uuid.UUID `json:"run_id"`
+	SessionID    uuid.UUID `json:"session_id"`
+	ProfessionID uuid.UUID `json:"profession_id"`
+	Area         string    `json:"area"`
+	SaveToDB     bool      `json:"save_to_db"`
+	Replace      bool      `json:"replace"`
+	Status       string    `json:"status"`
+	Attempts     int       `json:"attempts"`
+	MaxAttempts  int       `json:"max_attempts"`
+	RunAfter     time.Time `json:"run_after"`
+	LastError    string    `json:"last_error,omitempty"`
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrScrapingRunNotFound  = errors.New("scraping run not found")
+	ErrScrapingInProgress   = errors.New("scraping already in progress")
+	ErrScrapingRunNotActive = errors.New("scraping run is not active")
+	// ErrScrapingRunCancelled is the cause of a run context cancelled on request.
+	ErrScrapingRunCancelled = errors.New("scraping run cancelled")
+)
+
+// Scraping run modes.
+const (
+	// ScrapingModeArchive saves the full result to the database.
+	ScrapingModeArchive = "archive"
+	// ScrapingModeCache refreshes the cache and daily statistics.
+	ScrapingModeCache = "cache"
+	// ScrapingModeReprocess recounts skills of a stored archive session.
+	ScrapingModeReprocess = "reprocess"
+	// ScrapingModeResume scrapes the professions missing from an incomplete archive session.
+	ScrapingModeResume = "resume"
+)
+
+// Scraping run triggers.
+const (
+	ScrapingTriggerCron   = "cron"
+	ScrapingTriggerManual = "manual"
+)
+
+// Scraping run statuses.
+const (
+	ScrapingRunStatusRunning   = "running"
+	ScrapingRunStatusCompleted = "completed"
+	ScrapingRunStatusFailed    = "failed"
+	ScrapingRunStatusCancelled = "cancelled"
+)
+
+// Profession run statuses.
+const (
+	ProfessionRunStatusSuccess = "success"
+	ProfessionRunStatusFailed  = "failed"
+)
+
+// ScrapingRun is a single execution of a scraping mode. TriggeredBy is the admin who started a manual run.
+type ScrapingRun struct {
+	ID          uuid.UUID       `json:"id"`
+	Mode        string          `json:"mode"`
+	Trigger     string          `json:"trigger"`
+	TriggeredBy *uuid.UUID      `json:"triggered_by,omitempty"`
+	Status      string          `json:"status"`
+	Error       string          `json:"error,omitempty"`
+	StartedAt   time.Time       `json:"started_at"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+	Professions []ProfessionRun `json:"professions,omitempty"`
+}
+
+// ProfessionRun is the outcome of scraping a profession in an area within a run.
+type ProfessionRun struct {
+	ProfessionID   uuid.UUID  `json:"profession_id"`
+	ProfessionName string     `json:"profession_name"`
+	Area           string     `json:"area"`
+	SessionID      uuid.UUID  `json:"session_id"`
+	Status         string     `json:"status"`
+	Error          string     `json:"error,omitempty"`
+	Stats          FetchStats `json:"stats"`
+	StartedAt      time.Time  `json:"started_at"`
+	FinishedAt     time.Time  `json:"finished_at"`
+}
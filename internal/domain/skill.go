@@ -2,15 +2,52 @@ package domain
 
 import "github.com/google/uuid"
 
+// Skill is a skill count of a profession in a session. Percentage is the share of the session's vacancies
+// mentioning the skill, nil when the count is of mentions rather than vacancies.
 type Skill struct {
 	ID           uuid.UUID `json:"id"`
 	ProfessionID uuid.UUID `json:"profession_id"`
 	Skill        string    `json:"skill"`
 	Count        int32     `json:"count"`
+	Percentage   *float64  `json:"percentage,omitempty"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
 }
 
 type SkillResponse struct {
-	Skill string `json:"skill"`
-	Count int32  `json:"count"`
+	Skill      string   `json:"skill"`
+	Count      int32    `json:"count"`
+	Percentage *float64 `json:"percentage,omitempty"`
+}
+
+// Categories of the skill catalogue. SkillCategoryOther groups skills that are not in the catalogue.
+const (
+	SkillCategoryLanguage    = "language"
+	SkillCategoryFramework   = "framework"
+	SkillCategoryDatabase    = "database"
+	SkillCategoryCloud       = "cloud"
+	SkillCategoryDevOps      = "devops"
+	SkillCategoryTool        = "tool"
+	SkillCategoryMethodology = "methodology"
+	SkillCategorySoftSkill   = "soft_skill"
+	SkillCategoryOther       = "other"
+)
+
+// CatalogSkill is a skill of the catalogue. Parent is empty for a top-level skill.
+type CatalogSkill struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Parent   string `json:"parent,omitempty"`
+}
+
+// SkillGroup is the skills of one catalogue category, the most popular first.
+type SkillGroup struct {
+	Category string         `json:"category"`
+	Skills   []GroupedSkill `json:"skills"`
+}
+
+type GroupedSkill struct {
+	Skill      string   `json:"skill"`
+	Count      int32    `json:"count"`
+	Percentage *float64 `json:"percentage,omitempty"`
+	Parent     string   `json:"parent,omitempty"`
 }
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSkillAliasNotFound      = errors.New("skill alias not found")
+	ErrSkillAliasAlreadyExists = errors.New("skill alias already exists")
+	ErrInvalidSkillAlias       = errors.New("invalid skill alias")
+	// ErrSkillAliasChain is returned when an alias would point to another alias or a canonical skill would become an alias.
+	ErrSkillAliasChain = errors.New("skill alias chain")
+)
+
+// SkillAlias maps a variant spelling of a skill to its canonical name, e.g. "golang" to "go".
+type SkillAlias struct {
+	ID        uuid.UUID `json:"id"`
+	Alias     string    `json:"alias"`
+	Skill     string    `json:"skill"`
+	CreatedAt time.Time `json:"created_at"`
+}
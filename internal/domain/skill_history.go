@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidDateRange = errors.New("invalid date range")
+)
+
+// SkillSnapshot is a skill count at a specific archive scraping session.
+type SkillSnapshot struct {
+	Skill     string    `json:"skill"`
+	Count     int32     `json:"count"`
+	ScrapedAt time.Time `json:"scraped_at"`
+}
+
+type SkillHistoryPoint struct {
+	ScrapedAt time.Time `json:"scraped_at"`
+	Count     int32     `json:"count"`
+}
+
+type SkillHistory struct {
+	Skill string              `json:"skill"`
+	Data  []SkillHistoryPoint `json:"data"`
+}
+
+type ProfessionSkillHistory struct {
+	ProfessionID    uuid.UUID      `json:"profession_id"`
+	ProfessionName  string         `json:"profession_name"`
+	Area            string         `json:"area"`
+	From            time.Time      `json:"from"`
+	To              time.Time      `json:"to"`
+	FormalSkills    []SkillHistory `json:"formal_skills"`
+	ExtractedSkills []SkillHistory `json:"extracted_skills"`
+}
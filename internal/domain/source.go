@@ -0,0 +1,9 @@
+package domain
+
+// Vacancy sources. Every stored vacancy is tagged with the name of the source it was fetched from.
+const (
+	// SourceHH is the hh.ru API.
+	SourceHH = "hh"
+	// SourceFile is a local JSONL or CSV dump of vacancies.
+	SourceFile = "file"
+)
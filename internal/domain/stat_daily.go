@@ -14,5 +14,6 @@ type StatDailyPoint struct {
 type ProfessionTrend struct {
 	ProfessionID   uuid.UUID        `json:"profession_id"`
 	ProfessionName string           `json:"profession_name"`
+	Area           string           `json:"area"`
 	Data           []StatDailyPoint `json:"data"`
 }
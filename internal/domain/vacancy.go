@@ -1,11 +1,56 @@
 package domain
 
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrScrapingNotFound = errors.New("scraping session not found")
+)
+
 type VacancyData struct {
-	Skills      []string `json:"skills"`
-	Description string   `json:"description"`
+	ID          string    `json:"id"`
+	Skills      []string  `json:"skills"`
+	Description string    `json:"description"`
+	PublishedAt time.Time `json:"published_at"`
+	Salary      *Salary   `json:"salary,omitempty"`
+	Experience  string    `json:"experience,omitempty"`
+	Employment  string    `json:"employment,omitempty"`
+	Schedule    string    `json:"schedule,omitempty"`
+	Source      string    `json:"source,omitempty"`
 }
 
 type SkillData struct {
 	Name  string `json:"name"`
 	Count int    `json:"count"`
 }
+
+// Vacancy is a raw vacancy stored for an archive scraping session.
+type Vacancy struct {
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Description     string    `json:"description"`
+	DescriptionHash string    `json:"description_hash"`
+	KeySkills       []string  `json:"key_skills"`
+	PublishedAt     time.Time `json:"published_at"`
+}
+
+// VacancyCursor is the state of incremental scraping of one search: when it last ran
+// and the publication time of every vacancy it has found so far, keyed by vacancy ID.
+type VacancyCursor struct {
+	FetchedAt time.Time            `json:"fetched_at"`
+	Vacancies map[string]time.Time `json:"vacancies"`
+}
+
+type VacancyPage struct {
+	SessionID    uuid.UUID `json:"session_id"`
+	ProfessionID uuid.UUID `json:"profession_id"`
+	Area         string    `json:"area"`
+	Total        int64     `json:"total"`
+	Limit        int       `json:"limit"`
+	Offset       int       `json:"offset"`
+	Vacancies    []Vacancy `json:"vacancies"`
+}
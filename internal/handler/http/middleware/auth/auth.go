@@ -63,7 +63,7 @@ func (m *Middleware) Authenticate(next http.Handler) http.Handler {
 
 		log.Debug("auth_token_valid", "user_id", claims.UserID, "role", claims.Role)
 
-		ctx = context.WithValue(ctx, userContextKey, claims)
+		ctx = ContextWithUser(ctx, claims)
 
 		ctx = loggerctx.WithLogger(ctx, log.With("user_id", claims.UserID))
 
@@ -107,6 +107,11 @@ func (m *Middleware) respondWithError(w http.ResponseWriter, code int, message s
 	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
 }
 
+// ContextWithUser returns a copy of ctx carrying the authenticated user.
+func ContextWithUser(ctx context.Context, claims *domain.TokenClaims) context.Context {
+	return context.WithValue(ctx, userContextKey, claims)
+}
+
 func GetUserFromContext(ctx context.Context) (*domain.TokenClaims, error) {
 	claims, ok := ctx.Value(userContextKey).(*domain.TokenClaims)
 	if !ok {
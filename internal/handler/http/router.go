@@ -21,6 +21,11 @@ type V1Handlers struct {
 	ProfessionPublic *public.ProfessionHandler
 	ProfessionAdmin  *admin.ProfessionAdminHandler
 	Trend            *public.TrendHandler
+	Skill            *public.SkillHandler
+	VacancyAdmin     *admin.VacancyAdminHandler
+	Area             *public.AreaHandler
+	ScrapingRunAdmin *admin.ScrapingRunAdminHandler
+	SkillAliasAdmin  *admin.SkillAliasAdminHandler
 }
 
 // NewRouter creates a root router, installs middleware, and connects API versions.
@@ -45,6 +50,21 @@ func NewRouter(
 	if handlers.Trend == nil {
 		return nil, fmt.Errorf("NewRouter: nil Trend handler")
 	}
+	if handlers.Skill == nil {
+		return nil, fmt.Errorf("NewRouter: nil Skill handler")
+	}
+	if handlers.VacancyAdmin == nil {
+		return nil, fmt.Errorf("NewRouter: nil VacancyAdmin handler")
+	}
+	if handlers.Area == nil {
+		return nil, fmt.Errorf("NewRouter: nil Area handler")
+	}
+	if handlers.ScrapingRunAdmin == nil {
+		return nil, fmt.Errorf("NewRouter: nil ScrapingRunAdmin handler")
+	}
+	if handlers.SkillAliasAdmin == nil {
+		return nil, fmt.Errorf("NewRouter: nil SkillAliasAdmin handler")
+	}
 	if httpMetrics == nil {
 		return nil, fmt.Errorf("NewRouter: nil HTTP metrics")
 	}
@@ -58,7 +78,8 @@ func NewRouter(
 	}
 
 	// v1 router
-	v1Router := v1.New(handlers.AuthPublic, handlers.ProfessionAdmin, handlers.ProfessionPublic, handlers.Trend)
+	v1Router := v1.New(handlers.AuthPublic, handlers.ProfessionAdmin, handlers.ProfessionPublic, handlers.Trend, handlers.Skill,
+		handlers.VacancyAdmin, handlers.Area, handlers.ScrapingRunAdmin, handlers.SkillAliasAdmin)
 
 	// mux
 	root := http.NewServeMux()
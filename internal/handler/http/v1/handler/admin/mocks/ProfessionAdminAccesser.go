@@ -225,3 +225,71 @@ func (_c *MockProfessionAdminAccesser_CreateProfession_Call) RunAndReturn(run fu
 	_c.Call.Return(run)
 	return _c
 }
+
+// ProfessionByID provides a mock function for the type MockProfessionAdminAccesser
+func (_mock *MockProfessionAdminAccesser) ProfessionByID(ctx context.Context, id uuid.UUID) (*domain.Profession, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProfessionByID")
+	}
+
+	var r0 *domain.Profession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Profession, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Profession); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Profession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfessionAdminAccesser_ProfessionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProfessionByID'
+type MockProfessionAdminAccesser_ProfessionByID_Call struct {
+	*mock.Call
+}
+
+// ProfessionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockProfessionAdminAccesser_Expecter) ProfessionByID(ctx interface{}, id interface{}) *MockProfessionAdminAccesser_ProfessionByID_Call {
+	return &MockProfessionAdminAccesser_ProfessionByID_Call{Call: _e.mock.On("ProfessionByID", ctx, id)}
+}
+
+func (_c *MockProfessionAdminAccesser_ProfessionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockProfessionAdminAccesser_ProfessionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProfessionAdminAccesser_ProfessionByID_Call) Return(profession *domain.Profession, err error) *MockProfessionAdminAccesser_ProfessionByID_Call {
+	_c.Call.Return(profession, err)
+	return _c
+}
+
+func (_c *MockProfessionAdminAccesser_ProfessionByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*domain.Profession, error)) *MockProfessionAdminAccesser_ProfessionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -4,14 +4,13 @@ import (
 	"context"
 	"errors"
 	"net/http"
-	"runtime/debug"
 	"strings"
-	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 
 	"psa/internal/domain"
+	"psa/internal/handler/http/middleware/auth"
 	"psa/internal/handler/http/v1/handler"
 	"psa/pkg/logger/loggerctx"
 	"psa/pkg/logger/slogx"
@@ -19,25 +18,46 @@ import (
 
 type ProfessionAdminAccesser interface {
 	AllProfessions(ctx context.Context) ([]domain.Profession, error)
+	ProfessionByID(ctx context.Context, id uuid.UUID) (*domain.Profession, error)
 	CreateProfession(ctx context.Context, profession domain.Profession) (uuid.UUID, error)
 	ChangeProfession(ctx context.Context, profession domain.Profession) error
 }
 
 type ScrapingProvider interface {
-	ProcessActiveProfessionsArchive(ctx context.Context) error
-	ProcessActiveProfessionsDaily(ctx context.Context) error
+	ProcessActiveProfessionsArchive(ctx context.Context, runID uuid.UUID) error
+	ProcessActiveProfessionsDaily(ctx context.Context, runID uuid.UUID) error
+	ProcessProfessionArchive(ctx context.Context, runID uuid.UUID, professionID uuid.UUID) error
+	ProcessProfessionDaily(ctx context.Context, runID uuid.UUID, professionID uuid.UUID) error
+	ReprocessSession(ctx context.Context, sessionID uuid.UUID) error
+	ResumeSession(ctx context.Context, runID uuid.UUID, sessionID uuid.UUID) error
+}
+
+type QueryPreviewer interface {
+	PreviewQuery(ctx context.Context, query, area string) (domain.QueryPreview, error)
+}
+
+type ScrapingRunner interface {
+	Start(ctx context.Context, run domain.ScrapingRun, task func(ctx context.Context, runID uuid.UUID) error) (domain.ScrapingRun, error)
 }
 
 type ProfessionAdminHandler struct {
-	profession         ProfessionAdminAccesser
-	scraping           ScrapingProvider
-	scrapingInProgress atomic.Bool
+	profession ProfessionAdminAccesser
+	scraping   ScrapingProvider
+	runner     ScrapingRunner
+	preview    QueryPreviewer
 }
 
-func NewProfessionAdminHandler(profession ProfessionAdminAccesser, scraping ScrapingProvider) *ProfessionAdminHandler {
+func NewProfessionAdminHandler(
+	profession ProfessionAdminAccesser,
+	scraping ScrapingProvider,
+	runner ScrapingRunner,
+	preview QueryPreviewer,
+) *ProfessionAdminHandler {
 	return &ProfessionAdminHandler{
 		profession: profession,
 		scraping:   scraping,
+		runner:     runner,
+		preview:    preview,
 	}
 }
 
@@ -173,51 +193,179 @@ func (h *ProfessionAdminHandler) ListAllProfessions(w http.ResponseWriter, r *ht
 	return nil
 }
 
-func (h *ProfessionAdminHandler) triggerScraping(
-	w http.ResponseWriter, r *http.Request,
-	mode string, runFunc func(context.Context) error) error {
-	log := loggerctx.FromContext(r.Context()).With("component", "scraping")
+type previewQueryRequest struct {
+	VacancyQuery string `json:"vacancy_query"`
+}
+
+type vacancyPreviewResponse struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	PublishedAt *string `json:"published_at"`
+}
+
+type queryPreviewResponse struct {
+	VacancyQuery string                   `json:"vacancy_query"`
+	Area         string                   `json:"area"`
+	TotalFound   int                      `json:"total_found"`
+	Vacancies    []vacancyPreviewResponse `json:"vacancies"`
+}
+
+// PreviewQuery runs a candidate vacancy query against hh.ru without scraping it, so that the query
+// can be tuned before it is saved to a profession.
+func (h *ProfessionAdminHandler) PreviewQuery(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	var req previewQueryRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		log.Warn("profession_admin_preview_decode_failed", slogx.Err(err))
+		return err
+	}
+
+	area, err := handler.QueryArea(r)
+	if err != nil {
+		return err
+	}
+
+	preview, err := h.preview.PreviewQuery(ctx, req.VacancyQuery, area)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidProfessionQuery) {
+			return handler.StatusBadRequest("Vacancy query is required")
+		}
 
-	if !h.scrapingInProgress.CompareAndSwap(false, true) {
-		return handler.StatusConflict("Scraping already in progress")
+		log.Error("profession_admin_preview_failed", "area", area, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to preview vacancy query")
 	}
 
-	log.Info("scraping_triggered", "mode", mode)
+	vacancies := make([]vacancyPreviewResponse, len(preview.Vacancies))
+	for i, v := range preview.Vacancies {
+		vacancies[i] = vacancyPreviewResponse{
+			ID:   v.ID,
+			Name: v.Name,
+		}
+		if !v.PublishedAt.IsZero() {
+			publishedAt := v.PublishedAt.Format(time.RFC3339)
+			vacancies[i].PublishedAt = &publishedAt
+		}
+	}
 
-	go func() {
-		defer h.scrapingInProgress.Store(false)
-		defer func() {
-			if rec := recover(); rec != nil {
-				log.Error("scraping_panic", "mode", mode, "panic", rec, "stack", string(debug.Stack()))
-			}
-		}()
+	log.Debug("profession_admin_preview_success", "area", area, "total_found", preview.TotalFound)
+
+	handler.RespondJSON(w, http.StatusOK, queryPreviewResponse{
+		VacancyQuery: strings.TrimSpace(req.VacancyQuery),
+		Area:         area,
+		TotalFound:   preview.TotalFound,
+		Vacancies:    vacancies,
+	})
 
-		ctx := loggerctx.WithLogger(context.Background(), log)
-		ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
-		defer cancel()
+	return nil
+}
+
+// triggerScraping starts a manual run of the mode in the background, recorded with the admin who started it.
+func (h *ProfessionAdminHandler) triggerScraping(
+	w http.ResponseWriter, r *http.Request,
+	mode string, runFunc func(context.Context, uuid.UUID) error) error {
+	log := loggerctx.FromContext(r.Context()).With("component", "scraping")
+	ctx := loggerctx.WithLogger(r.Context(), log)
 
-		taskLog := loggerctx.FromContext(ctx)
+	run := domain.ScrapingRun{
+		Mode:    mode,
+		Trigger: domain.ScrapingTriggerManual,
+	}
+	if claims, err := auth.GetUserFromContext(ctx); err == nil {
+		run.TriggeredBy = &claims.UserID
+	}
 
-		if err := runFunc(ctx); err != nil {
-			taskLog.Error("scraping_failed", "mode", mode, slogx.Err(err))
-			return
+	run, err := h.runner.Start(ctx, run, runFunc)
+	if err != nil {
+		if errors.Is(err, domain.ErrScrapingInProgress) {
+			return handler.StatusConflict("Scraping already in progress")
 		}
 
-		taskLog.Info("scraping_task_completed", "mode", mode)
-	}()
+		log.Error("scraping_start_failed", "mode", mode, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to start scraping")
+	}
+
+	log.Info("scraping_triggered", "mode", mode, "run_id", run.ID)
 
 	handler.RespondJSON(w, http.StatusAccepted, map[string]string{
 		"status": "started",
 		"mode":   mode,
+		"run_id": run.ID.String(),
 	})
 
 	return nil
 }
 
 func (h *ProfessionAdminHandler) TriggerArchiveScraping(w http.ResponseWriter, r *http.Request) error {
-	return h.triggerScraping(w, r, "archive", h.scraping.ProcessActiveProfessionsArchive)
+	return h.triggerScraping(w, r, domain.ScrapingModeArchive, h.scraping.ProcessActiveProfessionsArchive)
 }
 
 func (h *ProfessionAdminHandler) TriggerCacheScraping(w http.ResponseWriter, r *http.Request) error {
-	return h.triggerScraping(w, r, "cache", h.scraping.ProcessActiveProfessionsDaily)
+	return h.triggerScraping(w, r, domain.ScrapingModeCache, h.scraping.ProcessActiveProfessionsDaily)
+}
+
+// TriggerReprocess recounts skills of a stored archive session with the current extractor version.
+func (h *ProfessionAdminHandler) TriggerReprocess(w http.ResponseWriter, r *http.Request) error {
+	sessionID, err := handler.PathUUID(r, "session_id")
+	if err != nil {
+		loggerctx.FromContext(r.Context()).Warn("scraping_reprocess_invalid_session_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid session ID")
+	}
+
+	return h.triggerScraping(w, r, domain.ScrapingModeReprocess, func(ctx context.Context, _ uuid.UUID) error {
+		return h.scraping.ReprocessSession(ctx, sessionID)
+	})
+}
+
+// TriggerResume scrapes the professions missing from an incomplete archive session, e.g. after the monthly run died.
+func (h *ProfessionAdminHandler) TriggerResume(w http.ResponseWriter, r *http.Request) error {
+	sessionID, err := handler.PathUUID(r, "session_id")
+	if err != nil {
+		loggerctx.FromContext(r.Context()).Warn("scraping_resume_invalid_session_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid session ID")
+	}
+
+	return h.triggerScraping(w, r, domain.ScrapingModeResume, func(ctx context.Context, runID uuid.UUID) error {
+		return h.scraping.ResumeSession(ctx, runID, sessionID)
+	})
+}
+
+// TriggerProfessionScraping scrapes a single profession, e.g. after its vacancy query was fixed.
+// mode=daily (default) refreshes the cache entry, mode=archive also replaces the profession's data in the latest session.
+func (h *ProfessionAdminHandler) TriggerProfessionScraping(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	professionID, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("profession_scraping_invalid_id", slogx.Err(err))
+		return err
+	}
+
+	var (
+		mode    string
+		process func(ctx context.Context, runID uuid.UUID, professionID uuid.UUID) error
+	)
+	switch strings.TrimSpace(r.URL.Query().Get("mode")) {
+	case "", "daily":
+		mode, process = domain.ScrapingModeCache, h.scraping.ProcessProfessionDaily
+	case "archive":
+		mode, process = domain.ScrapingModeArchive, h.scraping.ProcessProfessionArchive
+	default:
+		return handler.StatusBadRequest("Invalid mode: must be daily or archive")
+	}
+
+	if _, err := h.profession.ProfessionByID(ctx, professionID); err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return handler.StatusNotFound("Profession not found")
+		}
+
+		log.Error("profession_scraping_get_profession_failed", "profession_id", professionID, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to get profession")
+	}
+
+	return h.triggerScraping(w, r, mode, func(ctx context.Context, runID uuid.UUID) error {
+		return process(ctx, runID, professionID)
+	})
 }
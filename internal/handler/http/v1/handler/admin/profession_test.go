@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -15,28 +16,32 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"psa/internal/domain"
+	"psa/internal/handler/http/middleware/auth"
 	"psa/internal/handler/http/v1/handler"
 	"psa/internal/handler/http/v1/handler/admin"
 	"psa/internal/handler/http/v1/handler/admin/mocks"
-	cronMocks "psa/internal/service/cron/mocks"
 )
 
 // testDeps содержит зависимости для тестирования ProfessionAdminHandler
 type testDeps struct {
 	profession *mocks.MockProfessionAdminAccesser
-	scraping   *cronMocks.MockScrapingProvider
+	scraping   *mocks.MockScrapingProvider
+	runner     *mocks.MockScrapingRunner
+	preview    *mocks.MockQueryPreviewer
 }
 
 func newDeps(t *testing.T) testDeps {
 	t.Helper()
 	return testDeps{
 		profession: mocks.NewMockProfessionAdminAccesser(t),
-		scraping:   cronMocks.NewMockScrapingProvider(t),
+		scraping:   mocks.NewMockScrapingProvider(t),
+		runner:     mocks.NewMockScrapingRunner(t),
+		preview:    mocks.NewMockQueryPreviewer(t),
 	}
 }
 
 func (d testDeps) handler() *admin.ProfessionAdminHandler {
-	return admin.NewProfessionAdminHandler(d.profession, d.scraping)
+	return admin.NewProfessionAdminHandler(d.profession, d.scraping, d.runner, d.preview)
 }
 
 func doRequest(t *testing.T, h http.Handler, body any) *httptest.ResponseRecorder {
@@ -221,24 +226,35 @@ func TestProfessionAdminHandler_Create_Unit_InternalError(t *testing.T) {
 
 // ==================== TriggerScraping ====================
 
+// startRun — ScrapingRunner, который выполняет задачу запуска сразу
+func startRun(runID uuid.UUID) func(ctx context.Context, run domain.ScrapingRun, task func(context.Context, uuid.UUID) error) (domain.ScrapingRun, error) {
+	return func(ctx context.Context, run domain.ScrapingRun, task func(context.Context, uuid.UUID) error) (domain.ScrapingRun, error) {
+		run.ID = runID
+		return run, task(ctx, runID)
+	}
+}
+
 func TestProfessionAdminHandler_TriggerArchiveScraping_Unit_Success(t *testing.T) {
 	t.Parallel()
 
 	// Arrange
 	deps := newDeps(t)
 
-	done := make(chan struct{})
-	deps.scraping.EXPECT().
-		ProcessActiveProfessionsArchive(mock.AnythingOfType("*context.timerCtx")).
-		RunAndReturn(func(ctx context.Context) error {
-			close(done)
-			return nil
-		})
+	runID := uuid.New()
+	userID := uuid.New()
+	deps.runner.EXPECT().
+		Start(mock.Anything, mock.MatchedBy(func(run domain.ScrapingRun) bool {
+			return run.Mode == domain.ScrapingModeArchive && run.Trigger == domain.ScrapingTriggerManual &&
+				run.TriggeredBy != nil && *run.TriggeredBy == userID
+		}), mock.Anything).
+		RunAndReturn(startRun(runID))
+	deps.scraping.EXPECT().ProcessActiveProfessionsArchive(mock.Anything, runID).Return(nil)
 
 	h := handler.Handle(deps.handler().TriggerArchiveScraping)
 
 	// Act
 	req := httptest.NewRequest(http.MethodPost, "/admin/scraping/archive", nil)
+	req = req.WithContext(auth.ContextWithUser(req.Context(), &domain.TokenClaims{UserID: userID, Role: "admin"}))
 	rr := httptest.NewRecorder()
 
 	h.ServeHTTP(rr, req)
@@ -250,9 +266,7 @@ func TestProfessionAdminHandler_TriggerArchiveScraping_Unit_Success(t *testing.T
 	decodeResponse(t, rr, &resp)
 	assert.Equal(t, "started", resp["status"])
 	assert.Equal(t, "archive", resp["mode"])
-
-	// Ждём завершения goroutine
-	<-done
+	assert.Equal(t, runID.String(), resp["run_id"])
 }
 
 func TestProfessionAdminHandler_TriggerCacheScraping_Unit_Success(t *testing.T) {
@@ -261,13 +275,13 @@ func TestProfessionAdminHandler_TriggerCacheScraping_Unit_Success(t *testing.T)
 	// Arrange
 	deps := newDeps(t)
 
-	done := make(chan struct{})
-	deps.scraping.EXPECT().
-		ProcessActiveProfessionsDaily(mock.AnythingOfType("*context.timerCtx")).
-		RunAndReturn(func(ctx context.Context) error {
-			close(done)
-			return nil
-		})
+	runID := uuid.New()
+	deps.runner.EXPECT().
+		Start(mock.Anything, mock.MatchedBy(func(run domain.ScrapingRun) bool {
+			return run.Mode == domain.ScrapingModeCache && run.Trigger == domain.ScrapingTriggerManual
+		}), mock.Anything).
+		RunAndReturn(startRun(runID))
+	deps.scraping.EXPECT().ProcessActiveProfessionsDaily(mock.Anything, runID).Return(nil)
 
 	h := handler.Handle(deps.handler().TriggerCacheScraping)
 
@@ -284,47 +298,384 @@ func TestProfessionAdminHandler_TriggerCacheScraping_Unit_Success(t *testing.T)
 	decodeResponse(t, rr, &resp)
 	assert.Equal(t, "started", resp["status"])
 	assert.Equal(t, "cache", resp["mode"])
-
-	// Ждём завершения goroutine
-	<-done
+	assert.Equal(t, runID.String(), resp["run_id"])
 }
 
 func TestProfessionAdminHandler_TriggerScraping_Unit_ConcurrentBlocked(t *testing.T) {
 	t.Parallel()
 
-	// Arrange — создаём хендлер с моками (CAS сработает до вызова scraping)
+	// Arrange
+	deps := newDeps(t)
+
+	deps.runner.EXPECT().
+		Start(mock.Anything, mock.Anything, mock.Anything).
+		Return(domain.ScrapingRun{}, domain.ErrScrapingInProgress)
+
+	// Act
+	req := httptest.NewRequest(http.MethodPost, "/admin/scraping/cache", nil)
+	rr := httptest.NewRecorder()
+
+	handler.Handle(deps.handler().TriggerCacheScraping).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	var resp map[string]string
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, "Scraping already in progress", resp["error"])
+}
+
+func TestProfessionAdminHandler_TriggerScraping_Unit_StartError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
 	deps := newDeps(t)
 
-	done := make(chan struct{})
-	deps.scraping.EXPECT().
-		ProcessActiveProfessionsArchive(mock.AnythingOfType("*context.timerCtx")).
-		RunAndReturn(func(ctx context.Context) error {
-			close(done)
-			return nil
-		})
+	deps.runner.EXPECT().
+		Start(mock.Anything, mock.Anything, mock.Anything).
+		Return(domain.ScrapingRun{}, assert.AnError)
+
+	// Act
+	req := httptest.NewRequest(http.MethodPost, "/admin/scraping/archive", nil)
+	rr := httptest.NewRecorder()
+
+	handler.Handle(deps.handler().TriggerArchiveScraping).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestProfessionAdminHandler_TriggerReprocess_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
 
-	h := deps.handler()
+	sessionID := uuid.New()
+	runID := uuid.New()
+	deps.runner.EXPECT().
+		Start(mock.Anything, mock.MatchedBy(func(run domain.ScrapingRun) bool {
+			return run.Mode == domain.ScrapingModeReprocess
+		}), mock.Anything).
+		RunAndReturn(startRun(runID))
+	deps.scraping.EXPECT().ReprocessSession(mock.Anything, sessionID).Return(nil)
 
-	// Первый запуск — должен пройти
-	req1 := httptest.NewRequest(http.MethodPost, "/admin/scraping/archive", nil)
-	rr1 := httptest.NewRecorder()
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /admin/scraping/{session_id}/reprocess", handler.Handle(deps.handler().TriggerReprocess))
 
-	handler.Handle(h.TriggerArchiveScraping).ServeHTTP(rr1, req1)
-	assert.Equal(t, http.StatusAccepted, rr1.Code)
+	// Act
+	req := httptest.NewRequest(http.MethodPost, "/admin/scraping/"+sessionID.String()+"/reprocess", nil)
+	rr := httptest.NewRecorder()
 
-	// Второй запуск — должен быть заблокирован (CAS)
-	req2 := httptest.NewRequest(http.MethodPost, "/admin/scraping/cache", nil)
-	rr2 := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
 
-	handler.Handle(h.TriggerCacheScraping).ServeHTTP(rr2, req2)
-	assert.Equal(t, http.StatusConflict, rr2.Code)
+	// Assert
+	assert.Equal(t, http.StatusAccepted, rr.Code)
 
 	var resp map[string]string
-	decodeResponse(t, rr2, &resp)
-	assert.Equal(t, "Scraping already in progress", resp["error"])
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, "started", resp["status"])
+	assert.Equal(t, "reprocess", resp["mode"])
+}
+
+func TestProfessionAdminHandler_TriggerReprocess_Unit_InvalidSessionID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /admin/scraping/{session_id}/reprocess", handler.Handle(deps.handler().TriggerReprocess))
 
-	// Ждём завершения goroutine
-	<-done
+	// Act
+	req := httptest.NewRequest(http.MethodPost, "/admin/scraping/not-a-uuid/reprocess", nil)
+	rr := httptest.NewRecorder()
+
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestProfessionAdminHandler_TriggerResume_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	runID := uuid.New()
+	deps.runner.EXPECT().
+		Start(mock.Anything, mock.MatchedBy(func(run domain.ScrapingRun) bool {
+			return run.Mode == domain.ScrapingModeResume
+		}), mock.Anything).
+		RunAndReturn(startRun(runID))
+	deps.scraping.EXPECT().ResumeSession(mock.Anything, runID, sessionID).Return(nil)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /admin/scraping/{session_id}/resume", handler.Handle(deps.handler().TriggerResume))
+
+	// Act
+	req := httptest.NewRequest(http.MethodPost, "/admin/scraping/"+sessionID.String()+"/resume", nil)
+	rr := httptest.NewRecorder()
+
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+
+	var resp map[string]string
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, "started", resp["status"])
+	assert.Equal(t, "resume", resp["mode"])
+	assert.Equal(t, runID.String(), resp["run_id"])
+}
+
+func TestProfessionAdminHandler_TriggerResume_Unit_InvalidSessionID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /admin/scraping/{session_id}/resume", handler.Handle(deps.handler().TriggerResume))
+
+	// Act
+	req := httptest.NewRequest(http.MethodPost, "/admin/scraping/not-a-uuid/resume", nil)
+	rr := httptest.NewRecorder()
+
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func doScrapeRequest(t *testing.T, h *admin.ProfessionAdminHandler, target string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /admin/professions/{id}/scrape", handler.Handle(h.TriggerProfessionScraping))
+
+	req := httptest.NewRequest(http.MethodPost, target, nil)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func TestProfessionAdminHandler_TriggerProfessionScraping_Unit_DailyByDefault(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	runID := uuid.New()
+	deps.profession.EXPECT().ProfessionByID(mock.Anything, professionID).Return(&domain.Profession{ID: professionID}, nil)
+	deps.runner.EXPECT().
+		Start(mock.Anything, mock.MatchedBy(func(run domain.ScrapingRun) bool {
+			return run.Mode == domain.ScrapingModeCache && run.Trigger == domain.ScrapingTriggerManual
+		}), mock.Anything).
+		RunAndReturn(startRun(runID))
+	deps.scraping.EXPECT().ProcessProfessionDaily(mock.Anything, runID, professionID).Return(nil)
+
+	// Act
+	rr := doScrapeRequest(t, deps.handler(), "/admin/professions/"+professionID.String()+"/scrape")
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+
+	var resp map[string]string
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, "cache", resp["mode"])
+	assert.Equal(t, runID.String(), resp["run_id"])
+}
+
+func TestProfessionAdminHandler_TriggerProfessionScraping_Unit_Archive(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	runID := uuid.New()
+	deps.profession.EXPECT().ProfessionByID(mock.Anything, professionID).Return(&domain.Profession{ID: professionID}, nil)
+	deps.runner.EXPECT().
+		Start(mock.Anything, mock.MatchedBy(func(run domain.ScrapingRun) bool {
+			return run.Mode == domain.ScrapingModeArchive
+		}), mock.Anything).
+		RunAndReturn(startRun(runID))
+	deps.scraping.EXPECT().ProcessProfessionArchive(mock.Anything, runID, professionID).Return(nil)
+
+	// Act
+	rr := doScrapeRequest(t, deps.handler(), "/admin/professions/"+professionID.String()+"/scrape?mode=archive")
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+
+	var resp map[string]string
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, "archive", resp["mode"])
+}
+
+func TestProfessionAdminHandler_TriggerProfessionScraping_Unit_InvalidMode(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	// Act
+	rr := doScrapeRequest(t, deps.handler(), "/admin/professions/"+uuid.NewString()+"/scrape?mode=weekly")
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestProfessionAdminHandler_TriggerProfessionScraping_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	deps.profession.EXPECT().ProfessionByID(mock.Anything, professionID).Return(nil, domain.ErrProfessionNotFound)
+
+	// Act
+	rr := doScrapeRequest(t, deps.handler(), "/admin/professions/"+professionID.String()+"/scrape")
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestProfessionAdminHandler_TriggerProfessionScraping_Unit_ConcurrentBlocked(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	deps.profession.EXPECT().ProfessionByID(mock.Anything, professionID).Return(&domain.Profession{ID: professionID}, nil)
+	deps.runner.EXPECT().Start(mock.Anything, mock.Anything, mock.Anything).Return(domain.ScrapingRun{}, domain.ErrScrapingInProgress)
+
+	// Act
+	rr := doScrapeRequest(t, deps.handler(), "/admin/professions/"+professionID.String()+"/scrape?mode=archive")
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
+
+// ==================== PreviewQuery ====================
+
+func doPreviewRequest(t *testing.T, h *admin.ProfessionAdminHandler, target string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler.Handle(h.PreviewQuery).ServeHTTP(rr, req)
+
+	return rr
+}
+
+func TestProfessionAdminHandler_PreviewQuery_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	deps.preview.EXPECT().PreviewQuery(mock.Anything, "golang NOT 1С", "1").Return(domain.QueryPreview{
+		TotalFound: 1234,
+		Vacancies: []domain.VacancyPreview{
+			{ID: "101", Name: "Go Developer", PublishedAt: time.Date(2025, 1, 15, 7, 30, 0, 0, time.UTC)},
+			{ID: "102", Name: "Golang Backend Engineer"},
+		},
+	}, nil)
+
+	// Act
+	rr := doPreviewRequest(t, deps.handler(), "/admin/professions/preview?area=1", `{"vacancy_query": "golang NOT 1С"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp struct {
+		VacancyQuery string `json:"vacancy_query"`
+		Area         string `json:"area"`
+		TotalFound   int    `json:"total_found"`
+		Vacancies    []struct {
+			ID          string  `json:"id"`
+			Name        string  `json:"name"`
+			PublishedAt *string `json:"published_at"`
+		} `json:"vacancies"`
+	}
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, "golang NOT 1С", resp.VacancyQuery)
+	assert.Equal(t, "1", resp.Area)
+	assert.Equal(t, 1234, resp.TotalFound)
+	require.Len(t, resp.Vacancies, 2)
+	assert.Equal(t, "Go Developer", resp.Vacancies[0].Name)
+	require.NotNil(t, resp.Vacancies[0].PublishedAt)
+	assert.Equal(t, "2025-01-15T07:30:00Z", *resp.Vacancies[0].PublishedAt)
+	// дата публикации неизвестна
+	assert.Nil(t, resp.Vacancies[1].PublishedAt)
+}
+
+func TestProfessionAdminHandler_PreviewQuery_Unit_DefaultArea(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	deps.preview.EXPECT().PreviewQuery(mock.Anything, "golang", domain.DefaultArea).Return(domain.QueryPreview{}, nil)
+
+	// Act
+	rr := doPreviewRequest(t, deps.handler(), "/admin/professions/preview", `{"vacancy_query": "golang"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestProfessionAdminHandler_PreviewQuery_Unit_EmptyQuery(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	deps.preview.EXPECT().PreviewQuery(mock.Anything, "", domain.DefaultArea).Return(domain.QueryPreview{}, domain.ErrInvalidProfessionQuery)
+
+	// Act
+	rr := doPreviewRequest(t, deps.handler(), "/admin/professions/preview", `{"vacancy_query": ""}`)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestProfessionAdminHandler_PreviewQuery_Unit_InvalidArea(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	// Act
+	rr := doPreviewRequest(t, deps.handler(), "/admin/professions/preview?area=moscow", `{"vacancy_query": "golang"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestProfessionAdminHandler_PreviewQuery_Unit_SupplierError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	deps.preview.EXPECT().PreviewQuery(mock.Anything, "golang", domain.DefaultArea).Return(domain.QueryPreview{}, errors.New("hh unavailable"))
+
+	// Act
+	rr := doPreviewRequest(t, deps.handler(), "/admin/professions/preview", `{"vacancy_query": "golang"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
 }
 
 // ==================== Change ====================
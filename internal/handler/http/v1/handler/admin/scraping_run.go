@@ -0,0 +1,193 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+const (
+	defaultRunLimit = 20
+	maxRunLimit     = 100
+)
+
+type ScrapingRunProvider interface {
+	Runs(ctx context.Context, limit int) ([]domain.ScrapingRun, error)
+	RunByID(ctx context.Context, id uuid.UUID) (domain.ScrapingRun, error)
+	Cancel(ctx context.Context, id uuid.UUID) error
+}
+
+type ScrapingRunAdminHandler struct {
+	runs ScrapingRunProvider
+}
+
+func NewScrapingRunAdminHandler(runs ScrapingRunProvider) *ScrapingRunAdminHandler {
+	return &ScrapingRunAdminHandler{
+		runs: runs,
+	}
+}
+
+type professionRunResponse struct {
+	ProfessionID     string `json:"profession_id"`
+	ProfessionName   string `json:"profession_name"`
+	Area             string `json:"area"`
+	SessionID        string `json:"session_id"`
+	Status           string `json:"status"`
+	Error            string `json:"error"`
+	TotalFound       int    `json:"total_found"`
+	IDsCollected     int    `json:"ids_collected"`
+	VacanciesFetched int    `json:"vacancies_fetched"`
+	PagesFailed      int    `json:"pages_failed"`
+	VacanciesFailed  int    `json:"vacancies_failed"`
+	StartedAt        string `json:"started_at"`
+	FinishedAt       string `json:"finished_at"`
+}
+
+type scrapingRunResponse struct {
+	ID          string                  `json:"id"`
+	Mode        string                  `json:"mode"`
+	Trigger     string                  `json:"trigger"`
+	TriggeredBy *string                 `json:"triggered_by"`
+	Status      string                  `json:"status"`
+	Error       string                  `json:"error"`
+	StartedAt   string                  `json:"started_at"`
+	FinishedAt  *string                 `json:"finished_at"`
+	Professions []professionRunResponse `json:"professions,omitempty"`
+}
+
+func (h *ScrapingRunAdminHandler) ListRuns(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	limit, err := handler.QueryInt(r, "limit", defaultRunLimit, maxRunLimit)
+	if err != nil {
+		log.Warn("scraping_run_list_invalid_limit", slogx.Err(err))
+		return err
+	}
+
+	runs, err := h.runs.Runs(ctx, limit)
+	if err != nil {
+		log.Error("scraping_run_list_failed", slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to get scraping runs")
+	}
+
+	resp := make([]scrapingRunResponse, len(runs))
+	for i, run := range runs {
+		resp[i] = toScrapingRunResponse(run)
+	}
+
+	log.Debug("scraping_run_list_success", "count", len(runs))
+
+	handler.RespondJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func (h *ScrapingRunAdminHandler) GetRun(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	runID, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("scraping_run_get_invalid_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid run ID")
+	}
+
+	run, err := h.runs.RunByID(ctx, runID)
+	if err != nil {
+		if errors.Is(err, domain.ErrScrapingRunNotFound) {
+			return handler.StatusNotFound("Scraping run not found")
+		}
+
+		log.Error("scraping_run_get_failed", "run_id", runID, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to get scraping run")
+	}
+
+	resp := toScrapingRunResponse(run)
+	resp.Professions = make([]professionRunResponse, len(run.Professions))
+	for i, p := range run.Professions {
+		resp.Professions[i] = professionRunResponse{
+			ProfessionID:     p.ProfessionID.String(),
+			ProfessionName:   p.ProfessionName,
+			Area:             p.Area,
+			SessionID:        p.SessionID.String(),
+			Status:           p.Status,
+			Error:            p.Error,
+			TotalFound:       p.Stats.TotalFound,
+			IDsCollected:     p.Stats.IDsCollected,
+			VacanciesFetched: p.Stats.VacanciesFetched,
+			PagesFailed:      p.Stats.PagesFailed,
+			VacanciesFailed:  p.Stats.VacanciesFailed,
+			StartedAt:        p.StartedAt.Format(time.RFC3339),
+			FinishedAt:       p.FinishedAt.Format(time.RFC3339),
+		}
+	}
+
+	log.Debug("scraping_run_get_success", "run_id", runID, "profession_count", len(run.Professions))
+
+	handler.RespondJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// CancelRun stops an active run. The run stops before its next profession and is recorded as cancelled,
+// so the response only confirms the request.
+func (h *ScrapingRunAdminHandler) CancelRun(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	runID, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("scraping_run_cancel_invalid_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid run ID")
+	}
+
+	if err := h.runs.Cancel(ctx, runID); err != nil {
+		if errors.Is(err, domain.ErrScrapingRunNotFound) {
+			return handler.StatusNotFound("Scraping run not found")
+		}
+		if errors.Is(err, domain.ErrScrapingRunNotActive) {
+			return handler.StatusConflict("Scraping run is not active")
+		}
+
+		log.Error("scraping_run_cancel_failed", "run_id", runID, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to cancel scraping run")
+	}
+
+	log.Info("scraping_run_cancel_success", "run_id", runID)
+
+	handler.RespondJSON(w, http.StatusAccepted, map[string]string{
+		"status": "cancelling",
+		"run_id": runID.String(),
+	})
+
+	return nil
+}
+
+func toScrapingRunResponse(run domain.ScrapingRun) scrapingRunResponse {
+	resp := scrapingRunResponse{
+		ID:        run.ID.String(),
+		Mode:      run.Mode,
+		Trigger:   run.Trigger,
+		Status:    run.Status,
+		Error:     run.Error,
+		StartedAt: run.StartedAt.Format(time.RFC3339),
+	}
+
+	if run.TriggeredBy != nil {
+		triggeredBy := run.TriggeredBy.String()
+		resp.TriggeredBy = &triggeredBy
+	}
+	if run.FinishedAt != nil {
+		finishedAt := run.FinishedAt.Format(time.RFC3339)
+		resp.FinishedAt = &finishedAt
+	}
+
+	return resp
+}
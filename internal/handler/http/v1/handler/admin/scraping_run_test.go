@@ -0,0 +1,372 @@
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/internal/handler/http/v1/handler/admin"
+	"psa/internal/handler/http/v1/handler/admin/mocks"
+)
+
+// scrapingRunTestDeps содержит зависимости для тестирования ScrapingRunAdminHandler
+type scrapingRunTestDeps struct {
+	runs *mocks.MockScrapingRunProvider
+}
+
+func newScrapingRunDeps(t *testing.T) scrapingRunTestDeps {
+	t.Helper()
+	return scrapingRunTestDeps{
+		runs: mocks.NewMockScrapingRunProvider(t),
+	}
+}
+
+func (d scrapingRunTestDeps) handler() http.Handler {
+	h := admin.NewScrapingRunAdminHandler(d.runs)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/scraping/runs", handler.Handle(h.ListRuns))
+	mux.HandleFunc("GET /admin/scraping/runs/{id}", handler.Handle(h.GetRun))
+	mux.HandleFunc("DELETE /admin/scraping/runs/{id}", handler.Handle(h.CancelRun))
+	return mux
+}
+
+func doRunRequest(h http.Handler, url string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	return rr
+}
+
+type professionRunBody struct {
+	ProfessionID     string `json:"profession_id"`
+	ProfessionName   string `json:"profession_name"`
+	Area             string `json:"area"`
+	SessionID        string `json:"session_id"`
+	Status           string `json:"status"`
+	Error            string `json:"error"`
+	TotalFound       int    `json:"total_found"`
+	VacanciesFetched int    `json:"vacancies_fetched"`
+	StartedAt        string `json:"started_at"`
+	FinishedAt       string `json:"finished_at"`
+}
+
+type scrapingRunBody struct {
+	ID          string              `json:"id"`
+	Mode        string              `json:"mode"`
+	Trigger     string              `json:"trigger"`
+	TriggeredBy *string             `json:"triggered_by"`
+	Status      string              `json:"status"`
+	Error       string              `json:"error"`
+	StartedAt   string              `json:"started_at"`
+	FinishedAt  *string             `json:"finished_at"`
+	Professions []professionRunBody `json:"professions"`
+}
+
+// ==================== ListRuns ====================
+
+func TestScrapingRunAdminHandler_ListRuns_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+
+	userID := uuid.New()
+	startedAt := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(40 * time.Minute)
+	runs := []domain.ScrapingRun{
+		{
+			ID:          uuid.New(),
+			Mode:        domain.ScrapingModeCache,
+			Trigger:     domain.ScrapingTriggerManual,
+			TriggeredBy: &userID,
+			Status:      domain.ScrapingRunStatusRunning,
+			StartedAt:   finishedAt,
+		},
+		{
+			ID:         uuid.New(),
+			Mode:       domain.ScrapingModeArchive,
+			Trigger:    domain.ScrapingTriggerCron,
+			Status:     domain.ScrapingRunStatusFailed,
+			Error:      "get active professions: timeout",
+			StartedAt:  startedAt,
+			FinishedAt: &finishedAt,
+		},
+	}
+	deps.runs.EXPECT().Runs(mock.Anything, 20).Return(runs, nil)
+
+	// Act
+	rr := doRunRequest(deps.handler(), "/admin/scraping/runs")
+
+	// Assert
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp []scrapingRunBody
+	decodeResponse(t, rr, &resp)
+	require.Len(t, resp, 2)
+
+	assert.Equal(t, runs[0].ID.String(), resp[0].ID)
+	assert.Equal(t, "cache", resp[0].Mode)
+	assert.Equal(t, "manual", resp[0].Trigger)
+	require.NotNil(t, resp[0].TriggeredBy)
+	assert.Equal(t, userID.String(), *resp[0].TriggeredBy)
+	assert.Equal(t, "running", resp[0].Status)
+	assert.Nil(t, resp[0].FinishedAt)
+	assert.Nil(t, resp[0].Professions)
+
+	assert.Equal(t, "cron", resp[1].Trigger)
+	assert.Nil(t, resp[1].TriggeredBy)
+	assert.Equal(t, "failed", resp[1].Status)
+	assert.Equal(t, "get active professions: timeout", resp[1].Error)
+	assert.Equal(t, "2025-01-15T03:00:00Z", resp[1].StartedAt)
+	require.NotNil(t, resp[1].FinishedAt)
+	assert.Equal(t, "2025-01-15T03:40:00Z", *resp[1].FinishedAt)
+}
+
+func TestScrapingRunAdminHandler_ListRuns_Unit_Limit(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+	deps.runs.EXPECT().Runs(mock.Anything, 5).Return([]domain.ScrapingRun{}, nil)
+
+	// Act
+	rr := doRunRequest(deps.handler(), "/admin/scraping/runs?limit=5")
+
+	// Assert
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `[]`, rr.Body.String())
+}
+
+func TestScrapingRunAdminHandler_ListRuns_Unit_InvalidLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		limit string
+	}{
+		{name: "не число", limit: "abc"},
+		{name: "ноль", limit: "0"},
+		{name: "больше максимума", limit: "101"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			deps := newScrapingRunDeps(t)
+
+			// Act
+			rr := doRunRequest(deps.handler(), "/admin/scraping/runs?limit="+tt.limit)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestScrapingRunAdminHandler_ListRuns_Unit_InternalError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+	deps.runs.EXPECT().Runs(mock.Anything, 20).Return(nil, assert.AnError)
+
+	// Act
+	rr := doRunRequest(deps.handler(), "/admin/scraping/runs")
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+// ==================== GetRun ====================
+
+func TestScrapingRunAdminHandler_GetRun_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+
+	runID := uuid.New()
+	professionID := uuid.New()
+	sessionID := uuid.New()
+	startedAt := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Hour)
+
+	deps.runs.EXPECT().RunByID(mock.Anything, runID).Return(domain.ScrapingRun{
+		ID:         runID,
+		Mode:       domain.ScrapingModeArchive,
+		Trigger:    domain.ScrapingTriggerCron,
+		Status:     domain.ScrapingRunStatusCompleted,
+		StartedAt:  startedAt,
+		FinishedAt: &finishedAt,
+		Professions: []domain.ProfessionRun{
+			{
+				ProfessionID:   professionID,
+				ProfessionName: "Go Developer",
+				Area:           "113",
+				SessionID:      sessionID,
+				Status:         domain.ProfessionRunStatusFailed,
+				Error:          "fetch vacancy data: hh unavailable",
+				Stats:          domain.FetchStats{TotalFound: 100, VacanciesFetched: 90},
+				StartedAt:      startedAt,
+				FinishedAt:     startedAt.Add(time.Minute),
+			},
+		},
+	}, nil)
+
+	// Act
+	rr := doRunRequest(deps.handler(), "/admin/scraping/runs/"+runID.String())
+
+	// Assert
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp scrapingRunBody
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, runID.String(), resp.ID)
+	assert.Equal(t, "completed", resp.Status)
+	require.Len(t, resp.Professions, 1)
+	assert.Equal(t, professionRunBody{
+		ProfessionID:     professionID.String(),
+		ProfessionName:   "Go Developer",
+		Area:             "113",
+		SessionID:        sessionID.String(),
+		Status:           "failed",
+		Error:            "fetch vacancy data: hh unavailable",
+		TotalFound:       100,
+		VacanciesFetched: 90,
+		StartedAt:        "2025-01-15T03:00:00Z",
+		FinishedAt:       "2025-01-15T03:01:00Z",
+	}, resp.Professions[0])
+}
+
+func TestScrapingRunAdminHandler_GetRun_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().RunByID(mock.Anything, runID).Return(domain.ScrapingRun{}, domain.ErrScrapingRunNotFound)
+
+	// Act
+	rr := doRunRequest(deps.handler(), "/admin/scraping/runs/"+runID.String())
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestScrapingRunAdminHandler_GetRun_Unit_InvalidID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+
+	// Act
+	rr := doRunRequest(deps.handler(), "/admin/scraping/runs/not-a-uuid")
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestScrapingRunAdminHandler_GetRun_Unit_InternalError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().RunByID(mock.Anything, runID).Return(domain.ScrapingRun{}, assert.AnError)
+
+	// Act
+	rr := doRunRequest(deps.handler(), "/admin/scraping/runs/"+runID.String())
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+// ==================== CancelRun ====================
+
+func doCancelRequest(h http.Handler, runID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodDelete, "/admin/scraping/runs/"+runID, nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func TestScrapingRunAdminHandler_CancelRun_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().Cancel(mock.Anything, runID).Return(nil).Once()
+
+	// Act
+	rr := doCancelRequest(deps.handler(), runID.String())
+
+	// Assert
+	require.Equal(t, http.StatusAccepted, rr.Code)
+
+	var resp map[string]string
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, "cancelling", resp["status"])
+	assert.Equal(t, runID.String(), resp["run_id"])
+}
+
+func TestScrapingRunAdminHandler_CancelRun_Unit_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{name: "запуск не найден", err: domain.ErrScrapingRunNotFound, wantCode: http.StatusNotFound},
+		{name: "запуск уже завершён", err: domain.ErrScrapingRunNotActive, wantCode: http.StatusConflict},
+		{name: "внутренняя ошибка", err: assert.AnError, wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			deps := newScrapingRunDeps(t)
+
+			runID := uuid.New()
+			deps.runs.EXPECT().Cancel(mock.Anything, runID).Return(tt.err).Once()
+
+			// Act
+			rr := doCancelRequest(deps.handler(), runID.String())
+
+			// Assert
+			assert.Equal(t, tt.wantCode, rr.Code)
+		})
+	}
+}
+
+func TestScrapingRunAdminHandler_CancelRun_Unit_InvalidID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newScrapingRunDeps(t)
+
+	// Act
+	rr := doCancelRequest(deps.handler(), "not-a-uuid")
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,176 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+type SkillAliasProvider interface {
+	List(ctx context.Context) ([]domain.SkillAlias, error)
+	Create(ctx context.Context, alias, skill string) (domain.SkillAlias, error)
+	Change(ctx context.Context, id uuid.UUID, alias, skill string) (domain.SkillAlias, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type SkillAliasAdminHandler struct {
+	aliases SkillAliasProvider
+}
+
+func NewSkillAliasAdminHandler(aliases SkillAliasProvider) *SkillAliasAdminHandler {
+	return &SkillAliasAdminHandler{
+		aliases: aliases,
+	}
+}
+
+type skillAliasRequest struct {
+	Alias string `json:"alias"`
+	Skill string `json:"skill"`
+}
+
+type skillAliasResponse struct {
+	ID        string `json:"id"`
+	Alias     string `json:"alias"`
+	Skill     string `json:"skill"`
+	CreatedAt string `json:"created_at"`
+}
+
+func (h *SkillAliasAdminHandler) List(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	aliases, err := h.aliases.List(ctx)
+	if err != nil {
+		log.Error("skill_alias_admin_list_failed", slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to get skill aliases")
+	}
+
+	resp := make([]skillAliasResponse, len(aliases))
+	for i, a := range aliases {
+		resp[i] = toSkillAliasResponse(a)
+	}
+
+	log.Debug("skill_alias_admin_list_success", "count", len(aliases))
+
+	handler.RespondJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func (h *SkillAliasAdminHandler) Create(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	var req skillAliasRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		log.Warn("skill_alias_admin_create_decode_failed", slogx.Err(err))
+		return err
+	}
+
+	created, err := h.aliases.Create(ctx, req.Alias, req.Skill)
+	if err != nil {
+		if httpErr := skillAliasError(err); httpErr != nil {
+			log.Warn("skill_alias_admin_create_rejected", "alias", req.Alias, "skill", req.Skill, slogx.Err(err))
+			return httpErr
+		}
+
+		log.Error("skill_alias_admin_create_failed", "alias", req.Alias, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to create skill alias")
+	}
+
+	log.Info("skill_alias_admin_create_success", "alias_id", created.ID, "alias", created.Alias, "skill", created.Skill)
+
+	handler.RespondJSON(w, http.StatusCreated, toSkillAliasResponse(created))
+	return nil
+}
+
+func (h *SkillAliasAdminHandler) Change(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	id, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("skill_alias_admin_change_invalid_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid skill alias ID")
+	}
+
+	var req skillAliasRequest
+	if err := handler.DecodeJSON(r, &req); err != nil {
+		log.Warn("skill_alias_admin_change_decode_failed", slogx.Err(err))
+		return err
+	}
+
+	changed, err := h.aliases.Change(ctx, id, req.Alias, req.Skill)
+	if err != nil {
+		if errors.Is(err, domain.ErrSkillAliasNotFound) {
+			return handler.StatusNotFound("Skill alias not found")
+		}
+		if httpErr := skillAliasError(err); httpErr != nil {
+			log.Warn("skill_alias_admin_change_rejected", "alias_id", id, slogx.Err(err))
+			return httpErr
+		}
+
+		log.Error("skill_alias_admin_change_failed", "alias_id", id, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to change skill alias")
+	}
+
+	log.Info("skill_alias_admin_change_success", "alias_id", id, "alias", changed.Alias, "skill", changed.Skill)
+
+	handler.RespondJSON(w, http.StatusOK, toSkillAliasResponse(changed))
+	return nil
+}
+
+func (h *SkillAliasAdminHandler) Delete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	id, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("skill_alias_admin_delete_invalid_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid skill alias ID")
+	}
+
+	if err := h.aliases.Delete(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrSkillAliasNotFound) {
+			return handler.StatusNotFound("Skill alias not found")
+		}
+
+		log.Error("skill_alias_admin_delete_failed", "alias_id", id, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to delete skill alias")
+	}
+
+	log.Info("skill_alias_admin_delete_success", "alias_id", id)
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// skillAliasError maps a rejected alias to its HTTP error, or returns nil for other errors.
+func skillAliasError(err error) *handler.HTTPError {
+	switch {
+	case errors.Is(err, domain.ErrInvalidSkillAlias):
+		return handler.StatusBadRequest("Alias and skill are required and must differ")
+	case errors.Is(err, domain.ErrSkillAliasChain):
+		return handler.StatusBadRequest("Skill alias chains are not allowed")
+	case errors.Is(err, domain.ErrSkillAliasAlreadyExists):
+		return handler.StatusConflict("Skill alias already exists")
+	default:
+		return nil
+	}
+}
+
+func toSkillAliasResponse(a domain.SkillAlias) skillAliasResponse {
+	return skillAliasResponse{
+		ID:        a.ID.String(),
+		Alias:     a.Alias,
+		Skill:     a.Skill,
+		CreatedAt: a.CreatedAt.Format(time.RFC3339),
+	}
+}
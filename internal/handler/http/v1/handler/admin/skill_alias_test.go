@@ -0,0 +1,250 @@
+package admin_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/internal/handler/http/v1/handler/admin"
+	"psa/internal/handler/http/v1/handler/admin/mocks"
+)
+
+// skillAliasTestDeps содержит зависимости для тестирования SkillAliasAdminHandler
+type skillAliasTestDeps struct {
+	aliases *mocks.MockSkillAliasProvider
+}
+
+func newSkillAliasDeps(t *testing.T) skillAliasTestDeps {
+	t.Helper()
+	return skillAliasTestDeps{
+		aliases: mocks.NewMockSkillAliasProvider(t),
+	}
+}
+
+func (d skillAliasTestDeps) handler() http.Handler {
+	h := admin.NewSkillAliasAdminHandler(d.aliases)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/skills/aliases", handler.Handle(h.List))
+	mux.HandleFunc("POST /admin/skills/aliases", handler.Handle(h.Create))
+	mux.HandleFunc("PUT /admin/skills/aliases/{id}", handler.Handle(h.Change))
+	mux.HandleFunc("DELETE /admin/skills/aliases/{id}", handler.Handle(h.Delete))
+	return mux
+}
+
+func doSkillAliasRequest(h http.Handler, method, url, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	return rr
+}
+
+type skillAliasBody struct {
+	ID        string `json:"id"`
+	Alias     string `json:"alias"`
+	Skill     string `json:"skill"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ==================== List ====================
+
+func TestSkillAliasAdminHandler_List_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newSkillAliasDeps(t)
+
+	id := uuid.New()
+	createdAt := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	deps.aliases.EXPECT().List(mock.Anything).Return([]domain.SkillAlias{
+		{ID: id, Alias: "golang", Skill: "go", CreatedAt: createdAt},
+	}, nil)
+
+	// Act
+	rr := doSkillAliasRequest(deps.handler(), http.MethodGet, "/admin/skills/aliases", "")
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp []skillAliasBody
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, []skillAliasBody{{
+		ID:        id.String(),
+		Alias:     "golang",
+		Skill:     "go",
+		CreatedAt: "2025-01-15T03:00:00Z",
+	}}, resp)
+}
+
+func TestSkillAliasAdminHandler_List_Unit_Error(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newSkillAliasDeps(t)
+	deps.aliases.EXPECT().List(mock.Anything).Return(nil, errors.New("db unavailable"))
+
+	// Act
+	rr := doSkillAliasRequest(deps.handler(), http.MethodGet, "/admin/skills/aliases", "")
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+// ==================== Create ====================
+
+func TestSkillAliasAdminHandler_Create_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newSkillAliasDeps(t)
+
+	id := uuid.New()
+	deps.aliases.EXPECT().Create(mock.Anything, "K8s", "kubernetes").
+		Return(domain.SkillAlias{ID: id, Alias: "k8s", Skill: "kubernetes", CreatedAt: time.Now()}, nil)
+
+	// Act
+	rr := doSkillAliasRequest(deps.handler(), http.MethodPost, "/admin/skills/aliases",
+		`{"alias": "K8s", "skill": "kubernetes"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	var resp skillAliasBody
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, id.String(), resp.ID)
+	assert.Equal(t, "k8s", resp.Alias)
+	assert.Equal(t, "kubernetes", resp.Skill)
+}
+
+func TestSkillAliasAdminHandler_Create_Unit_Rejected(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "некорректный синоним", err: domain.ErrInvalidSkillAlias, status: http.StatusBadRequest},
+		{name: "цепочка синонимов", err: domain.ErrSkillAliasChain, status: http.StatusBadRequest},
+		{name: "синоним уже существует", err: domain.ErrSkillAliasAlreadyExists, status: http.StatusConflict},
+		{name: "ошибка хранилища", err: errors.New("db unavailable"), status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			deps := newSkillAliasDeps(t)
+			deps.aliases.EXPECT().Create(mock.Anything, "golang", "go").Return(domain.SkillAlias{}, tt.err)
+
+			// Act
+			rr := doSkillAliasRequest(deps.handler(), http.MethodPost, "/admin/skills/aliases",
+				`{"alias": "golang", "skill": "go"}`)
+
+			// Assert
+			assert.Equal(t, tt.status, rr.Code)
+		})
+	}
+}
+
+// ==================== Change ====================
+
+func TestSkillAliasAdminHandler_Change_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newSkillAliasDeps(t)
+
+	id := uuid.New()
+	deps.aliases.EXPECT().Change(mock.Anything, id, "pg", "postgresql").
+		Return(domain.SkillAlias{ID: id, Alias: "pg", Skill: "postgresql", CreatedAt: time.Now()}, nil)
+
+	// Act
+	rr := doSkillAliasRequest(deps.handler(), http.MethodPut, "/admin/skills/aliases/"+id.String(),
+		`{"alias": "pg", "skill": "postgresql"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp skillAliasBody
+	decodeResponse(t, rr, &resp)
+	assert.Equal(t, "postgresql", resp.Skill)
+}
+
+func TestSkillAliasAdminHandler_Change_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newSkillAliasDeps(t)
+
+	id := uuid.New()
+	deps.aliases.EXPECT().Change(mock.Anything, id, "pg", "postgresql").Return(domain.SkillAlias{}, domain.ErrSkillAliasNotFound)
+
+	// Act
+	rr := doSkillAliasRequest(deps.handler(), http.MethodPut, "/admin/skills/aliases/"+id.String(),
+		`{"alias": "pg", "skill": "postgresql"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestSkillAliasAdminHandler_Change_Unit_InvalidID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newSkillAliasDeps(t)
+
+	// Act
+	rr := doSkillAliasRequest(deps.handler(), http.MethodPut, "/admin/skills/aliases/not-a-uuid",
+		`{"alias": "pg", "skill": "postgresql"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+// ==================== Delete ====================
+
+func TestSkillAliasAdminHandler_Delete_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newSkillAliasDeps(t)
+
+	id := uuid.New()
+	deps.aliases.EXPECT().Delete(mock.Anything, id).Return(nil)
+
+	// Act
+	rr := doSkillAliasRequest(deps.handler(), http.MethodDelete, "/admin/skills/aliases/"+id.String(), "")
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Empty(t, rr.Body.String())
+}
+
+func TestSkillAliasAdminHandler_Delete_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newSkillAliasDeps(t)
+
+	id := uuid.New()
+	deps.aliases.EXPECT().Delete(mock.Anything, id).Return(domain.ErrSkillAliasNotFound)
+
+	// Act
+	rr := doSkillAliasRequest(deps.handler(), http.MethodDelete, "/admin/skills/aliases/"+id.String(), "")
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,125 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+const (
+	defaultVacancyLimit = 50
+	maxVacancyLimit     = 500
+)
+
+type VacancyProvider interface {
+	SessionVacancies(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, limit, offset int) (*domain.VacancyPage, error)
+}
+
+type VacancyAdminHandler struct {
+	provider VacancyProvider
+}
+
+func NewVacancyAdminHandler(provider VacancyProvider) *VacancyAdminHandler {
+	return &VacancyAdminHandler{
+		provider: provider,
+	}
+}
+
+type vacancyResponse struct {
+	ID              string   `json:"id"`
+	Source          string   `json:"source"`
+	Description     string   `json:"description"`
+	DescriptionHash string   `json:"description_hash"`
+	KeySkills       []string `json:"key_skills"`
+	PublishedAt     *string  `json:"published_at"`
+}
+
+type vacancyPageResponse struct {
+	SessionID    string            `json:"session_id"`
+	ProfessionID string            `json:"profession_id"`
+	Area         string            `json:"area"`
+	Total        int64             `json:"total"`
+	Limit        int               `json:"limit"`
+	Offset       int               `json:"offset"`
+	Vacancies    []vacancyResponse `json:"vacancies"`
+}
+
+func (h *VacancyAdminHandler) ListSessionVacancies(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	sessionID, err := handler.PathUUID(r, "session_id")
+	if err != nil {
+		log.Warn("vacancy_admin_list_invalid_session_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid session ID")
+	}
+
+	professionID, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("vacancy_admin_list_invalid_profession_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid profession ID")
+	}
+
+	area, err := handler.QueryArea(r)
+	if err != nil {
+		log.Warn("vacancy_admin_list_invalid_area", slogx.Err(err))
+		return err
+	}
+
+	limit, offset, err := handler.QueryPagination(r, defaultVacancyLimit, maxVacancyLimit)
+	if err != nil {
+		log.Warn("vacancy_admin_list_invalid_pagination", slogx.Err(err))
+		return err
+	}
+
+	page, err := h.provider.SessionVacancies(ctx, sessionID, professionID, area, limit, offset)
+	if err != nil {
+		if errors.Is(err, domain.ErrScrapingNotFound) {
+			return handler.StatusNotFound("Scraping session not found")
+		}
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return handler.StatusNotFound("Profession not found")
+		}
+
+		log.Error("vacancy_admin_list_failed", "session_id", sessionID, "profession_id", professionID, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to get vacancies")
+	}
+
+	resp := vacancyPageResponse{
+		SessionID:    page.SessionID.String(),
+		ProfessionID: page.ProfessionID.String(),
+		Area:         page.Area,
+		Total:        page.Total,
+		Limit:        page.Limit,
+		Offset:       page.Offset,
+		Vacancies:    make([]vacancyResponse, len(page.Vacancies)),
+	}
+
+	for i, v := range page.Vacancies {
+		resp.Vacancies[i] = vacancyResponse{
+			ID:              v.ID,
+			Source:          v.Source,
+			Description:     v.Description,
+			DescriptionHash: v.DescriptionHash,
+			KeySkills:       v.KeySkills,
+		}
+		if !v.PublishedAt.IsZero() {
+			publishedAt := v.PublishedAt.Format(time.RFC3339)
+			resp.Vacancies[i].PublishedAt = &publishedAt
+		}
+	}
+
+	log.Debug("vacancy_admin_list_success", "session_id", sessionID, "profession_id", professionID,
+		"count", len(page.Vacancies))
+
+	handler.RespondJSON(w, http.StatusOK, resp)
+	return nil
+}
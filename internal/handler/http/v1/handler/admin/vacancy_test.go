@@ -0,0 +1,215 @@
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/internal/handler/http/v1/handler/admin"
+	"psa/internal/handler/http/v1/handler/admin/mocks"
+)
+
+// vacancyTestDeps содержит зависимости для тестирования VacancyAdminHandler
+type vacancyTestDeps struct {
+	provider *mocks.MockVacancyProvider
+}
+
+func newVacancyDeps(t *testing.T) vacancyTestDeps {
+	t.Helper()
+	return vacancyTestDeps{
+		provider: mocks.NewMockVacancyProvider(t),
+	}
+}
+
+func (d vacancyTestDeps) handler() http.Handler {
+	h := handler.Handle(admin.NewVacancyAdminHandler(d.provider).ListSessionVacancies)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/scraping/{session_id}/professions/{id}/vacancies", h.ServeHTTP)
+	return mux
+}
+
+func doVacancyRequest(h http.Handler, url string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func vacanciesURL(sessionID, professionID string) string {
+	return "/admin/scraping/" + sessionID + "/professions/" + professionID + "/vacancies"
+}
+
+// ==================== ListSessionVacancies ====================
+
+func TestVacancyAdminHandler_ListSessionVacancies_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	sessionID := uuid.New()
+	professionID := uuid.New()
+
+	// Arrange
+	deps := newVacancyDeps(t)
+
+	page := &domain.VacancyPage{
+		SessionID:    sessionID,
+		ProfessionID: professionID,
+		Total:        2,
+		Limit:        50,
+		Offset:       0,
+		Vacancies: []domain.Vacancy{
+			{
+				ID:              "101",
+				Description:     "Go developer needed",
+				DescriptionHash: "abc",
+				KeySkills:       []string{"golang", "sql"},
+				PublishedAt:     time.Date(2025, 1, 15, 7, 30, 0, 0, time.UTC),
+			},
+			{
+				ID:              "102",
+				Description:     "No date",
+				DescriptionHash: "def",
+				KeySkills:       []string{},
+			},
+		},
+	}
+
+	deps.provider.EXPECT().SessionVacancies(mock.Anything, sessionID, professionID, "113", 50, 0).Return(page, nil)
+
+	// Act
+	rr := doVacancyRequest(deps.handler(), vacanciesURL(sessionID.String(), professionID.String()))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp struct {
+		SessionID string `json:"session_id"`
+		Total     int64  `json:"total"`
+		Vacancies []struct {
+			ID          string   `json:"id"`
+			KeySkills   []string `json:"key_skills"`
+			PublishedAt *string  `json:"published_at"`
+		} `json:"vacancies"`
+	}
+	decodeResponse(t, rr, &resp)
+
+	assert.Equal(t, sessionID.String(), resp.SessionID)
+	assert.Equal(t, int64(2), resp.Total)
+	require.Len(t, resp.Vacancies, 2)
+	assert.Equal(t, "101", resp.Vacancies[0].ID)
+	assert.Equal(t, []string{"golang", "sql"}, resp.Vacancies[0].KeySkills)
+	require.NotNil(t, resp.Vacancies[0].PublishedAt)
+	assert.Equal(t, "2025-01-15T07:30:00Z", *resp.Vacancies[0].PublishedAt)
+	assert.Nil(t, resp.Vacancies[1].PublishedAt)
+}
+
+func TestVacancyAdminHandler_ListSessionVacancies_Unit_Pagination(t *testing.T) {
+	t.Parallel()
+
+	sessionID := uuid.New()
+	professionID := uuid.New()
+
+	// Arrange
+	deps := newVacancyDeps(t)
+
+	deps.provider.EXPECT().SessionVacancies(mock.Anything, sessionID, professionID, "1", 10, 30).
+		Return(&domain.VacancyPage{Area: "1", Limit: 10, Offset: 30}, nil)
+
+	// Act
+	rr := doVacancyRequest(deps.handler(), vacanciesURL(sessionID.String(), professionID.String())+"?area=1&limit=10&offset=30")
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestVacancyAdminHandler_ListSessionVacancies_Unit_BadRequest(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New().String()
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "invalid session id", url: vacanciesURL("not-a-uuid", id)},
+		{name: "invalid profession id", url: vacanciesURL(id, "not-a-uuid")},
+		{name: "limit out of range", url: vacanciesURL(id, id) + "?limit=501"},
+		{name: "negative offset", url: vacanciesURL(id, id) + "?offset=-1"},
+		{name: "invalid area", url: vacanciesURL(id, id) + "?area=msk"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			deps := newVacancyDeps(t)
+
+			// Act
+			rr := doVacancyRequest(deps.handler(), tt.url)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestVacancyAdminHandler_ListSessionVacancies_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		err     error
+		message string
+	}{
+		{name: "session", err: domain.ErrScrapingNotFound, message: "Scraping session not found"},
+		{name: "profession", err: domain.ErrProfessionNotFound, message: "Profession not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			deps := newVacancyDeps(t)
+
+			deps.provider.EXPECT().SessionVacancies(mock.Anything, mock.Anything, mock.Anything, "113", 50, 0).
+				Return(nil, tt.err)
+
+			// Act
+			rr := doVacancyRequest(deps.handler(), vacanciesURL(uuid.New().String(), uuid.New().String()))
+
+			// Assert
+			assert.Equal(t, http.StatusNotFound, rr.Code)
+
+			var resp map[string]string
+			decodeResponse(t, rr, &resp)
+			assert.Equal(t, tt.message, resp["error"])
+		})
+	}
+}
+
+func TestVacancyAdminHandler_ListSessionVacancies_Unit_InternalError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newVacancyDeps(t)
+
+	deps.provider.EXPECT().SessionVacancies(mock.Anything, mock.Anything, mock.Anything, "113", 50, 0).
+		Return(nil, assert.AnError)
+
+	// Act
+	rr := doVacancyRequest(deps.handler(), vacanciesURL(uuid.New().String(), uuid.New().String()))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
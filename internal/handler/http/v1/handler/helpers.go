@@ -8,8 +8,11 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
+	"psa/internal/domain"
 	"psa/pkg/logger/loggerctx"
 
 	"github.com/google/uuid"
@@ -147,3 +150,130 @@ func PathUUID(r *http.Request, param string) (uuid.UUID, error) {
 
 	return id, nil
 }
+
+// QueryDateRange parses optional "from" and "to" query parameters in RFC3339 or YYYY-MM-DD format.
+// A date-only "to" covers the whole day. Missing "to" defaults to now, missing "from" to one year before "to".
+func QueryDateRange(r *http.Request) (time.Time, time.Time, error) {
+	to := time.Now().UTC()
+	from := time.Time{}
+
+	if value := strings.TrimSpace(r.URL.Query().Get("to")); value != "" {
+		t, dateOnly, err := parseDate(value)
+		if err != nil {
+			return time.Time{}, time.Time{}, StatusBadRequest("invalid date for parameter: to")
+		}
+		if dateOnly {
+			t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		to = t
+	}
+
+	if value := strings.TrimSpace(r.URL.Query().Get("from")); value != "" {
+		t, _, err := parseDate(value)
+		if err != nil {
+			return time.Time{}, time.Time{}, StatusBadRequest("invalid date for parameter: from")
+		}
+		from = t
+	} else {
+		from = to.AddDate(-1, 0, 0)
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, StatusBadRequest("from must not be after to")
+	}
+
+	return from, to, nil
+}
+
+func parseDate(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return t, false, nil
+}
+
+// QueryUUIDs parses a comma-separated list of UUIDs from the query parameter, skipping duplicates.
+func QueryUUIDs(r *http.Request, param string) ([]uuid.UUID, error) {
+	value := strings.TrimSpace(r.URL.Query().Get(param))
+	if value == "" {
+		return nil, StatusBadRequest(fmt.Sprintf("missing query parameter: %s", param))
+	}
+
+	parts := strings.Split(value, ",")
+	ids := make([]uuid.UUID, 0, len(parts))
+	seen := make(map[uuid.UUID]struct{}, len(parts))
+	for _, part := range parts {
+		id, err := uuid.Parse(strings.TrimSpace(part))
+		if err != nil {
+			return nil, StatusBadRequest(fmt.Sprintf("invalid uuid for parameter: %s", param))
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// QueryInt parses an optional positive integer query parameter capped at maxValue.
+func QueryInt(r *http.Request, param string, defaultValue, maxValue int) (int, error) {
+	value := strings.TrimSpace(r.URL.Query().Get(param))
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 || n > maxValue {
+		return 0, StatusBadRequest(fmt.Sprintf("invalid value for parameter: %s", param))
+	}
+
+	return n, nil
+}
+
+// maxAreaLength matches the width of the area column.
+const maxAreaLength = 16
+
+// QueryArea parses an optional hh.ru area ID from the "area" query parameter, defaulting to the whole of Russia.
+func QueryArea(r *http.Request) (string, error) {
+	value := strings.TrimSpace(r.URL.Query().Get("area"))
+	if value == "" {
+		return domain.DefaultArea, nil
+	}
+
+	if len(value) > maxAreaLength {
+		return "", StatusBadRequest("invalid value for parameter: area")
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return "", StatusBadRequest("invalid value for parameter: area")
+		}
+	}
+
+	return value, nil
+}
+
+// QueryPagination parses optional "limit" and "offset" query parameters.
+func QueryPagination(r *http.Request, defaultLimit, maxLimit int) (int, int, error) {
+	limit, err := QueryInt(r, "limit", defaultLimit, maxLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	offset := 0
+	if value := strings.TrimSpace(r.URL.Query().Get("offset")); value != "" {
+		offset, err = strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			return 0, 0, StatusBadRequest("invalid value for parameter: offset")
+		}
+	}
+
+	return limit, offset, nil
+}
@@ -0,0 +1,72 @@
+package public
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+type AreaProvider interface {
+	Search(ctx context.Context, query string, limit int) ([]domain.Area, error)
+}
+
+type AreaHandler struct {
+	provider AreaProvider
+}
+
+func NewAreaHandler(provider AreaProvider) *AreaHandler {
+	return &AreaHandler{
+		provider: provider,
+	}
+}
+
+type areaResponse struct {
+	ID       string  `json:"id"`
+	ParentID *string `json:"parent_id"`
+	Name     string  `json:"name"`
+}
+
+func (h *AreaHandler) SearchAreas(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	query := strings.TrimSpace(r.URL.Query().Get("query"))
+	if query == "" {
+		log.Warn("area_search_empty_query")
+		return handler.StatusBadRequest("Query is required")
+	}
+
+	limit, err := handler.QueryInt(r, "limit", 20, 100)
+	if err != nil {
+		log.Warn("area_search_invalid_limit", slogx.Err(err))
+		return err
+	}
+
+	areas, err := h.provider.Search(ctx, query, limit)
+	if err != nil {
+		log.Error("area_search_failed", "query", query, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to search areas")
+	}
+
+	resp := make([]areaResponse, len(areas))
+	for i, area := range areas {
+		resp[i] = areaResponse{
+			ID:   area.ID,
+			Name: area.Name,
+		}
+		if area.ParentID != "" {
+			parentID := area.ParentID
+			resp[i].ParentID = &parentID
+		}
+	}
+
+	log.Debug("area_search_success", "query", query, "count", len(resp))
+
+	handler.RespondJSON(w, http.StatusOK, resp)
+	return nil
+}
@@ -0,0 +1,140 @@
+package public_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/internal/handler/http/v1/handler/public"
+	"psa/internal/handler/http/v1/handler/public/mocks"
+)
+
+// areaTestDeps содержит зависимости для тестирования AreaHandler
+type areaTestDeps struct {
+	areaProvider *mocks.MockAreaProvider
+}
+
+func newAreaDeps(t *testing.T) areaTestDeps {
+	t.Helper()
+	return areaTestDeps{
+		areaProvider: mocks.NewMockAreaProvider(t),
+	}
+}
+
+func (d areaTestDeps) areaHandler() *public.AreaHandler {
+	return public.NewAreaHandler(d.areaProvider)
+}
+
+// ==================== SearchAreas ====================
+
+func TestAreaHandler_SearchAreas_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newAreaDeps(t)
+
+	areas := []domain.Area{
+		{ID: "1", ParentID: "113", Name: "Москва"},
+		{ID: "113", Name: "Россия"},
+	}
+	deps.areaProvider.EXPECT().Search(mock.Anything, "мос", 20).Return(areas, nil).Once()
+
+	h := handler.Handle(deps.areaHandler().SearchAreas)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/areas?query=%D0%BC%D0%BE%D1%81", nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp []map[string]any
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp, 2)
+	assert.Equal(t, "1", resp[0]["id"])
+	assert.Equal(t, "113", resp[0]["parent_id"])
+	assert.Equal(t, "Москва", resp[0]["name"])
+	assert.Equal(t, "113", resp[1]["id"])
+	assert.Nil(t, resp[1]["parent_id"])
+}
+
+func TestAreaHandler_SearchAreas_Unit_Empty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newAreaDeps(t)
+	deps.areaProvider.EXPECT().Search(mock.Anything, "xyz", 5).Return(nil, nil).Once()
+
+	h := handler.Handle(deps.areaHandler().SearchAreas)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/areas?query=xyz&limit=5", nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, "[]", rr.Body.String())
+}
+
+func TestAreaHandler_SearchAreas_Unit_BadRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "пустой query", url: "/areas"},
+		{name: "query из пробелов", url: "/areas?query=%20%20"},
+		{name: "нечисловой limit", url: "/areas?query=mos&limit=abc"},
+		{name: "limit больше максимума", url: "/areas?query=mos&limit=101"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			deps := newAreaDeps(t)
+			h := handler.Handle(deps.areaHandler().SearchAreas)
+
+			// Act
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(rr, req)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestAreaHandler_SearchAreas_Unit_ProviderError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newAreaDeps(t)
+	deps.areaProvider.EXPECT().Search(mock.Anything, "mos", 20).Return(nil, assert.AnError).Once()
+
+	h := handler.Handle(deps.areaHandler().SearchAreas)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/areas?query=mos", nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -101,9 +101,157 @@ func (_c *MockProfessionProvider_ActiveProfessions_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CompareProfessions provides a mock function for the type MockProfessionProvider
+func (_mock *MockProfessionProvider) CompareProfessions(ctx context.Context, professionIDs []uuid.UUID, area string, topSkills int) (*domain.ProfessionComparison, error) {
+	ret := _mock.Called(ctx, professionIDs, area, topSkills)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareProfessions")
+	}
+
+	var r0 *domain.ProfessionComparison
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, string, int) (*domain.ProfessionComparison, error)); ok {
+		return returnFunc(ctx, professionIDs, area, topSkills)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, string, int) *domain.ProfessionComparison); ok {
+		r0 = returnFunc(ctx, professionIDs, area, topSkills)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProfessionComparison)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, string, int) error); ok {
+		r1 = returnFunc(ctx, professionIDs, area, topSkills)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfessionProvider_CompareProfessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareProfessions'
+type MockProfessionProvider_CompareProfessions_Call struct {
+	*mock.Call
+}
+
+// CompareProfessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionIDs []uuid.UUID
+//   - area string
+//   - topSkills int
+func (_e *MockProfessionProvider_Expecter) CompareProfessions(ctx interface{}, professionIDs interface{}, area interface{}, topSkills interface{}) *MockProfessionProvider_CompareProfessions_Call {
+	return &MockProfessionProvider_CompareProfessions_Call{Call: _e.mock.On("CompareProfessions", ctx, professionIDs, area, topSkills)}
+}
+
+func (_c *MockProfessionProvider_CompareProfessions_Call) Run(run func(ctx context.Context, professionIDs []uuid.UUID, area string, topSkills int)) *MockProfessionProvider_CompareProfessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProfessionProvider_CompareProfessions_Call) Return(professionComparison *domain.ProfessionComparison, err error) *MockProfessionProvider_CompareProfessions_Call {
+	_c.Call.Return(professionComparison, err)
+	return _c
+}
+
+func (_c *MockProfessionProvider_CompareProfessions_Call) RunAndReturn(run func(ctx context.Context, professionIDs []uuid.UUID, area string, topSkills int) (*domain.ProfessionComparison, error)) *MockProfessionProvider_CompareProfessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupSkillsByCategory provides a mock function for the type MockProfessionProvider
+func (_mock *MockProfessionProvider) GroupSkillsByCategory(ctx context.Context, skills []domain.SkillResponse) ([]domain.SkillGroup, error) {
+	ret := _mock.Called(ctx, skills)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupSkillsByCategory")
+	}
+
+	var r0 []domain.SkillGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.SkillResponse) ([]domain.SkillGroup, error)); ok {
+		return returnFunc(ctx, skills)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.SkillResponse) []domain.SkillGroup); ok {
+		r0 = returnFunc(ctx, skills)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SkillGroup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.SkillResponse) error); ok {
+		r1 = returnFunc(ctx, skills)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfessionProvider_GroupSkillsByCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupSkillsByCategory'
+type MockProfessionProvider_GroupSkillsByCategory_Call struct {
+	*mock.Call
+}
+
+// GroupSkillsByCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skills []domain.SkillResponse
+func (_e *MockProfessionProvider_Expecter) GroupSkillsByCategory(ctx interface{}, skills interface{}) *MockProfessionProvider_GroupSkillsByCategory_Call {
+	return &MockProfessionProvider_GroupSkillsByCategory_Call{Call: _e.mock.On("GroupSkillsByCategory", ctx, skills)}
+}
+
+func (_c *MockProfessionProvider_GroupSkillsByCategory_Call) Run(run func(ctx context.Context, skills []domain.SkillResponse)) *MockProfessionProvider_GroupSkillsByCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.SkillResponse
+		if args[1] != nil {
+			arg1 = args[1].([]domain.SkillResponse)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProfessionProvider_GroupSkillsByCategory_Call) Return(skillGroups []domain.SkillGroup, err error) *MockProfessionProvider_GroupSkillsByCategory_Call {
+	_c.Call.Return(skillGroups, err)
+	return _c
+}
+
+func (_c *MockProfessionProvider_GroupSkillsByCategory_Call) RunAndReturn(run func(ctx context.Context, skills []domain.SkillResponse) ([]domain.SkillGroup, error)) *MockProfessionProvider_GroupSkillsByCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProfessionSkills provides a mock function for the type MockProfessionProvider
-func (_mock *MockProfessionProvider) ProfessionSkills(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionDetail, error) {
-	ret := _mock.Called(ctx, professionID)
+func (_mock *MockProfessionProvider) ProfessionSkills(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionDetail, error) {
+	ret := _mock.Called(ctx, professionID, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProfessionSkills")
@@ -111,18 +259,18 @@ func (_mock *MockProfessionProvider) ProfessionSkills(ctx context.Context, profe
 
 	var r0 *domain.ProfessionDetail
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ProfessionDetail, error)); ok {
-		return returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*domain.ProfessionDetail, error)); ok {
+		return returnFunc(ctx, professionID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ProfessionDetail); ok {
-		r0 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *domain.ProfessionDetail); ok {
+		r0 = returnFunc(ctx, professionID, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.ProfessionDetail)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -137,11 +285,12 @@ type MockProfessionProvider_ProfessionSkills_Call struct {
 // ProfessionSkills is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
-func (_e *MockProfessionProvider_Expecter) ProfessionSkills(ctx interface{}, professionID interface{}) *MockProfessionProvider_ProfessionSkills_Call {
-	return &MockProfessionProvider_ProfessionSkills_Call{Call: _e.mock.On("ProfessionSkills", ctx, professionID)}
+//   - area string
+func (_e *MockProfessionProvider_Expecter) ProfessionSkills(ctx interface{}, professionID interface{}, area interface{}) *MockProfessionProvider_ProfessionSkills_Call {
+	return &MockProfessionProvider_ProfessionSkills_Call{Call: _e.mock.On("ProfessionSkills", ctx, professionID, area)}
 }
 
-func (_c *MockProfessionProvider_ProfessionSkills_Call) Run(run func(ctx context.Context, professionID uuid.UUID)) *MockProfessionProvider_ProfessionSkills_Call {
+func (_c *MockProfessionProvider_ProfessionSkills_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string)) *MockProfessionProvider_ProfessionSkills_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -151,9 +300,14 @@ func (_c *MockProfessionProvider_ProfessionSkills_Call) Run(run func(ctx context
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -164,14 +318,14 @@ func (_c *MockProfessionProvider_ProfessionSkills_Call) Return(professionDetail
 	return _c
 }
 
-func (_c *MockProfessionProvider_ProfessionSkills_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionDetail, error)) *MockProfessionProvider_ProfessionSkills_Call {
+func (_c *MockProfessionProvider_ProfessionSkills_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionDetail, error)) *MockProfessionProvider_ProfessionSkills_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ProfessionTrend provides a mock function for the type MockProfessionProvider
-func (_mock *MockProfessionProvider) ProfessionTrend(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error) {
-	ret := _mock.Called(ctx, professionID)
+func (_mock *MockProfessionProvider) ProfessionTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error) {
+	ret := _mock.Called(ctx, professionID, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProfessionTrend")
@@ -179,18 +333,18 @@ func (_mock *MockProfessionProvider) ProfessionTrend(ctx context.Context, profes
 
 	var r0 *domain.ProfessionTrend
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ProfessionTrend, error)); ok {
-		return returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*domain.ProfessionTrend, error)); ok {
+		return returnFunc(ctx, professionID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ProfessionTrend); ok {
-		r0 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *domain.ProfessionTrend); ok {
+		r0 = returnFunc(ctx, professionID, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.ProfessionTrend)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -205,11 +359,12 @@ type MockProfessionProvider_ProfessionTrend_Call struct {
 // ProfessionTrend is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
-func (_e *MockProfessionProvider_Expecter) ProfessionTrend(ctx interface{}, professionID interface{}) *MockProfessionProvider_ProfessionTrend_Call {
-	return &MockProfessionProvider_ProfessionTrend_Call{Call: _e.mock.On("ProfessionTrend", ctx, professionID)}
+//   - area string
+func (_e *MockProfessionProvider_Expecter) ProfessionTrend(ctx interface{}, professionID interface{}, area interface{}) *MockProfessionProvider_ProfessionTrend_Call {
+	return &MockProfessionProvider_ProfessionTrend_Call{Call: _e.mock.On("ProfessionTrend", ctx, professionID, area)}
 }
 
-func (_c *MockProfessionProvider_ProfessionTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID)) *MockProfessionProvider_ProfessionTrend_Call {
+func (_c *MockProfessionProvider_ProfessionTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string)) *MockProfessionProvider_ProfessionTrend_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -219,9 +374,14 @@ func (_c *MockProfessionProvider_ProfessionTrend_Call) Run(run func(ctx context.
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -232,7 +392,7 @@ func (_c *MockProfessionProvider_ProfessionTrend_Call) Return(professionTrend *d
 	return _c
 }
 
-func (_c *MockProfessionProvider_ProfessionTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error)) *MockProfessionProvider_ProfessionTrend_Call {
+func (_c *MockProfessionProvider_ProfessionTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error)) *MockProfessionProvider_ProfessionTrend_Call {
 	_c.Call.Return(run)
 	return _c
 }
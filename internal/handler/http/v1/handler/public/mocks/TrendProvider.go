@@ -39,9 +39,83 @@ func (_m *MockTrendProvider) EXPECT() *MockTrendProvider_Expecter {
 	return &MockTrendProvider_Expecter{mock: &_m.Mock}
 }
 
+// ProfessionSalaryTrend provides a mock function for the type MockTrendProvider
+func (_mock *MockTrendProvider) ProfessionSalaryTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionSalaryTrend, error) {
+	ret := _mock.Called(ctx, professionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProfessionSalaryTrend")
+	}
+
+	var r0 *domain.ProfessionSalaryTrend
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*domain.ProfessionSalaryTrend, error)); ok {
+		return returnFunc(ctx, professionID, area)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *domain.ProfessionSalaryTrend); ok {
+		r0 = returnFunc(ctx, professionID, area)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProfessionSalaryTrend)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTrendProvider_ProfessionSalaryTrend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProfessionSalaryTrend'
+type MockTrendProvider_ProfessionSalaryTrend_Call struct {
+	*mock.Call
+}
+
+// ProfessionSalaryTrend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionID uuid.UUID
+//   - area string
+func (_e *MockTrendProvider_Expecter) ProfessionSalaryTrend(ctx interface{}, professionID interface{}, area interface{}) *MockTrendProvider_ProfessionSalaryTrend_Call {
+	return &MockTrendProvider_ProfessionSalaryTrend_Call{Call: _e.mock.On("ProfessionSalaryTrend", ctx, professionID, area)}
+}
+
+func (_c *MockTrendProvider_ProfessionSalaryTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string)) *MockTrendProvider_ProfessionSalaryTrend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTrendProvider_ProfessionSalaryTrend_Call) Return(professionSalaryTrend *domain.ProfessionSalaryTrend, err error) *MockTrendProvider_ProfessionSalaryTrend_Call {
+	_c.Call.Return(professionSalaryTrend, err)
+	return _c
+}
+
+func (_c *MockTrendProvider_ProfessionSalaryTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionSalaryTrend, error)) *MockTrendProvider_ProfessionSalaryTrend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProfessionTrend provides a mock function for the type MockTrendProvider
-func (_mock *MockTrendProvider) ProfessionTrend(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error) {
-	ret := _mock.Called(ctx, professionID)
+func (_mock *MockTrendProvider) ProfessionTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error) {
+	ret := _mock.Called(ctx, professionID, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProfessionTrend")
@@ -49,18 +123,18 @@ func (_mock *MockTrendProvider) ProfessionTrend(ctx context.Context, professionI
 
 	var r0 *domain.ProfessionTrend
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ProfessionTrend, error)); ok {
-		return returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*domain.ProfessionTrend, error)); ok {
+		return returnFunc(ctx, professionID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ProfessionTrend); ok {
-		r0 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *domain.ProfessionTrend); ok {
+		r0 = returnFunc(ctx, professionID, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.ProfessionTrend)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,11 +149,12 @@ type MockTrendProvider_ProfessionTrend_Call struct {
 // ProfessionTrend is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
-func (_e *MockTrendProvider_Expecter) ProfessionTrend(ctx interface{}, professionID interface{}) *MockTrendProvider_ProfessionTrend_Call {
-	return &MockTrendProvider_ProfessionTrend_Call{Call: _e.mock.On("ProfessionTrend", ctx, professionID)}
+//   - area string
+func (_e *MockTrendProvider_Expecter) ProfessionTrend(ctx interface{}, professionID interface{}, area interface{}) *MockTrendProvider_ProfessionTrend_Call {
+	return &MockTrendProvider_ProfessionTrend_Call{Call: _e.mock.On("ProfessionTrend", ctx, professionID, area)}
 }
 
-func (_c *MockTrendProvider_ProfessionTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID)) *MockTrendProvider_ProfessionTrend_Call {
+func (_c *MockTrendProvider_ProfessionTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string)) *MockTrendProvider_ProfessionTrend_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,9 +164,14 @@ func (_c *MockTrendProvider_ProfessionTrend_Call) Run(run func(ctx context.Conte
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -102,7 +182,7 @@ func (_c *MockTrendProvider_ProfessionTrend_Call) Return(professionTrend *domain
 	return _c
 }
 
-func (_c *MockTrendProvider_ProfessionTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error)) *MockTrendProvider_ProfessionTrend_Call {
+func (_c *MockTrendProvider_ProfessionTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error)) *MockTrendProvider_ProfessionTrend_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,6 +3,8 @@ package public
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
 	"time"
 
@@ -16,10 +18,21 @@ import (
 
 type ProfessionProvider interface {
 	ActiveProfessions(ctx context.Context) ([]domain.ActiveProfession, error)
-	ProfessionSkills(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionDetail, error)
-	ProfessionTrend(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error)
+	ProfessionSkills(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionDetail, error)
+	ProfessionTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error)
+	GroupSkillsByCategory(ctx context.Context, skills []domain.SkillResponse) ([]domain.SkillGroup, error)
+	CompareProfessions(ctx context.Context, professionIDs []uuid.UUID, area string, topSkills int) (*domain.ProfessionComparison, error)
 }
 
+const (
+	minComparedProfessions  = 2
+	maxComparedProfessions  = 10
+	defaultCompareTopSkills = 20
+	maxCompareTopSkills     = 100
+
+	groupByCategory = "category"
+)
+
 type ProfessionHandler struct {
 	provider ProfessionProvider
 }
@@ -62,8 +75,21 @@ func (h *ProfessionHandler) ListProfessions(w http.ResponseWriter, r *http.Reque
 }
 
 type skillResponse struct {
-	Skill string `json:"skill"`
-	Count int32  `json:"count"`
+	Skill      string   `json:"skill"`
+	Count      int32    `json:"count"`
+	Percentage *float64 `json:"percentage,omitempty"`
+}
+
+type skillGroupResponse struct {
+	Category string                 `json:"category"`
+	Skills   []groupedSkillResponse `json:"skills"`
+}
+
+type groupedSkillResponse struct {
+	Skill      string   `json:"skill"`
+	Count      int32    `json:"count"`
+	Percentage *float64 `json:"percentage,omitempty"`
+	Parent     string   `json:"parent,omitempty"`
 }
 
 type trendProfession struct {
@@ -71,14 +97,51 @@ type trendProfession struct {
 	VacancyCount int32  `json:"vacancy_count"`
 }
 
+type salaryResponse struct {
+	SampleSize int32 `json:"sample_size"`
+	P25        int32 `json:"p25"`
+	Median     int32 `json:"median"`
+	P75        int32 `json:"p75"`
+}
+
+type breakdownItemResponse struct {
+	Value string  `json:"value"`
+	Count int32   `json:"count"`
+	Share float64 `json:"share"`
+}
+
+type breakdownResponse struct {
+	Experience          []breakdownItemResponse `json:"experience"`
+	Employment          []breakdownItemResponse `json:"employment"`
+	Schedule            []breakdownItemResponse `json:"schedule"`
+	Source              []breakdownItemResponse `json:"source"`
+	RemoteShare         float64                 `json:"remote_share"`
+	Experience3To6Share float64                 `json:"experience_3_6_share"`
+}
+
+type coverageResponse struct {
+	TotalFound       int     `json:"total_found"`
+	IDsCollected     int     `json:"ids_collected"`
+	VacanciesFetched int     `json:"vacancies_fetched"`
+	PagesFailed      int     `json:"pages_failed"`
+	VacanciesFailed  int     `json:"vacancies_failed"`
+	Ratio            float64 `json:"ratio"`
+}
+
 type professionDetailResponse struct {
-	ProfessionID    string            `json:"profession_id"`
-	ProfessionName  string            `json:"profession_name"`
-	ScrapedAt       string            `json:"scraped_at"`
-	VacancyCount    int32             `json:"vacancy_count"`
-	FormalSkills    []skillResponse   `json:"formal_skills"`
-	ExtractedSkills []skillResponse   `json:"extracted_skills"`
-	Trend           []trendProfession `json:"trend,omitempty"`
+	ProfessionID              string               `json:"profession_id"`
+	ProfessionName            string               `json:"profession_name"`
+	Area                      string               `json:"area"`
+	ScrapedAt                 string               `json:"scraped_at"`
+	VacancyCount              int32                `json:"vacancy_count"`
+	FormalSkills              []skillResponse      `json:"formal_skills"`
+	ExtractedSkills           []skillResponse      `json:"extracted_skills"`
+	FormalSkillsByCategory    []skillGroupResponse `json:"formal_skills_by_category,omitempty"`
+	ExtractedSkillsByCategory []skillGroupResponse `json:"extracted_skills_by_category,omitempty"`
+	Salary                    *salaryResponse      `json:"salary"`
+	Breakdown                 *breakdownResponse   `json:"breakdown"`
+	Coverage                  *coverageResponse    `json:"coverage"`
+	Trend                     []trendProfession    `json:"trend,omitempty"`
 }
 
 func (h *ProfessionHandler) LastProfessionDetails(w http.ResponseWriter, r *http.Request) error {
@@ -91,9 +154,21 @@ func (h *ProfessionHandler) LastProfessionDetails(w http.ResponseWriter, r *http
 		return handler.StatusBadRequest("Invalid profession ID")
 	}
 
+	area, err := handler.QueryArea(r)
+	if err != nil {
+		log.Warn("profession_details_invalid_area", slogx.Err(err))
+		return err
+	}
+
 	includeTrend := r.URL.Query().Get("trend") == "true"
 
-	profession, err := h.provider.ProfessionSkills(ctx, professionID)
+	groupBy := r.URL.Query().Get("group_by")
+	if groupBy != "" && groupBy != groupByCategory {
+		log.Warn("profession_details_invalid_group_by", "group_by", groupBy)
+		return handler.StatusBadRequest("Invalid group_by, expected category")
+	}
+
+	profession, err := h.provider.ProfessionSkills(ctx, professionID, area)
 	if err != nil {
 		if errors.Is(err, domain.ErrProfessionNotFound) {
 			return handler.StatusNotFound("Profession not found")
@@ -106,6 +181,7 @@ func (h *ProfessionHandler) LastProfessionDetails(w http.ResponseWriter, r *http
 	resp := professionDetailResponse{
 		ProfessionID:    profession.ProfessionID.String(),
 		ProfessionName:  profession.ProfessionName,
+		Area:            profession.Area,
 		ScrapedAt:       profession.ScrapedAt,
 		VacancyCount:    profession.VacancyCount,
 		FormalSkills:    make([]skillResponse, len(profession.FormalSkills)),
@@ -114,20 +190,51 @@ func (h *ProfessionHandler) LastProfessionDetails(w http.ResponseWriter, r *http
 
 	for i, skill := range profession.FormalSkills {
 		resp.FormalSkills[i] = skillResponse{
-			Skill: skill.Skill,
-			Count: skill.Count,
+			Skill:      skill.Skill,
+			Count:      skill.Count,
+			Percentage: skill.Percentage,
 		}
 	}
 
 	for i, skill := range profession.ExtractedSkills {
 		resp.ExtractedSkills[i] = skillResponse{
-			Skill: skill.Skill,
-			Count: skill.Count,
+			Skill:      skill.Skill,
+			Count:      skill.Count,
+			Percentage: skill.Percentage,
+		}
+	}
+
+	if profession.Salary != nil {
+		resp.Salary = toSalaryResponse(*profession.Salary)
+	}
+
+	if profession.Breakdown != nil {
+		resp.Breakdown = toBreakdownResponse(*profession.Breakdown)
+	}
+
+	if profession.Coverage != nil {
+		resp.Coverage = toCoverageResponse(*profession.Coverage)
+	}
+
+	if groupBy == groupByCategory {
+		formalGroups, err := h.provider.GroupSkillsByCategory(ctx, profession.FormalSkills)
+		if err != nil {
+			log.Error("profession_skill_groups_failed", "profession_id", professionID, slogx.Err(err))
+			return handler.StatusInternalServerError("Failed to get profession details")
 		}
+
+		extractedGroups, err := h.provider.GroupSkillsByCategory(ctx, profession.ExtractedSkills)
+		if err != nil {
+			log.Error("profession_skill_groups_failed", "profession_id", professionID, slogx.Err(err))
+			return handler.StatusInternalServerError("Failed to get profession details")
+		}
+
+		resp.FormalSkillsByCategory = toSkillGroupResponses(formalGroups)
+		resp.ExtractedSkillsByCategory = toSkillGroupResponses(extractedGroups)
 	}
 
 	if includeTrend {
-		trend, err := h.provider.ProfessionTrend(ctx, professionID)
+		trend, err := h.provider.ProfessionTrend(ctx, professionID, area)
 		if err != nil {
 			if errors.Is(err, domain.ErrProfessionNotFound) {
 				return handler.StatusNotFound("Profession not found")
@@ -153,3 +260,217 @@ func (h *ProfessionHandler) LastProfessionDetails(w http.ResponseWriter, r *http
 	handler.RespondJSON(w, http.StatusOK, resp)
 	return nil
 }
+
+func toSkillGroupResponses(groups []domain.SkillGroup) []skillGroupResponse {
+	resp := make([]skillGroupResponse, len(groups))
+	for i, group := range groups {
+		skills := make([]groupedSkillResponse, len(group.Skills))
+		for j, skill := range group.Skills {
+			skills[j] = groupedSkillResponse{
+				Skill:      skill.Skill,
+				Count:      skill.Count,
+				Percentage: skill.Percentage,
+				Parent:     skill.Parent,
+			}
+		}
+
+		resp[i] = skillGroupResponse{
+			Category: group.Category,
+			Skills:   skills,
+		}
+	}
+
+	return resp
+}
+
+func toSalaryResponse(stat domain.SalaryStat) *salaryResponse {
+	return &salaryResponse{
+		SampleSize: stat.SampleSize,
+		P25:        stat.P25,
+		Median:     stat.Median,
+		P75:        stat.P75,
+	}
+}
+
+// toBreakdownResponse adds shares of vacancies with a known value of each dimension.
+func toBreakdownResponse(b domain.VacancyBreakdown) *breakdownResponse {
+	resp := &breakdownResponse{
+		Experience: toBreakdownItems(b.Experience),
+		Employment: toBreakdownItems(b.Employment),
+		Schedule:   toBreakdownItems(b.Schedule),
+		Source:     toBreakdownItems(b.Source),
+	}
+
+	for _, item := range resp.Schedule {
+		if item.Value == domain.ScheduleRemote {
+			resp.RemoteShare = item.Share
+		}
+	}
+
+	for _, item := range resp.Experience {
+		if item.Value == domain.ExperienceBetween3And6 {
+			resp.Experience3To6Share = item.Share
+		}
+	}
+
+	return resp
+}
+
+// toCoverageResponse adds the share of found vacancies the statistics were computed from.
+// A search that found nothing is fully covered.
+func toCoverageResponse(stats domain.FetchStats) *coverageResponse {
+	ratio := 1.0
+	if stats.TotalFound > 0 {
+		ratio = min(math.Round(float64(stats.VacanciesFetched)/float64(stats.TotalFound)*1000)/1000, 1)
+	}
+
+	return &coverageResponse{
+		TotalFound:       stats.TotalFound,
+		IDsCollected:     stats.IDsCollected,
+		VacanciesFetched: stats.VacanciesFetched,
+		PagesFailed:      stats.PagesFailed,
+		VacanciesFailed:  stats.VacanciesFailed,
+		Ratio:            ratio,
+	}
+}
+
+func toBreakdownItems(items []domain.BreakdownItem) []breakdownItemResponse {
+	var total int32
+	for _, item := range items {
+		total += item.Count
+	}
+
+	resp := make([]breakdownItemResponse, len(items))
+	for i, item := range items {
+		resp[i] = breakdownItemResponse{
+			Value: item.Value,
+			Count: item.Count,
+			Share: math.Round(float64(item.Count)/float64(total)*1000) / 1000,
+		}
+	}
+
+	return resp
+}
+
+type comparedProfessionResponse struct {
+	ProfessionID   string          `json:"profession_id"`
+	ProfessionName string          `json:"profession_name"`
+	VacancyCount   int32           `json:"vacancy_count"`
+	TopSkills      []skillResponse `json:"top_skills"`
+}
+
+type comparisonTrendPointResponse struct {
+	Date          string           `json:"date"`
+	VacancyCounts map[string]int32 `json:"vacancy_counts"`
+}
+
+type skillOverlapResponse struct {
+	Skill  string           `json:"skill"`
+	Counts map[string]int32 `json:"counts"`
+}
+
+type professionComparisonResponse struct {
+	Area         string                         `json:"area"`
+	ScrapedAt    string                         `json:"scraped_at"`
+	Professions  []comparedProfessionResponse   `json:"professions"`
+	Trend        []comparisonTrendPointResponse `json:"trend"`
+	SkillOverlap []skillOverlapResponse         `json:"skill_overlap"`
+}
+
+func (h *ProfessionHandler) CompareProfessions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	professionIDs, err := handler.QueryUUIDs(r, "ids")
+	if err != nil {
+		log.Warn("profession_compare_invalid_ids", slogx.Err(err))
+		return err
+	}
+
+	if len(professionIDs) < minComparedProfessions || len(professionIDs) > maxComparedProfessions {
+		return handler.StatusBadRequest(fmt.Sprintf("ids must contain from %d to %d professions",
+			minComparedProfessions, maxComparedProfessions))
+	}
+
+	topSkills, err := handler.QueryInt(r, "top", defaultCompareTopSkills, maxCompareTopSkills)
+	if err != nil {
+		log.Warn("profession_compare_invalid_top", slogx.Err(err))
+		return err
+	}
+
+	area, err := handler.QueryArea(r)
+	if err != nil {
+		log.Warn("profession_compare_invalid_area", slogx.Err(err))
+		return err
+	}
+
+	comparison, err := h.provider.CompareProfessions(ctx, professionIDs, area, topSkills)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return handler.StatusNotFound("Profession not found")
+		}
+		if errors.Is(err, domain.ErrInvalidComparison) {
+			return handler.StatusBadRequest("Invalid comparison parameters")
+		}
+
+		log.Error("profession_compare_failed", slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to compare professions")
+	}
+
+	log.Debug("profession_compare_success", "count", len(comparison.Professions))
+
+	handler.RespondJSON(w, http.StatusOK, toProfessionComparisonResponse(comparison))
+	return nil
+}
+
+func toProfessionComparisonResponse(comparison *domain.ProfessionComparison) professionComparisonResponse {
+	resp := professionComparisonResponse{
+		Area:         comparison.Area,
+		ScrapedAt:    comparison.ScrapedAt.Format(time.RFC3339),
+		Professions:  make([]comparedProfessionResponse, len(comparison.Professions)),
+		Trend:        make([]comparisonTrendPointResponse, len(comparison.Trend)),
+		SkillOverlap: make([]skillOverlapResponse, len(comparison.SkillOverlap)),
+	}
+
+	for i, p := range comparison.Professions {
+		skills := make([]skillResponse, len(p.TopSkills))
+		for j, skill := range p.TopSkills {
+			skills[j] = skillResponse{
+				Skill: skill.Skill,
+				Count: skill.Count,
+			}
+		}
+
+		resp.Professions[i] = comparedProfessionResponse{
+			ProfessionID:   p.ProfessionID.String(),
+			ProfessionName: p.ProfessionName,
+			VacancyCount:   p.VacancyCount,
+			TopSkills:      skills,
+		}
+	}
+
+	for i, point := range comparison.Trend {
+		resp.Trend[i] = comparisonTrendPointResponse{
+			Date:          point.Date.Format(time.RFC3339),
+			VacancyCounts: stringKeyCounts(point.VacancyCounts),
+		}
+	}
+
+	for i, overlap := range comparison.SkillOverlap {
+		resp.SkillOverlap[i] = skillOverlapResponse{
+			Skill:  overlap.Skill,
+			Counts: stringKeyCounts(overlap.Counts),
+		}
+	}
+
+	return resp
+}
+
+func stringKeyCounts(counts map[uuid.UUID]int32) map[string]int32 {
+	result := make(map[string]int32, len(counts))
+	for id, count := range counts {
+		result[id.String()] = count
+	}
+
+	return result
+}
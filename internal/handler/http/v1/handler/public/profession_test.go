@@ -143,6 +143,7 @@ func TestProfessionHandler_LastProfessionDetails_Unit_Success(t *testing.T) {
 	t.Parallel()
 
 	professionUUID := uuid.New()
+	goPercentage := 66.7
 
 	// Arrange
 	profDeps := newProfDeps(t)
@@ -153,15 +154,23 @@ func TestProfessionHandler_LastProfessionDetails_Unit_Success(t *testing.T) {
 		ScrapedAt:      "2024-01-01T00:00:00Z",
 		VacancyCount:   150,
 		FormalSkills: []domain.SkillResponse{
-			{Skill: "Go", Count: 100},
+			{Skill: "Go", Count: 100, Percentage: &goPercentage},
 			{Skill: "PostgreSQL", Count: 80},
 		},
 		ExtractedSkills: []domain.SkillResponse{
 			{Skill: "Microservices", Count: 60},
 		},
+		Salary: &domain.SalaryStat{SampleSize: 40, P25: 150000, Median: 200000, P75: 260000},
+		Breakdown: &domain.VacancyBreakdown{
+			Experience: []domain.BreakdownItem{{Value: "between3And6", Count: 60}, {Value: "between1And3", Count: 40}},
+			Employment: []domain.BreakdownItem{{Value: "full", Count: 100}},
+			Schedule:   []domain.BreakdownItem{{Value: "fullDay", Count: 75}, {Value: "remote", Count: 25}},
+			Source:     []domain.BreakdownItem{{Value: domain.SourceHH, Count: 90}, {Value: domain.SourceFile, Count: 10}},
+		},
+		Coverage: &domain.FetchStats{TotalFound: 8000, IDsCollected: 7900, VacanciesFetched: 7800, PagesFailed: 1, VacanciesFailed: 100},
 	}
 
-	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID).Return(detail, nil)
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
 
 	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
 
@@ -187,10 +196,40 @@ func TestProfessionHandler_LastProfessionDetails_Unit_Success(t *testing.T) {
 	assert.Len(t, formalSkills, 2)
 	assert.Equal(t, "Go", formalSkills[0].(map[string]any)["skill"])
 	assert.Equal(t, float64(100), formalSkills[0].(map[string]any)["count"])
+	assert.Equal(t, 66.7, formalSkills[0].(map[string]any)["percentage"])
+	assert.NotContains(t, formalSkills[1].(map[string]any), "percentage")
 
 	extractedSkills := resp["extracted_skills"].([]any)
 	assert.Len(t, extractedSkills, 1)
 	assert.Equal(t, "Microservices", extractedSkills[0].(map[string]any)["skill"])
+
+	salary := resp["salary"].(map[string]any)
+	assert.Equal(t, float64(40), salary["sample_size"])
+	assert.Equal(t, float64(150000), salary["p25"])
+	assert.Equal(t, float64(200000), salary["median"])
+	assert.Equal(t, float64(260000), salary["p75"])
+
+	breakdown := resp["breakdown"].(map[string]any)
+	assert.Equal(t, 0.25, breakdown["remote_share"])
+	assert.Equal(t, 0.6, breakdown["experience_3_6_share"])
+	experience := breakdown["experience"].([]any)
+	require.Len(t, experience, 2)
+	assert.Equal(t, "between1And3", experience[1].(map[string]any)["value"])
+	assert.Equal(t, float64(40), experience[1].(map[string]any)["count"])
+	assert.Equal(t, 0.4, experience[1].(map[string]any)["share"])
+	assert.Equal(t, 1.0, breakdown["employment"].([]any)[0].(map[string]any)["share"])
+	source := breakdown["source"].([]any)
+	require.Len(t, source, 2)
+	assert.Equal(t, domain.SourceFile, source[1].(map[string]any)["value"])
+	assert.Equal(t, 0.1, source[1].(map[string]any)["share"])
+
+	coverage := resp["coverage"].(map[string]any)
+	assert.Equal(t, float64(8000), coverage["total_found"])
+	assert.Equal(t, float64(7900), coverage["ids_collected"])
+	assert.Equal(t, float64(7800), coverage["vacancies_fetched"])
+	assert.Equal(t, float64(1), coverage["pages_failed"])
+	assert.Equal(t, float64(100), coverage["vacancies_failed"])
+	assert.Equal(t, 0.975, coverage["ratio"])
 }
 
 func TestProfessionHandler_LastProfessionDetails_Unit_SuccessWithTrend(t *testing.T) {
@@ -220,8 +259,8 @@ func TestProfessionHandler_LastProfessionDetails_Unit_SuccessWithTrend(t *testin
 		},
 	}
 
-	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID).Return(detail, nil)
-	profDeps.provider.EXPECT().ProfessionTrend(mock.Anything, professionUUID).Return(trendData, nil)
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
+	profDeps.provider.EXPECT().ProfessionTrend(mock.Anything, professionUUID, "113").Return(trendData, nil)
 
 	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
 
@@ -266,7 +305,7 @@ func TestProfessionHandler_LastProfessionDetails_Unit_WithoutTrend(t *testing.T)
 	}
 
 	// ProfessionTrend НЕ должен вызываться
-	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID).Return(detail, nil)
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
 
 	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
 
@@ -287,6 +326,8 @@ func TestProfessionHandler_LastProfessionDetails_Unit_WithoutTrend(t *testing.T)
 	// trend не должен присутствовать в ответе
 	_, hasTrend := resp["trend"]
 	assert.False(t, hasTrend, "trend should not be present when trend=false")
+	// сбор без данных о выборке возвращает coverage = null
+	assert.Nil(t, resp["coverage"])
 }
 
 func TestProfessionHandler_LastProfessionDetails_Unit_EmptyTrend(t *testing.T) {
@@ -312,8 +353,8 @@ func TestProfessionHandler_LastProfessionDetails_Unit_EmptyTrend(t *testing.T) {
 		Data:           []domain.StatDailyPoint{},
 	}
 
-	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID).Return(detail, nil)
-	profDeps.provider.EXPECT().ProfessionTrend(mock.Anything, professionUUID).Return(trendData, nil)
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
+	profDeps.provider.EXPECT().ProfessionTrend(mock.Anything, professionUUID, "113").Return(trendData, nil)
 
 	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
 
@@ -361,6 +402,64 @@ func TestProfessionHandler_LastProfessionDetails_Unit_InvalidUUID(t *testing.T)
 	assert.Contains(t, resp["error"], "Invalid profession ID")
 }
 
+func TestProfessionHandler_LastProfessionDetails_Unit_Area(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	detail := &domain.ProfessionDetail{
+		ProfessionID:   professionUUID,
+		ProfessionName: "Go Developer",
+		Area:           "1",
+	}
+
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "1").Return(detail, nil)
+
+	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/details?area=1", nil)
+	rr := httptest.NewRecorder()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/details", h.ServeHTTP)
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp map[string]any
+	decodeProfResponse(t, rr, &resp)
+	assert.Equal(t, "1", resp["area"])
+}
+
+func TestProfessionHandler_LastProfessionDetails_Unit_InvalidArea(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+uuid.New().String()+"/details?area=moscow", nil)
+	rr := httptest.NewRecorder()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/details", h.ServeHTTP)
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var resp map[string]string
+	decodeProfResponse(t, rr, &resp)
+	assert.Equal(t, "invalid value for parameter: area", resp["error"])
+}
+
 func TestProfessionHandler_LastProfessionDetails_Unit_NotFound(t *testing.T) {
 	t.Parallel()
 
@@ -369,7 +468,7 @@ func TestProfessionHandler_LastProfessionDetails_Unit_NotFound(t *testing.T) {
 	// Arrange
 	profDeps := newProfDeps(t)
 
-	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID).Return(nil, domain.ErrProfessionNotFound)
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(nil, domain.ErrProfessionNotFound)
 
 	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
 
@@ -397,7 +496,7 @@ func TestProfessionHandler_LastProfessionDetails_Unit_ServiceError(t *testing.T)
 	// Arrange
 	profDeps := newProfDeps(t)
 
-	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID).Return(nil, assert.AnError)
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(nil, assert.AnError)
 
 	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
 
@@ -434,8 +533,8 @@ func TestProfessionHandler_LastProfessionDetails_Unit_TrendError_NotFound(t *tes
 		ExtractedSkills: []domain.SkillResponse{},
 	}
 
-	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID).Return(detail, nil)
-	profDeps.provider.EXPECT().ProfessionTrend(mock.Anything, professionUUID).Return(nil, domain.ErrProfessionNotFound)
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
+	profDeps.provider.EXPECT().ProfessionTrend(mock.Anything, professionUUID, "113").Return(nil, domain.ErrProfessionNotFound)
 
 	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
 
@@ -472,8 +571,8 @@ func TestProfessionHandler_LastProfessionDetails_Unit_TrendError_Internal(t *tes
 		ExtractedSkills: []domain.SkillResponse{},
 	}
 
-	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID).Return(detail, nil)
-	profDeps.provider.EXPECT().ProfessionTrend(mock.Anything, professionUUID).Return(nil, assert.AnError)
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
+	profDeps.provider.EXPECT().ProfessionTrend(mock.Anything, professionUUID, "113").Return(nil, assert.AnError)
 
 	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
 
@@ -492,3 +591,345 @@ func TestProfessionHandler_LastProfessionDetails_Unit_TrendError_Internal(t *tes
 	decodeProfResponse(t, rr, &resp)
 	assert.Equal(t, "Failed to get profession details", resp["error"])
 }
+
+func TestProfessionHandler_LastProfessionDetails_Unit_GroupByCategory(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	formalSkills := []domain.SkillResponse{{Skill: "go", Count: 40}, {Skill: "gin", Count: 20}}
+	extractedSkills := []domain.SkillResponse{{Skill: "grpc", Count: 10}}
+
+	detail := &domain.ProfessionDetail{
+		ProfessionID:    professionUUID,
+		ProfessionName:  "Go Developer",
+		ScrapedAt:       "2024-01-01T00:00:00Z",
+		VacancyCount:    150,
+		FormalSkills:    formalSkills,
+		ExtractedSkills: extractedSkills,
+	}
+
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
+	profDeps.provider.EXPECT().GroupSkillsByCategory(mock.Anything, formalSkills).Return([]domain.SkillGroup{
+		{Category: domain.SkillCategoryLanguage, Skills: []domain.GroupedSkill{{Skill: "go", Count: 40}}},
+		{Category: domain.SkillCategoryFramework, Skills: []domain.GroupedSkill{{Skill: "gin", Count: 20, Parent: "go"}}},
+	}, nil)
+	profDeps.provider.EXPECT().GroupSkillsByCategory(mock.Anything, extractedSkills).Return([]domain.SkillGroup{
+		{Category: domain.SkillCategoryOther, Skills: []domain.GroupedSkill{{Skill: "grpc", Count: 10}}},
+	}, nil)
+
+	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/details?group_by=category", nil)
+	rr := httptest.NewRecorder()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/details", h.ServeHTTP)
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp map[string]any
+	decodeProfResponse(t, rr, &resp)
+	assert.Len(t, resp["formal_skills"], 2)
+
+	formalGroups := resp["formal_skills_by_category"].([]any)
+	require.Len(t, formalGroups, 2)
+	assert.Equal(t, "language", formalGroups[0].(map[string]any)["category"])
+
+	framework := formalGroups[1].(map[string]any)
+	assert.Equal(t, "framework", framework["category"])
+	gin := framework["skills"].([]any)[0].(map[string]any)
+	assert.Equal(t, "gin", gin["skill"])
+	assert.Equal(t, "go", gin["parent"])
+
+	extractedGroups := resp["extracted_skills_by_category"].([]any)
+	require.Len(t, extractedGroups, 1)
+	assert.Equal(t, "other", extractedGroups[0].(map[string]any)["category"])
+}
+
+func TestProfessionHandler_LastProfessionDetails_Unit_WithoutGroupBy(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	detail := &domain.ProfessionDetail{
+		ProfessionID:    professionUUID,
+		ProfessionName:  "Go Developer",
+		FormalSkills:    []domain.SkillResponse{{Skill: "go", Count: 40}},
+		ExtractedSkills: []domain.SkillResponse{},
+	}
+
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
+
+	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/details", nil)
+	rr := httptest.NewRecorder()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/details", h.ServeHTTP)
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp map[string]any
+	decodeProfResponse(t, rr, &resp)
+	assert.NotContains(t, resp, "formal_skills_by_category")
+	assert.NotContains(t, resp, "extracted_skills_by_category")
+	profDeps.provider.AssertNotCalled(t, "GroupSkillsByCategory", mock.Anything, mock.Anything)
+}
+
+func TestProfessionHandler_LastProfessionDetails_Unit_InvalidGroupBy(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/details?group_by=parent", nil)
+	rr := httptest.NewRecorder()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/details", h.ServeHTTP)
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var resp map[string]string
+	decodeProfResponse(t, rr, &resp)
+	assert.Contains(t, resp["error"], "Invalid group_by")
+	profDeps.provider.AssertNotCalled(t, "ProfessionSkills", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProfessionHandler_LastProfessionDetails_Unit_GroupByCategoryError(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	detail := &domain.ProfessionDetail{
+		ProfessionID:    professionUUID,
+		ProfessionName:  "Go Developer",
+		FormalSkills:    []domain.SkillResponse{{Skill: "go", Count: 40}},
+		ExtractedSkills: []domain.SkillResponse{},
+	}
+
+	profDeps.provider.EXPECT().ProfessionSkills(mock.Anything, professionUUID, "113").Return(detail, nil)
+	profDeps.provider.EXPECT().GroupSkillsByCategory(mock.Anything, detail.FormalSkills).Return(nil, assert.AnError)
+
+	h := handler.Handle(profDeps.profHandler().LastProfessionDetails)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/details?group_by=category", nil)
+	rr := httptest.NewRecorder()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/details", h.ServeHTTP)
+	mux.ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+
+	var resp map[string]string
+	decodeProfResponse(t, rr, &resp)
+	assert.Equal(t, "Failed to get profession details", resp["error"])
+}
+
+// ==================== CompareProfessions ====================
+
+func TestProfessionHandler_CompareProfessions_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	goID := uuid.New()
+	pyID := uuid.New()
+	scrapedAt := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	day := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	comparison := &domain.ProfessionComparison{
+		ScrapedAt: scrapedAt,
+		Professions: []domain.ComparedProfession{
+			{ProfessionID: goID, ProfessionName: "Go Developer", VacancyCount: 500,
+				TopSkills: []domain.SkillResponse{{Skill: "golang", Count: 300}, {Skill: "sql", Count: 100}}},
+			{ProfessionID: pyID, ProfessionName: "Python Developer", VacancyCount: 800,
+				TopSkills: []domain.SkillResponse{{Skill: "python", Count: 600}, {Skill: "sql", Count: 200}}},
+		},
+		Trend: []domain.ComparisonTrendPoint{
+			{Date: day, VacancyCounts: map[uuid.UUID]int32{goID: 500, pyID: 800}},
+		},
+		SkillOverlap: []domain.SkillOverlap{
+			{Skill: "sql", Counts: map[uuid.UUID]int32{goID: 100, pyID: 200}},
+		},
+	}
+
+	// Дубликаты отбрасываются до вызова сервиса
+	profDeps.provider.EXPECT().CompareProfessions(mock.Anything, []uuid.UUID{goID, pyID}, "113", 20).
+		Return(comparison, nil)
+
+	h := handler.Handle(profDeps.profHandler().CompareProfessions)
+
+	// Act
+	rr := doProfRequest(t, h, http.MethodGet, "/professions/compare?ids="+goID.String()+","+pyID.String()+","+goID.String())
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp struct {
+		ScrapedAt   string `json:"scraped_at"`
+		Professions []struct {
+			ProfessionID   string `json:"profession_id"`
+			ProfessionName string `json:"profession_name"`
+			VacancyCount   int32  `json:"vacancy_count"`
+			TopSkills      []struct {
+				Skill string `json:"skill"`
+				Count int32  `json:"count"`
+			} `json:"top_skills"`
+		} `json:"professions"`
+		Trend []struct {
+			Date          string           `json:"date"`
+			VacancyCounts map[string]int32 `json:"vacancy_counts"`
+		} `json:"trend"`
+		SkillOverlap []struct {
+			Skill  string           `json:"skill"`
+			Counts map[string]int32 `json:"counts"`
+		} `json:"skill_overlap"`
+	}
+	decodeProfResponse(t, rr, &resp)
+
+	assert.Equal(t, "2025-03-01T03:00:00Z", resp.ScrapedAt)
+	require.Len(t, resp.Professions, 2)
+	assert.Equal(t, goID.String(), resp.Professions[0].ProfessionID)
+	assert.Equal(t, int32(500), resp.Professions[0].VacancyCount)
+	assert.Len(t, resp.Professions[0].TopSkills, 2)
+	require.Len(t, resp.Trend, 1)
+	assert.Equal(t, "2025-02-28T00:00:00Z", resp.Trend[0].Date)
+	assert.Equal(t, int32(800), resp.Trend[0].VacancyCounts[pyID.String()])
+	require.Len(t, resp.SkillOverlap, 1)
+	assert.Equal(t, "sql", resp.SkillOverlap[0].Skill)
+	assert.Equal(t, map[string]int32{goID.String(): 100, pyID.String(): 200}, resp.SkillOverlap[0].Counts)
+}
+
+func TestProfessionHandler_CompareProfessions_Unit_CustomTop(t *testing.T) {
+	t.Parallel()
+
+	goID := uuid.New()
+	pyID := uuid.New()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	profDeps.provider.EXPECT().CompareProfessions(mock.Anything, []uuid.UUID{goID, pyID}, "113", 5).
+		Return(&domain.ProfessionComparison{}, nil)
+
+	h := handler.Handle(profDeps.profHandler().CompareProfessions)
+
+	// Act
+	rr := doProfRequest(t, h, http.MethodGet, "/professions/compare?top=5&ids="+goID.String()+","+pyID.String())
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestProfessionHandler_CompareProfessions_Unit_BadRequest(t *testing.T) {
+	t.Parallel()
+
+	id1 := uuid.New().String()
+	id2 := uuid.New().String()
+	tooMany := id1
+	for range 10 {
+		tooMany += "," + uuid.New().String()
+	}
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "missing ids", query: ""},
+		{name: "invalid uuid", query: "?ids=" + id1 + ",invalid"},
+		{name: "single profession", query: "?ids=" + id1},
+		{name: "duplicate profession", query: "?ids=" + id1 + "," + id1},
+		{name: "too many professions", query: "?ids=" + tooMany},
+		{name: "invalid top", query: "?top=abc&ids=" + id1 + "," + id2},
+		{name: "top out of range", query: "?top=101&ids=" + id1 + "," + id2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			profDeps := newProfDeps(t)
+			h := handler.Handle(profDeps.profHandler().CompareProfessions)
+
+			// Act
+			rr := doProfRequest(t, h, http.MethodGet, "/professions/compare"+tt.query)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestProfessionHandler_CompareProfessions_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	profDeps.provider.EXPECT().CompareProfessions(mock.Anything, mock.Anything, "113", mock.Anything).
+		Return(nil, domain.ErrProfessionNotFound)
+
+	h := handler.Handle(profDeps.profHandler().CompareProfessions)
+
+	// Act
+	rr := doProfRequest(t, h, http.MethodGet, "/professions/compare?ids="+uuid.New().String()+","+uuid.New().String())
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	var resp map[string]string
+	decodeProfResponse(t, rr, &resp)
+	assert.Equal(t, "Profession not found", resp["error"])
+}
+
+func TestProfessionHandler_CompareProfessions_Unit_ServiceError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	profDeps := newProfDeps(t)
+
+	profDeps.provider.EXPECT().CompareProfessions(mock.Anything, mock.Anything, "113", mock.Anything).
+		Return(nil, assert.AnError)
+
+	h := handler.Handle(profDeps.profHandler().CompareProfessions)
+
+	// Act
+	rr := doProfRequest(t, h, http.MethodGet, "/professions/compare?ids="+uuid.New().String()+","+uuid.New().String())
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+
+	var resp map[string]string
+	decodeProfResponse(t, rr, &resp)
+	assert.Equal(t, "Failed to compare professions", resp["error"])
+}
@@ -0,0 +1,195 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+type SkillProvider interface {
+	ProfessionSkillHistory(
+		ctx context.Context,
+		professionID uuid.UUID,
+		area string,
+		from, to time.Time,
+		skill string,
+	) (*domain.ProfessionSkillHistory, error)
+	ProfessionSkillSalaries(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionSkillSalaries, error)
+}
+
+type SkillHandler struct {
+	provider SkillProvider
+}
+
+func NewSkillHandler(provider SkillProvider) *SkillHandler {
+	return &SkillHandler{
+		provider: provider,
+	}
+}
+
+type skillHistoryPoint struct {
+	ScrapedAt string `json:"scraped_at"`
+	Count     int32  `json:"count"`
+}
+
+type skillHistory struct {
+	Skill string              `json:"skill"`
+	Data  []skillHistoryPoint `json:"data"`
+}
+
+type skillHistoryResponse struct {
+	ProfessionID    string         `json:"profession_id"`
+	ProfessionName  string         `json:"profession_name"`
+	Area            string         `json:"area"`
+	From            string         `json:"from"`
+	To              string         `json:"to"`
+	FormalSkills    []skillHistory `json:"formal_skills"`
+	ExtractedSkills []skillHistory `json:"extracted_skills"`
+}
+
+func (h *SkillHandler) GetSkillHistory(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	professionID, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("skill_history_invalid_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid profession ID")
+	}
+
+	area, err := handler.QueryArea(r)
+	if err != nil {
+		log.Warn("skill_history_invalid_area", slogx.Err(err))
+		return err
+	}
+
+	from, to, err := handler.QueryDateRange(r)
+	if err != nil {
+		log.Warn("skill_history_invalid_range", slogx.Err(err))
+		return err
+	}
+
+	skill := r.URL.Query().Get("skill")
+
+	history, err := h.provider.ProfessionSkillHistory(ctx, professionID, area, from, to, skill)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrProfessionNotFound):
+			return handler.StatusNotFound("Profession not found")
+		case errors.Is(err, domain.ErrInvalidDateRange):
+			return handler.StatusBadRequest("Invalid date range")
+		}
+		log.Error("skill_history_failed", "profession_id", professionID, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to get skill history")
+	}
+
+	resp := skillHistoryResponse{
+		ProfessionID:    history.ProfessionID.String(),
+		ProfessionName:  history.ProfessionName,
+		Area:            history.Area,
+		From:            history.From.Format(time.RFC3339),
+		To:              history.To.Format(time.RFC3339),
+		FormalSkills:    toSkillHistoryResponse(history.FormalSkills),
+		ExtractedSkills: toSkillHistoryResponse(history.ExtractedSkills),
+	}
+
+	log.Debug("skill_history_success", "profession_id", professionID,
+		"formal_count", len(resp.FormalSkills), "extracted_count", len(resp.ExtractedSkills))
+
+	handler.RespondJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func toSkillHistoryResponse(history []domain.SkillHistory) []skillHistory {
+	resp := make([]skillHistory, len(history))
+	for i, h := range history {
+		points := make([]skillHistoryPoint, len(h.Data))
+		for j, p := range h.Data {
+			points[j] = skillHistoryPoint{
+				ScrapedAt: p.ScrapedAt.Format(time.RFC3339),
+				Count:     p.Count,
+			}
+		}
+		resp[i] = skillHistory{
+			Skill: h.Skill,
+			Data:  points,
+		}
+	}
+
+	return resp
+}
+
+type skillSalaryResponse struct {
+	Skill         string `json:"skill"`
+	WithCount     int32  `json:"with_count"`
+	WithMedian    int32  `json:"with_median"`
+	WithoutCount  int32  `json:"without_count"`
+	WithoutMedian int32  `json:"without_median"`
+	Difference    int32  `json:"difference"`
+}
+
+type skillSalaryImpactResponse struct {
+	ProfessionID   string                `json:"profession_id"`
+	ProfessionName string                `json:"profession_name"`
+	Area           string                `json:"area"`
+	ScrapedAt      string                `json:"scraped_at"`
+	Skills         []skillSalaryResponse `json:"skills"`
+}
+
+func (h *SkillHandler) GetSkillSalaryImpact(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	professionID, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("skill_salary_impact_invalid_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid profession ID")
+	}
+
+	area, err := handler.QueryArea(r)
+	if err != nil {
+		log.Warn("skill_salary_impact_invalid_area", slogx.Err(err))
+		return err
+	}
+
+	impact, err := h.provider.ProfessionSkillSalaries(ctx, professionID, area)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return handler.StatusNotFound("Profession not found")
+		}
+		log.Error("skill_salary_impact_failed", "profession_id", professionID, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to get skill salary impact")
+	}
+
+	resp := skillSalaryImpactResponse{
+		ProfessionID:   impact.ProfessionID.String(),
+		ProfessionName: impact.ProfessionName,
+		Area:           impact.Area,
+		ScrapedAt:      impact.ScrapedAt.Format(time.RFC3339),
+		Skills:         make([]skillSalaryResponse, len(impact.Skills)),
+	}
+
+	for i, skill := range impact.Skills {
+		resp.Skills[i] = skillSalaryResponse{
+			Skill:         skill.Skill,
+			WithCount:     skill.WithCount,
+			WithMedian:    skill.WithMedian,
+			WithoutCount:  skill.WithoutCount,
+			WithoutMedian: skill.WithoutMedian,
+			Difference:    skill.WithMedian - skill.WithoutMedian,
+		}
+	}
+
+	log.Debug("skill_salary_impact_success", "profession_id", professionID, "skill_count", len(resp.Skills))
+
+	handler.RespondJSON(w, http.StatusOK, resp)
+	return nil
+}
@@ -0,0 +1,343 @@
+package public_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/handler/http/v1/handler"
+	"psa/internal/handler/http/v1/handler/public"
+	"psa/internal/handler/http/v1/handler/public/mocks"
+)
+
+// skillTestDeps содержит зависимости для тестирования SkillHandler
+type skillTestDeps struct {
+	skillProvider *mocks.MockSkillProvider
+}
+
+func newSkillDeps(t *testing.T) skillTestDeps {
+	t.Helper()
+	return skillTestDeps{
+		skillProvider: mocks.NewMockSkillProvider(t),
+	}
+}
+
+func (d skillTestDeps) skillHandler() *public.SkillHandler {
+	return public.NewSkillHandler(d.skillProvider)
+}
+
+func decodeSkillResponse(t *testing.T, rr *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	err := json.Unmarshal(rr.Body.Bytes(), v)
+	require.NoError(t, err)
+}
+
+func routeSkill(h http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/skills/history", h.ServeHTTP)
+	return mux
+}
+
+func routeSkillSalaryImpact(h http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/skills/salary-impact", h.ServeHTTP)
+	return mux
+}
+
+// ==================== GetSkillHistory ====================
+
+func TestSkillHandler_GetSkillHistory_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC)
+	jan := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	history := &domain.ProfessionSkillHistory{
+		ProfessionID:   professionUUID,
+		ProfessionName: "Go Developer",
+		From:           from,
+		To:             to,
+		FormalSkills: []domain.SkillHistory{
+			{Skill: "kubernetes", Data: []domain.SkillHistoryPoint{{ScrapedAt: jan, Count: 20}}},
+		},
+		ExtractedSkills: []domain.SkillHistory{},
+	}
+
+	skillDeps.skillProvider.EXPECT().ProfessionSkillHistory(mock.Anything, professionUUID, "113", from, to, "kubernetes").
+		Return(history, nil)
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillHistory)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet,
+		"/professions/"+professionUUID.String()+"/skills/history?from=2025-01-01&to=2025-12-31&skill=kubernetes", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkill(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp map[string]any
+	decodeSkillResponse(t, rr, &resp)
+	assert.Equal(t, professionUUID.String(), resp["profession_id"])
+	assert.Equal(t, "Go Developer", resp["profession_name"])
+
+	formal := resp["formal_skills"].([]any)
+	require.Len(t, formal, 1)
+	assert.Equal(t, "kubernetes", formal[0].(map[string]any)["skill"])
+
+	data := formal[0].(map[string]any)["data"].([]any)
+	require.Len(t, data, 1)
+	assert.Equal(t, jan.Format(time.RFC3339), data[0].(map[string]any)["scraped_at"])
+	assert.Equal(t, float64(20), data[0].(map[string]any)["count"])
+}
+
+func TestSkillHandler_GetSkillHistory_Unit_DefaultRange(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	skillDeps.skillProvider.EXPECT().ProfessionSkillHistory(mock.Anything, professionUUID, "113", mock.Anything, mock.Anything, "").
+		RunAndReturn(func(_ context.Context, id uuid.UUID, _ string, from, to time.Time, _ string) (*domain.ProfessionSkillHistory, error) {
+			// По умолчанию — последний год
+			assert.Equal(t, to.AddDate(-1, 0, 0), from)
+			return &domain.ProfessionSkillHistory{ProfessionID: id, From: from, To: to}, nil
+		})
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillHistory)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/skills/history", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkill(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestSkillHandler_GetSkillHistory_Unit_InvalidDate(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillHistory)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+uuid.New().String()+"/skills/history?from=yesterday", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkill(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var resp map[string]string
+	decodeSkillResponse(t, rr, &resp)
+	assert.Contains(t, resp["error"], "from")
+}
+
+func TestSkillHandler_GetSkillHistory_Unit_FromAfterTo(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillHistory)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet,
+		"/professions/"+uuid.New().String()+"/skills/history?from=2025-12-01&to=2025-01-01", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkill(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestSkillHandler_GetSkillHistory_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	skillDeps.skillProvider.EXPECT().ProfessionSkillHistory(mock.Anything, professionUUID, "113", mock.Anything, mock.Anything, "").
+		Return(nil, domain.ErrProfessionNotFound)
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillHistory)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/skills/history", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkill(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestSkillHandler_GetSkillHistory_Unit_ServiceError(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	skillDeps.skillProvider.EXPECT().ProfessionSkillHistory(mock.Anything, professionUUID, "113", mock.Anything, mock.Anything, "").
+		Return(nil, errors.New("database error"))
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillHistory)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/skills/history", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkill(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+
+	var resp map[string]string
+	decodeSkillResponse(t, rr, &resp)
+	assert.Equal(t, "Failed to get skill history", resp["error"])
+}
+
+// ==================== GetSkillSalaryImpact ====================
+
+func TestSkillHandler_GetSkillSalaryImpact_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+	scrapedAt := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	impact := &domain.ProfessionSkillSalaries{
+		ProfessionID:   professionUUID,
+		ProfessionName: "Go Developer",
+		ScrapedAt:      scrapedAt,
+		Skills: []domain.SkillSalary{
+			{Skill: "kubernetes", WithCount: 30, WithMedian: 320000, WithoutCount: 70, WithoutMedian: 250000},
+			{Skill: "php", WithCount: 5, WithMedian: 180000, WithoutCount: 95, WithoutMedian: 260000},
+		},
+	}
+
+	skillDeps.skillProvider.EXPECT().ProfessionSkillSalaries(mock.Anything, professionUUID, "113").Return(impact, nil)
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillSalaryImpact)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/skills/salary-impact", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkillSalaryImpact(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp map[string]any
+	decodeSkillResponse(t, rr, &resp)
+	assert.Equal(t, professionUUID.String(), resp["profession_id"])
+	assert.Equal(t, scrapedAt.Format(time.RFC3339), resp["scraped_at"])
+
+	skills := resp["skills"].([]any)
+	require.Len(t, skills, 2)
+	first := skills[0].(map[string]any)
+	assert.Equal(t, "kubernetes", first["skill"])
+	assert.Equal(t, float64(320000), first["with_median"])
+	assert.Equal(t, float64(250000), first["without_median"])
+	assert.Equal(t, float64(70000), first["difference"])
+	assert.Equal(t, float64(-80000), skills[1].(map[string]any)["difference"])
+}
+
+func TestSkillHandler_GetSkillSalaryImpact_Unit_InvalidUUID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillSalaryImpact)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/not-a-uuid/skills/salary-impact", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkillSalaryImpact(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	skillDeps.skillProvider.AssertNotCalled(t, "ProfessionSkillSalaries")
+}
+
+func TestSkillHandler_GetSkillSalaryImpact_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	skillDeps.skillProvider.EXPECT().ProfessionSkillSalaries(mock.Anything, professionUUID, "113").Return(nil, domain.ErrProfessionNotFound)
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillSalaryImpact)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/skills/salary-impact", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkillSalaryImpact(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestSkillHandler_GetSkillSalaryImpact_Unit_ServiceError(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	skillDeps := newSkillDeps(t)
+
+	skillDeps.skillProvider.EXPECT().ProfessionSkillSalaries(mock.Anything, professionUUID, "113").Return(nil, assert.AnError)
+
+	h := handler.Handle(skillDeps.skillHandler().GetSkillSalaryImpact)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/skills/salary-impact", nil)
+	rr := httptest.NewRecorder()
+
+	routeSkillSalaryImpact(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+
+	var resp map[string]string
+	decodeSkillResponse(t, rr, &resp)
+	assert.Equal(t, "Failed to get skill salary impact", resp["error"])
+}
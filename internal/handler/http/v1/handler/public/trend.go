@@ -15,7 +15,8 @@ import (
 )
 
 type TrendProvider interface {
-	ProfessionTrend(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error)
+	ProfessionTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error)
+	ProfessionSalaryTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionSalaryTrend, error)
 }
 
 type TrendHandler struct {
@@ -36,6 +37,7 @@ type trendPoint struct {
 type professionTrendResponse struct {
 	ProfessionID   string       `json:"profession_id"`
 	ProfessionName string       `json:"profession_name"`
+	Area           string       `json:"area"`
 	Data           []trendPoint `json:"data"`
 }
 
@@ -49,7 +51,13 @@ func (h *TrendHandler) GetProfessionTrend(w http.ResponseWriter, r *http.Request
 		return handler.StatusBadRequest("Invalid profession ID")
 	}
 
-	trend, err := h.provider.ProfessionTrend(ctx, professionID)
+	area, err := handler.QueryArea(r)
+	if err != nil {
+		log.Warn("trend_invalid_area", slogx.Err(err))
+		return err
+	}
+
+	trend, err := h.provider.ProfessionTrend(ctx, professionID, area)
 	if err != nil {
 		if errors.Is(err, domain.ErrProfessionNotFound) {
 			return handler.StatusNotFound("Profession trend not found")
@@ -61,6 +69,7 @@ func (h *TrendHandler) GetProfessionTrend(w http.ResponseWriter, r *http.Request
 	resp := professionTrendResponse{
 		ProfessionID:   trend.ProfessionID.String(),
 		ProfessionName: trend.ProfessionName,
+		Area:           trend.Area,
 		Data:           make([]trendPoint, len(trend.Data)),
 	}
 
@@ -76,3 +85,60 @@ func (h *TrendHandler) GetProfessionTrend(w http.ResponseWriter, r *http.Request
 	handler.RespondJSON(w, http.StatusOK, resp)
 	return nil
 }
+
+type salaryTrendPoint struct {
+	ScrapedAt string `json:"scraped_at"`
+	salaryResponse
+}
+
+type professionSalaryTrendResponse struct {
+	ProfessionID   string             `json:"profession_id"`
+	ProfessionName string             `json:"profession_name"`
+	Area           string             `json:"area"`
+	Data           []salaryTrendPoint `json:"data"`
+}
+
+func (h *TrendHandler) GetSalaryTrend(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	log := loggerctx.FromContext(ctx)
+
+	professionID, err := handler.PathUUID(r, "id")
+	if err != nil {
+		log.Warn("salary_trend_invalid_id", slogx.Err(err))
+		return handler.StatusBadRequest("Invalid profession ID")
+	}
+
+	area, err := handler.QueryArea(r)
+	if err != nil {
+		log.Warn("salary_trend_invalid_area", slogx.Err(err))
+		return err
+	}
+
+	trend, err := h.provider.ProfessionSalaryTrend(ctx, professionID, area)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return handler.StatusNotFound("Profession not found")
+		}
+		log.Error("salary_trend_failed", "profession_id", professionID, slogx.Err(err))
+		return handler.StatusInternalServerError("Failed to get salary trend")
+	}
+
+	resp := professionSalaryTrendResponse{
+		ProfessionID:   trend.ProfessionID.String(),
+		ProfessionName: trend.ProfessionName,
+		Area:           trend.Area,
+		Data:           make([]salaryTrendPoint, len(trend.Data)),
+	}
+
+	for i, point := range trend.Data {
+		resp.Data[i] = salaryTrendPoint{
+			ScrapedAt:      point.ScrapedAt.Format(time.RFC3339),
+			salaryResponse: *toSalaryResponse(point.SalaryStat),
+		}
+	}
+
+	log.Debug("salary_trend_success", "profession_id", professionID, "points_count", len(trend.Data))
+
+	handler.RespondJSON(w, http.StatusOK, resp)
+	return nil
+}
@@ -46,6 +46,12 @@ func routeTrend(h http.Handler) http.Handler {
 	return mux
 }
 
+func routeSalaryTrend(h http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /professions/{id}/salary/trend", h.ServeHTTP)
+	return mux
+}
+
 // ==================== GetProfessionTrend ====================
 
 func TestTrendHandler_GetProfessionTrend_Unit_Success(t *testing.T) {
@@ -67,7 +73,7 @@ func TestTrendHandler_GetProfessionTrend_Unit_Success(t *testing.T) {
 		},
 	}
 
-	trendDeps.trendProvider.EXPECT().ProfessionTrend(mock.Anything, professionUUID).Return(trendData, nil)
+	trendDeps.trendProvider.EXPECT().ProfessionTrend(mock.Anything, professionUUID, "113").Return(trendData, nil)
 
 	h := handler.Handle(trendDeps.trendHandler().GetProfessionTrend)
 
@@ -107,7 +113,7 @@ func TestTrendHandler_GetProfessionTrend_Unit_EmptyTrend(t *testing.T) {
 		Data:           []domain.StatDailyPoint{},
 	}
 
-	trendDeps.trendProvider.EXPECT().ProfessionTrend(mock.Anything, professionUUID).Return(trendData, nil)
+	trendDeps.trendProvider.EXPECT().ProfessionTrend(mock.Anything, professionUUID, "113").Return(trendData, nil)
 
 	h := handler.Handle(trendDeps.trendHandler().GetProfessionTrend)
 
@@ -158,7 +164,7 @@ func TestTrendHandler_GetProfessionTrend_Unit_NotFound(t *testing.T) {
 	// Arrange
 	trendDeps := newTrendDeps(t)
 
-	trendDeps.trendProvider.EXPECT().ProfessionTrend(mock.Anything, professionUUID).Return(nil, domain.ErrProfessionNotFound)
+	trendDeps.trendProvider.EXPECT().ProfessionTrend(mock.Anything, professionUUID, "113").Return(nil, domain.ErrProfessionNotFound)
 
 	h := handler.Handle(trendDeps.trendHandler().GetProfessionTrend)
 
@@ -184,7 +190,7 @@ func TestTrendHandler_GetProfessionTrend_Unit_InternalError(t *testing.T) {
 	// Arrange
 	trendDeps := newTrendDeps(t)
 
-	trendDeps.trendProvider.EXPECT().ProfessionTrend(mock.Anything, professionUUID).Return(nil, assert.AnError)
+	trendDeps.trendProvider.EXPECT().ProfessionTrend(mock.Anything, professionUUID, "113").Return(nil, assert.AnError)
 
 	h := handler.Handle(trendDeps.trendHandler().GetProfessionTrend)
 
@@ -201,3 +207,116 @@ func TestTrendHandler_GetProfessionTrend_Unit_InternalError(t *testing.T) {
 	decodeTrendResponse(t, rr, &resp)
 	assert.Equal(t, "Failed to get profession trend", resp["error"])
 }
+
+// ==================== GetSalaryTrend ====================
+
+func TestTrendHandler_GetSalaryTrend_Unit_Success(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+	scrapedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Arrange
+	trendDeps := newTrendDeps(t)
+
+	trendData := &domain.ProfessionSalaryTrend{
+		ProfessionID:   professionUUID,
+		ProfessionName: "Go Developer",
+		Data: []domain.SalaryTrendPoint{
+			{ScrapedAt: scrapedAt, SalaryStat: domain.SalaryStat{SampleSize: 40, P25: 150000, Median: 200000, P75: 260000}},
+		},
+	}
+
+	trendDeps.trendProvider.EXPECT().ProfessionSalaryTrend(mock.Anything, professionUUID, "113").Return(trendData, nil)
+
+	h := handler.Handle(trendDeps.trendHandler().GetSalaryTrend)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/salary/trend", nil)
+	rr := httptest.NewRecorder()
+
+	routeSalaryTrend(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp map[string]any
+	decodeTrendResponse(t, rr, &resp)
+	assert.Equal(t, professionUUID.String(), resp["profession_id"])
+
+	data := resp["data"].([]any)
+	require.Len(t, data, 1)
+	point := data[0].(map[string]any)
+	assert.Equal(t, scrapedAt.Format(time.RFC3339), point["scraped_at"])
+	assert.Equal(t, float64(40), point["sample_size"])
+	assert.Equal(t, float64(150000), point["p25"])
+	assert.Equal(t, float64(200000), point["median"])
+	assert.Equal(t, float64(260000), point["p75"])
+}
+
+func TestTrendHandler_GetSalaryTrend_Unit_InvalidUUID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	trendDeps := newTrendDeps(t)
+
+	h := handler.Handle(trendDeps.trendHandler().GetSalaryTrend)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/not-a-uuid/salary/trend", nil)
+	rr := httptest.NewRecorder()
+
+	routeSalaryTrend(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	trendDeps.trendProvider.AssertNotCalled(t, "ProfessionSalaryTrend")
+}
+
+func TestTrendHandler_GetSalaryTrend_Unit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	trendDeps := newTrendDeps(t)
+
+	trendDeps.trendProvider.EXPECT().ProfessionSalaryTrend(mock.Anything, professionUUID, "113").Return(nil, domain.ErrProfessionNotFound)
+
+	h := handler.Handle(trendDeps.trendHandler().GetSalaryTrend)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/salary/trend", nil)
+	rr := httptest.NewRecorder()
+
+	routeSalaryTrend(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestTrendHandler_GetSalaryTrend_Unit_InternalError(t *testing.T) {
+	t.Parallel()
+
+	professionUUID := uuid.New()
+
+	// Arrange
+	trendDeps := newTrendDeps(t)
+
+	trendDeps.trendProvider.EXPECT().ProfessionSalaryTrend(mock.Anything, professionUUID, "113").Return(nil, assert.AnError)
+
+	h := handler.Handle(trendDeps.trendHandler().GetSalaryTrend)
+
+	// Act
+	req := httptest.NewRequest(http.MethodGet, "/professions/"+professionUUID.String()+"/salary/trend", nil)
+	rr := httptest.NewRecorder()
+
+	routeSalaryTrend(h).ServeHTTP(rr, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+
+	var resp map[string]string
+	decodeTrendResponse(t, rr, &resp)
+	assert.Equal(t, "Failed to get salary trend", resp["error"])
+}
@@ -13,6 +13,11 @@ type Router struct {
 	professionAdminHandler *admin.ProfessionAdminHandler
 	professionHandler      *public.ProfessionHandler
 	trendHandler           *public.TrendHandler
+	skillHandler           *public.SkillHandler
+	vacancyAdminHandler    *admin.VacancyAdminHandler
+	areaHandler            *public.AreaHandler
+	scrapingRunHandler     *admin.ScrapingRunAdminHandler
+	skillAliasHandler      *admin.SkillAliasAdminHandler
 }
 
 func New(
@@ -20,12 +25,22 @@ func New(
 	professionAdminHandler *admin.ProfessionAdminHandler,
 	professionHandler *public.ProfessionHandler,
 	trendHandler *public.TrendHandler,
+	skillHandler *public.SkillHandler,
+	vacancyAdminHandler *admin.VacancyAdminHandler,
+	areaHandler *public.AreaHandler,
+	scrapingRunHandler *admin.ScrapingRunAdminHandler,
+	skillAliasHandler *admin.SkillAliasAdminHandler,
 ) *Router {
 	return &Router{
 		authHandler:            authHandler,
 		professionAdminHandler: professionAdminHandler,
 		professionHandler:      professionHandler,
 		trendHandler:           trendHandler,
+		skillHandler:           skillHandler,
+		vacancyAdminHandler:    vacancyAdminHandler,
+		areaHandler:            areaHandler,
+		scrapingRunHandler:     scrapingRunHandler,
+		skillAliasHandler:      skillAliasHandler,
 	}
 }
 
@@ -37,17 +52,39 @@ func (r *Router) RegisterPublicRoutes(mux *http.ServeMux) {
 
 	// Profession routes
 	mux.HandleFunc("GET /professions", handler.Handle(r.professionHandler.ListProfessions))
+	mux.HandleFunc("GET /professions/compare", handler.Handle(r.professionHandler.CompareProfessions))
 	mux.HandleFunc("GET /professions/{id}/latest", handler.Handle(r.professionHandler.LastProfessionDetails))
 	mux.HandleFunc("GET /professions/{id}/trend", handler.Handle(r.trendHandler.GetProfessionTrend))
+	mux.HandleFunc("GET /professions/{id}/salary/trend", handler.Handle(r.trendHandler.GetSalaryTrend))
+	mux.HandleFunc("GET /professions/{id}/skills/history", handler.Handle(r.skillHandler.GetSkillHistory))
+	mux.HandleFunc("GET /professions/{id}/skills/salary-impact", handler.Handle(r.skillHandler.GetSkillSalaryImpact))
+
+	// Area routes
+	mux.HandleFunc("GET /areas", handler.Handle(r.areaHandler.SearchAreas))
 }
 
 func (r *Router) RegisterAdminRoutes(mux *http.ServeMux) {
 	// Profession admin routes
 	mux.HandleFunc("GET /professions", handler.Handle(r.professionAdminHandler.ListAllProfessions))
 	mux.HandleFunc("POST /professions", handler.Handle(r.professionAdminHandler.Create))
+	mux.HandleFunc("POST /professions/preview", handler.Handle(r.professionAdminHandler.PreviewQuery))
 	mux.HandleFunc("PUT /professions/{id}", handler.Handle(r.professionAdminHandler.Change))
+	mux.HandleFunc("POST /professions/{id}/scrape", handler.Handle(r.professionAdminHandler.TriggerProfessionScraping))
+
+	// Skill alias admin routes
+	mux.HandleFunc("GET /skills/aliases", handler.Handle(r.skillAliasHandler.List))
+	mux.HandleFunc("POST /skills/aliases", handler.Handle(r.skillAliasHandler.Create))
+	mux.HandleFunc("PUT /skills/aliases/{id}", handler.Handle(r.skillAliasHandler.Change))
+	mux.HandleFunc("DELETE /skills/aliases/{id}", handler.Handle(r.skillAliasHandler.Delete))
 
 	// Scraping admin routes
 	mux.HandleFunc("POST /scraping/archive", handler.Handle(r.professionAdminHandler.TriggerArchiveScraping))
 	mux.HandleFunc("POST /scraping/cache", handler.Handle(r.professionAdminHandler.TriggerCacheScraping))
+	mux.HandleFunc("POST /scraping/{session_id}/reprocess", handler.Handle(r.professionAdminHandler.TriggerReprocess))
+	mux.HandleFunc("POST /scraping/{session_id}/resume", handler.Handle(r.professionAdminHandler.TriggerResume))
+	mux.HandleFunc("GET /scraping/{session_id}/professions/{id}/vacancies",
+		handler.Handle(r.vacancyAdminHandler.ListSessionVacancies))
+	mux.HandleFunc("GET /scraping/runs", handler.Handle(r.scrapingRunHandler.ListRuns))
+	mux.HandleFunc("GET /scraping/runs/{id}", handler.Handle(r.scrapingRunHandler.GetRun))
+	mux.HandleFunc("DELETE /scraping/runs/{id}", handler.Handle(r.scrapingRunHandler.CancelRun))
 }
@@ -0,0 +1,123 @@
+package file
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// record is a vacancy of a dump. In CSV the header names the columns with the JSON keys below,
+// skills are separated by ";" and published_at is RFC 3339.
+type record struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	Skills         []string `json:"skills"`
+	PublishedAt    string   `json:"published_at"`
+	Area           string   `json:"area"`
+	SalaryFrom     int      `json:"salary_from"`
+	SalaryTo       int      `json:"salary_to"`
+	SalaryCurrency string   `json:"salary_currency"`
+	SalaryGross    bool     `json:"salary_gross"`
+	Experience     string   `json:"experience"`
+	Employment     string   `json:"employment"`
+	Schedule       string   `json:"schedule"`
+}
+
+const csvSkillSeparator = ";"
+
+func readJSONL(r io.Reader) ([]record, error) {
+	dec := json.NewDecoder(r)
+
+	var records []record
+	for line := 1; ; line++ {
+		var rec record
+		err := dec.Decode(&rec)
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", line, err)
+		}
+		records = append(records, rec)
+	}
+}
+
+func readCSV(r io.Reader) ([]record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	if _, ok := columns["id"]; !ok {
+		return nil, errors.New("header: missing id column")
+	}
+
+	var records []record
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+
+		rec := record{
+			ID:             field("id"),
+			Name:           field("name"),
+			Description:    field("description"),
+			PublishedAt:    field("published_at"),
+			Area:           field("area"),
+			SalaryCurrency: field("salary_currency"),
+			Experience:     field("experience"),
+			Employment:     field("employment"),
+			Schedule:       field("schedule"),
+		}
+		if skills := field("skills"); skills != "" {
+			rec.Skills = strings.Split(skills, csvSkillSeparator)
+		}
+		if rec.SalaryFrom, err = optionalInt(field("salary_from")); err != nil {
+			return nil, fmt.Errorf("line %d: salary_from: %w", line, err)
+		}
+		if rec.SalaryTo, err = optionalInt(field("salary_to")); err != nil {
+			return nil, fmt.Errorf("line %d: salary_to: %w", line, err)
+		}
+		if gross := field("salary_gross"); gross != "" {
+			if rec.SalaryGross, err = strconv.ParseBool(gross); err != nil {
+				return nil, fmt.Errorf("line %d: salary_gross: %w", line, err)
+			}
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func optionalInt(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
@@ -0,0 +1,244 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// query is a compiled hh.ru search query. The supported subset is words, "quoted phrases", prefixes ending with *,
+// AND (also implied between adjacent terms), OR, NOT and parentheses. Matching is case-insensitive and a term
+// must start on a word boundary; a term without * must also end on one.
+type query interface {
+	match(text string) bool
+}
+
+type termQuery struct {
+	term   string
+	prefix bool
+}
+
+type notQuery struct {
+	q query
+}
+
+type andQuery []query
+
+type orQuery []query
+
+func (q termQuery) match(text string) bool {
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], q.term)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		end := start + len(q.term)
+
+		if isBoundary(text, start, true) && (q.prefix || isBoundary(text, end, false)) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+func (q notQuery) match(text string) bool {
+	return !q.q.match(text)
+}
+
+func (q andQuery) match(text string) bool {
+	for _, sub := range q {
+		if !sub.match(text) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q orQuery) match(text string) bool {
+	for _, sub := range q {
+		if sub.match(text) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBoundary reports whether a word boundary is at the byte offset: the rune before a start offset,
+// or the rune at an end offset, is not a letter or digit.
+func isBoundary(text string, offset int, start bool) bool {
+	var r rune
+	if start {
+		if offset == 0 {
+			return true
+		}
+		r, _ = utf8.DecodeLastRuneInString(text[:offset])
+	} else {
+		if offset == len(text) {
+			return true
+		}
+		r, _ = utf8.DecodeRuneInString(text[offset:])
+	}
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+var errEmptyQuery = errors.New("empty query")
+
+// parseQuery compiles an hh.ru search query.
+func parseQuery(s string) (query, error) {
+	p := &queryParser{tokens: tokenizeQuery(s)}
+	if len(p.tokens) == 0 {
+		return nil, errEmptyQuery
+	}
+
+	q, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q at token %d", p.tokens[p.pos], p.pos)
+	}
+
+	return q, nil
+}
+
+type queryParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *queryParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) parseOr() (query, error) {
+	var alternatives orQuery
+	for {
+		q, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		alternatives = append(alternatives, q)
+
+		if p.peek() != "OR" {
+			break
+		}
+		p.pos++
+	}
+
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+	return alternatives, nil
+}
+
+func (p *queryParser) parseAnd() (query, error) {
+	var terms andQuery
+	for {
+		switch p.peek() {
+		case "", ")", "OR":
+			if len(terms) == 0 {
+				return nil, fmt.Errorf("missing term at token %d", p.pos)
+			}
+			if len(terms) == 1 {
+				return terms[0], nil
+			}
+			return terms, nil
+		case "AND":
+			p.pos++
+			continue
+		}
+
+		q, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, q)
+	}
+}
+
+func (p *queryParser) parseUnary() (query, error) {
+	token := p.peek()
+	p.pos++
+
+	switch {
+	case token == "NOT":
+		q, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notQuery{q: q}, nil
+	case token == "(":
+		q, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis at token %d", p.pos)
+		}
+		p.pos++
+		return q, nil
+	case token == "":
+		return nil, fmt.Errorf("missing term at token %d", p.pos)
+	case strings.HasPrefix(token, `"`):
+		phrase := strings.Join(strings.Fields(strings.Trim(token, `"`)), " ")
+		if phrase == "" {
+			return nil, fmt.Errorf("empty phrase at token %d", p.pos-1)
+		}
+		return termQuery{term: strings.ToLower(phrase)}, nil
+	default:
+		// + asks hh.ru for the exact word form, which is what a substring match does anyway.
+		term := strings.ToLower(strings.TrimPrefix(token, "+"))
+		prefix := strings.HasSuffix(term, "*")
+		term = strings.TrimSuffix(term, "*")
+		if term == "" {
+			return nil, fmt.Errorf("empty term at token %d", p.pos-1)
+		}
+		return termQuery{term: term, prefix: prefix}, nil
+	}
+}
+
+// tokenizeQuery splits a query into parentheses, quoted phrases (kept with their quotes) and words.
+func tokenizeQuery(s string) []string {
+	var (
+		tokens  []string
+		current strings.Builder
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '"':
+			flush()
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			tokens = append(tokens, `"`+string(runes[i+1:min(end, len(runes))])+`"`)
+			i = end
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
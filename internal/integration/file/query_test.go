@@ -0,0 +1,87 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery_Match(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+		text  string
+		want  bool
+	}{
+		{name: "одно слово", query: "golang", text: "senior golang developer", want: true},
+		{name: "регистр не важен", query: "GoLang", text: "senior golang developer", want: true},
+		{name: "неявное AND", query: "go developer", text: "developer on go", want: true},
+		{name: "неявное AND, нет слова", query: "go developer", text: "go engineer", want: false},
+		{name: "явное AND", query: "go AND developer", text: "go developer", want: true},
+		{name: "OR", query: "golang OR rust", text: "rust engineer", want: true},
+		{name: "граница слова", query: "go", text: "google cloud", want: false},
+		{name: "префикс", query: "full*", text: "fullstack developer", want: true},
+		{name: "префикс с начала слова", query: "stack*", text: "fullstack developer", want: false},
+		{name: "фраза", query: `"data analyst"`, text: "junior data analyst", want: true},
+		{name: "фраза не подряд", query: `"data analyst"`, text: "data and analyst", want: false},
+		{name: "пунктуация в термине", query: "c++ OR c#", text: "senior c# developer", want: true},
+		{name: "плюс перед словом", query: "+go", text: "go developer", want: true},
+		{name: "кириллица", query: "программист 1с", text: "ведущий программист 1с", want: true},
+		{
+			name:  "NOT исключает",
+			query: "(go developer OR golang) NOT (full* OR php)",
+			text:  "golang fullstack developer",
+			want:  false,
+		},
+		{
+			name:  "NOT не срабатывает",
+			query: "(go developer OR golang) NOT (full* OR php)",
+			text:  "backend golang developer",
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			q, err := parseQuery(tt.query)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, q.match(tt.text))
+		})
+	}
+}
+
+func TestParseQuery_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "пустой", query: "  "},
+		{name: "нет закрывающей скобки", query: "(go OR rust"},
+		{name: "лишняя закрывающая скобка", query: "go)"},
+		{name: "OR без правой части", query: "go OR"},
+		{name: "NOT без термина", query: "go NOT"},
+		{name: "пустая фраза", query: `""`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			_, err := parseQuery(tt.query)
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"psa/internal/domain"
+)
+
+// rouble codes accepted in salary_currency, hh.ru uses the legacy RUR
+var roubleCodes = map[string]bool{
+	domain.CurrencyRUB: true,
+	"RUR":              true,
+}
+
+type format int
+
+const (
+	formatJSONL format = iota
+	formatCSV
+)
+
+// entry is a parsed dump record prepared for matching.
+type entry struct {
+	vacancy domain.VacancyData
+	area    string
+	text    string
+}
+
+// Source is a vacancy source reading a local JSONL or CSV dump. The dump is parsed on the first fetch
+// and again whenever the file changes, so it can be replaced while the service is running.
+type Source struct {
+	path   string
+	format format
+
+	mu      sync.Mutex
+	entries []entry
+	modTime time.Time
+	size    int64
+}
+
+// New returns a source of the dump at path. The format is chosen by extension: .jsonl, .ndjson or .csv.
+func New(path string) (*Source, error) {
+	const op = "integration.file.New"
+
+	var f format
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		f = formatJSONL
+	case ".csv":
+		f = formatCSV
+	default:
+		return nil, fmt.Errorf("%s: unsupported dump format: %q", op, path)
+	}
+
+	return &Source{
+		path:   path,
+		format: f,
+	}, nil
+}
+
+// Name returns the source name vacancies of the dump are tagged with.
+func (s *Source) Name() string {
+	return domain.SourceFile
+}
+
+// FetchDataProfession returns dump vacancies whose name, description or skills match the hh.ru query.
+// A vacancy without an area matches any area. Every matched vacancy is found, collected and fetched.
+func (s *Source) FetchDataProfession(ctx context.Context, query, area string) ([]domain.VacancyData, domain.FetchStats, error) {
+	const op = "integration.file.Source.FetchDataProfession"
+
+	q, err := parseQuery(query)
+	if err != nil {
+		return nil, domain.FetchStats{}, fmt.Errorf("%s: parse query: %w", op, err)
+	}
+
+	entries, err := s.load()
+	if err != nil {
+		return nil, domain.FetchStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]domain.VacancyData, 0)
+	for _, e := range entries {
+		if err := ctx.Err(); err != nil {
+			return nil, domain.FetchStats{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if e.area != "" && e.area != area {
+			continue
+		}
+		if !q.match(e.text) {
+			continue
+		}
+
+		v := e.vacancy
+		v.Skills = append([]string(nil), e.vacancy.Skills...)
+		result = append(result, v)
+	}
+
+	return result, domain.FetchStats{
+		TotalFound:       len(result),
+		IDsCollected:     len(result),
+		VacanciesFetched: len(result),
+	}, nil
+}
+
+// load returns the parsed dump, parsing it again if its modification time or size changed.
+func (s *Source) load() ([]entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, err
+	}
+	if s.entries != nil && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.entries, nil
+	}
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []record
+	switch s.format {
+	case formatCSV:
+		records, err = readCSV(f)
+	default:
+		records, err = readJSONL(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", s.path, err)
+	}
+
+	entries := make([]entry, 0, len(records))
+	for _, rec := range records {
+		if rec.ID == "" {
+			continue
+		}
+		entries = append(entries, newEntry(rec))
+	}
+
+	s.entries = entries
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+
+	return s.entries, nil
+}
+
+func newEntry(rec record) entry {
+	v := domain.VacancyData{
+		ID:          rec.ID,
+		Skills:      make([]string, 0, len(rec.Skills)),
+		Description: rec.Description,
+		Salary:      parseSalary(rec),
+		Experience:  rec.Experience,
+		Employment:  rec.Employment,
+		Schedule:    rec.Schedule,
+	}
+	if publishedAt, err := time.Parse(time.RFC3339, rec.PublishedAt); err == nil {
+		v.PublishedAt = publishedAt
+	}
+
+	for _, skill := range rec.Skills {
+		name := strings.TrimSpace(strings.ToLower(skill))
+		if name != "" {
+			v.Skills = append(v.Skills, name)
+		}
+	}
+
+	text := strings.Join([]string{rec.Name, rec.Description, strings.Join(v.Skills, " ")}, " ")
+
+	return entry{
+		vacancy: v,
+		area:    rec.Area,
+		text:    strings.Join(strings.Fields(strings.ToLower(text)), " "),
+	}
+}
+
+// parseSalary converts a salary fork of a record. RUB is the middle of the fork, or its only bound,
+// and is known only for rouble salaries since a dump carries no currency rates.
+func parseSalary(rec record) *domain.Salary {
+	from := max(rec.SalaryFrom, 0)
+	to := max(rec.SalaryTo, 0)
+	if from == 0 && to == 0 {
+		return nil
+	}
+
+	salary := &domain.Salary{
+		From:     from,
+		To:       to,
+		Currency: strings.ToUpper(rec.SalaryCurrency),
+		Gross:    rec.SalaryGross,
+	}
+	if salary.Currency == "" || roubleCodes[salary.Currency] {
+		salary.Currency = domain.CurrencyRUB
+
+		point := from + to
+		if from > 0 && to > 0 {
+			point /= 2
+		}
+		salary.RUB = point
+	}
+
+	return salary
+}
@@ -0,0 +1,205 @@
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+)
+
+// writeDump — хелпер для создания файла выгрузки во временной директории
+func writeDump(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestNew_UnsupportedFormat(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	_, err := New("/tmp/vacancies.xml")
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestSource_FetchDataProfession_JSONL(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	path := writeDump(t, "vacancies.jsonl", `{"id":"1","name":"Golang developer","description":"Backend","skills":[" Go ","PostgreSQL",""],"published_at":"2025-01-15T10:30:00+03:00","area":"1","salary_from":200000,"salary_to":300000,"salary_currency":"RUR","experience":"between3And6","employment":"full","schedule":"remote"}
+{"id":"2","name":"PHP developer","description":"Legacy","area":"1"}
+{"id":"3","name":"Go developer","description":"Services","area":"2","salary_from":3000,"salary_currency":"USD"}
+{"id":"4","name":"Go developer","description":"Anywhere"}
+{"name":"Go developer without id"}
+`)
+
+	source, err := New(path)
+	require.NoError(t, err)
+
+	// Act
+	data, stats, err := source.FetchDataProfession(context.Background(), "go developer OR golang", "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.FetchStats{TotalFound: 2, IDsCollected: 2, VacanciesFetched: 2}, stats)
+	require.Len(t, data, 2)
+
+	assert.Equal(t, domain.VacancyData{
+		ID:          "1",
+		Skills:      []string{"go", "postgresql"},
+		Description: "Backend",
+		PublishedAt: time.Date(2025, 1, 15, 7, 30, 0, 0, time.UTC),
+		Salary:      &domain.Salary{From: 200000, To: 300000, Currency: domain.CurrencyRUB, RUB: 250000},
+		Experience:  "between3And6",
+		Employment:  "full",
+		Schedule:    "remote",
+	}, withUTC(data[0]))
+	assert.Equal(t, "4", data[1].ID, "vacancy without area matches any area")
+	assert.Equal(t, domain.SourceFile, source.Name())
+}
+
+func TestSource_FetchDataProfession_ForeignSalary(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	path := writeDump(t, "vacancies.ndjson", `{"id":"3","name":"Go developer","salary_from":3000,"salary_currency":"usd","salary_gross":true}`)
+
+	source, err := New(path)
+	require.NoError(t, err)
+
+	// Act
+	data, _, err := source.FetchDataProfession(context.Background(), "go", "113")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.Equal(t, &domain.Salary{From: 3000, Currency: "USD", Gross: true}, data[0].Salary)
+}
+
+func TestSource_FetchDataProfession_CSV(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	path := writeDump(t, "vacancies.csv", `id,name,description,skills,area,salary_from,salary_to,salary_gross,extra
+10,Data Analyst,"SQL, Python",SQL;Python,113,100000,,true,x
+11,Data Engineer,Spark,Spark,113,,,,x
+`)
+
+	source, err := New(path)
+	require.NoError(t, err)
+
+	// Act
+	data, stats, err := source.FetchDataProfession(context.Background(), `"data analyst"`, "113")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.TotalFound)
+	require.Len(t, data, 1)
+	assert.Equal(t, "10", data[0].ID)
+	assert.Equal(t, "SQL, Python", data[0].Description)
+	assert.Equal(t, []string{"sql", "python"}, data[0].Skills)
+	assert.Equal(t, &domain.Salary{From: 100000, Currency: domain.CurrencyRUB, Gross: true, RUB: 100000}, data[0].Salary)
+}
+
+func TestSource_FetchDataProfession_CSVMissingID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	path := writeDump(t, "vacancies.csv", "name,description\nGo developer,Backend\n")
+
+	source, err := New(path)
+	require.NoError(t, err)
+
+	// Act
+	_, _, err = source.FetchDataProfession(context.Background(), "go", "113")
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestSource_FetchDataProfession_InvalidJSONL(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	path := writeDump(t, "vacancies.jsonl", `{"id":"1"}
+{"id":`)
+
+	source, err := New(path)
+	require.NoError(t, err)
+
+	// Act
+	_, _, err = source.FetchDataProfession(context.Background(), "go", "113")
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestSource_FetchDataProfession_InvalidQuery(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	path := writeDump(t, "vacancies.jsonl", `{"id":"1","name":"Go developer"}`)
+
+	source, err := New(path)
+	require.NoError(t, err)
+
+	// Act
+	_, _, err = source.FetchDataProfession(context.Background(), "(go", "113")
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestSource_FetchDataProfession_MissingFile(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	source, err := New(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.NoError(t, err)
+
+	// Act
+	_, _, err = source.FetchDataProfession(context.Background(), "go", "113")
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestSource_FetchDataProfession_ReloadsChangedFile(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	path := writeDump(t, "vacancies.jsonl", `{"id":"1","name":"Go developer"}`)
+
+	source, err := New(path)
+	require.NoError(t, err)
+
+	_, stats, err := source.FetchDataProfession(context.Background(), "go", "113")
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.TotalFound)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"id":"1","name":"Go developer"}
+{"id":"2","name":"Go engineer"}
+`), 0o600))
+
+	// Act
+	_, stats, err = source.FetchDataProfession(context.Background(), "go", "113")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.TotalFound)
+}
+
+// withUTC приводит время публикации к UTC для сравнения
+func withUTC(v domain.VacancyData) domain.VacancyData {
+	v.PublishedAt = v.PublishedAt.UTC()
+	return v
+}
@@ -3,13 +3,30 @@ package hh
 import (
 	"context"
 	"log/slog"
+	"math"
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"psa/internal/config"
 	"psa/internal/domain"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+const (
+	// hh returns timestamps with a numeric zone without a colon, e.g. 2025-01-15T10:30:00+0300
+	publishedAtLayout = "2006-01-02T15:04:05-0700"
+
+	// hh uses the legacy code for the rouble
+	roubleCode = "RUR"
+
+	currencyRatesTTL = 12 * time.Hour
+
+	// previewSampleSize caps the vacancies a query preview returns
+	previewSampleSize = 20
 )
 
 var (
@@ -18,13 +35,21 @@ var (
 
 type professionFetcher interface {
 	fetchDataProfession(ctx context.Context, query, area string) (professionData, error)
+	fetchCurrencyRates(ctx context.Context) (map[string]float64, error)
+	fetchAreas(ctx context.Context) ([]areaResponse, error)
+	fetchFirstPage(ctx context.Context, query, area string) (searchPageResponse, error)
 }
 
 type Adapter struct {
 	fetcher professionFetcher
+
+	ratesMu        sync.Mutex
+	rates          map[string]float64
+	ratesFetchedAt time.Time
 }
 
-func NewAdapter(cfg *config.Config, logger *slog.Logger) *Adapter {
+// NewAdapter returns an hh.ru adapter. With a non-nil cache daily scraping is incremental, see VacancyCache.
+func NewAdapter(cfg *config.Config, logger *slog.Logger, cache VacancyCache) *Adapter {
 	httpClient := &http.Client{
 		Timeout: 30 * time.Second,
 		Transport: &http.Transport{
@@ -37,7 +62,7 @@ func NewAdapter(cfg *config.Config, logger *slog.Logger) *Adapter {
 
 	tokenManager := newTokenManager(cfg.HHAuth, logger)
 
-	client := newClient(cfg, logger, httpClient, tokenManager)
+	client := newClient(cfg, logger, httpClient, tokenManager, cache)
 
 	return &Adapter{
 		fetcher: client,
@@ -50,18 +75,31 @@ func NewAdapterWithClient(client professionFetcher) *Adapter {
 	}
 }
 
-func (a *Adapter) FetchDataProfession(ctx context.Context, query, area string) ([]domain.VacancyData, int, error) {
+// Name returns the source name vacancies of hh.ru are tagged with.
+func (a *Adapter) Name() string {
+	return domain.SourceHH
+}
+
+func (a *Adapter) FetchDataProfession(ctx context.Context, query, area string) ([]domain.VacancyData, domain.FetchStats, error) {
 	profData, err := a.fetcher.fetchDataProfession(ctx, query, area)
 	if err != nil {
-		return nil, 0, err
+		return nil, domain.FetchStats{}, err
 	}
 
+	rates := a.currencyRates(ctx, profData.Vacancies)
+
 	result := make([]domain.VacancyData, 0, len(profData.Vacancies))
 
 	for _, item := range profData.Vacancies {
 		v := domain.VacancyData{
+			ID:          item.ID,
 			Skills:      make([]string, 0),
 			Description: item.Description,
+			PublishedAt: parsePublishedAt(item.PublishedAt),
+			Salary:      parseSalary(item.Salary, rates),
+			Experience:  item.Experience.id(),
+			Employment:  item.Employment.id(),
+			Schedule:    item.Schedule.id(),
 		}
 
 		for _, skill := range item.KeySkills {
@@ -76,5 +114,146 @@ func (a *Adapter) FetchDataProfession(ctx context.Context, query, area string) (
 		result = append(result, v)
 	}
 
-	return result, profData.TotalFound, nil
+	return result, domain.FetchStats{
+		TotalFound:       profData.TotalFound,
+		IDsCollected:     profData.IDsCollected,
+		VacanciesFetched: len(result),
+		PagesFailed:      profData.PagesFailed,
+		VacanciesFailed:  profData.VacanciesFailed,
+	}, nil
+}
+
+// PreviewQuery returns what a vacancy query finds without scraping it: the total found and
+// the first vacancies of the first results page. Vacancy bodies are not fetched.
+func (a *Adapter) PreviewQuery(ctx context.Context, query, area string) (domain.QueryPreview, error) {
+	page, err := a.fetcher.fetchFirstPage(ctx, query, area)
+	if err != nil {
+		return domain.QueryPreview{}, err
+	}
+
+	items := page.Items
+	if len(items) > previewSampleSize {
+		items = items[:previewSampleSize]
+	}
+
+	vacancies := make([]domain.VacancyPreview, len(items))
+	for i, item := range items {
+		vacancies[i] = domain.VacancyPreview{
+			ID:          item.ID,
+			Name:        item.Name,
+			PublishedAt: parsePublishedAt(item.PublishedAt),
+		}
+	}
+
+	return domain.QueryPreview{
+		TotalFound: page.Found,
+		Vacancies:  vacancies,
+	}, nil
+}
+
+// FetchAreas returns the hh areas dictionary flattened into a list, parents before their children.
+func (a *Adapter) FetchAreas(ctx context.Context) ([]domain.Area, error) {
+	tree, err := a.fetcher.fetchAreas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Area, 0)
+	var walk func(nodes []areaResponse, parentID string)
+	walk = func(nodes []areaResponse, parentID string) {
+		for _, node := range nodes {
+			result = append(result, domain.Area{
+				ID:       node.ID,
+				ParentID: parentID,
+				Name:     node.Name,
+			})
+			walk(node.Areas, node.ID)
+		}
+	}
+	walk(tree, "")
+
+	return result, nil
+}
+
+// currencyRates returns cached hh currency rates, refreshing them when vacancies have a foreign currency salary
+// and the cache is older than currencyRatesTTL. On a refresh failure the stale rates are used.
+func (a *Adapter) currencyRates(ctx context.Context, vacancies []vacancyResponse) map[string]float64 {
+	a.ratesMu.Lock()
+	defer a.ratesMu.Unlock()
+
+	if time.Since(a.ratesFetchedAt) < currencyRatesTTL || !hasForeignSalary(vacancies) {
+		return a.rates
+	}
+
+	rates, err := a.fetcher.fetchCurrencyRates(ctx)
+	if err != nil {
+		loggerctx.FromContext(ctx).Warn("currency_rates_fetch_failed", slogx.Err(err))
+		return a.rates
+	}
+
+	a.rates = rates
+	a.ratesFetchedAt = time.Now()
+
+	return a.rates
+}
+
+func hasForeignSalary(vacancies []vacancyResponse) bool {
+	for _, v := range vacancies {
+		if v.Salary != nil && v.Salary.Currency != roubleCode {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSalary converts an hh salary fork. RUB is the middle of the fork, or its only bound,
+// converted with rates. Gross and net forks are not normalized to each other.
+func parseSalary(s *salaryResponse, rates map[string]float64) *domain.Salary {
+	if s == nil {
+		return nil
+	}
+
+	var from, to int
+	if s.From != nil && *s.From > 0 {
+		from = *s.From
+	}
+	if s.To != nil && *s.To > 0 {
+		to = *s.To
+	}
+	if from == 0 && to == 0 {
+		return nil
+	}
+
+	salary := &domain.Salary{
+		From:     from,
+		To:       to,
+		Currency: s.Currency,
+		Gross:    s.Gross,
+	}
+	if s.Currency == roubleCode {
+		salary.Currency = domain.CurrencyRUB
+	}
+
+	point := float64(from + to)
+	if from > 0 && to > 0 {
+		point /= 2
+	}
+
+	switch rate, ok := rates[s.Currency]; {
+	case s.Currency == roubleCode:
+		salary.RUB = int(point)
+	case ok:
+		salary.RUB = int(math.Round(point / rate))
+	}
+
+	return salary
+}
+
+func parsePublishedAt(value string) time.Time {
+	t, err := time.Parse(publishedAtLayout, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t.UTC()
 }
@@ -2,21 +2,62 @@ package hh
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
 )
 
 // fakeProfessionFetcher — ручная реализация professionFetcher для тестов
 type fakeProfessionFetcher struct {
-	fn func(ctx context.Context, query, area string) (professionData, error)
+	fn      func(ctx context.Context, query, area string) (professionData, error)
+	ratesFn func(ctx context.Context) (map[string]float64, error)
+	areasFn func(ctx context.Context) ([]areaResponse, error)
+	pageFn  func(ctx context.Context, query, area string) (searchPageResponse, error)
 }
 
 func (f *fakeProfessionFetcher) fetchDataProfession(ctx context.Context, query, area string) (professionData, error) {
 	return f.fn(ctx, query, area)
 }
 
+func (f *fakeProfessionFetcher) fetchCurrencyRates(ctx context.Context) (map[string]float64, error) {
+	if f.ratesFn == nil {
+		return nil, errors.New("unexpected currency rates call")
+	}
+	return f.ratesFn(ctx)
+}
+
+func (f *fakeProfessionFetcher) fetchAreas(ctx context.Context) ([]areaResponse, error) {
+	if f.areasFn == nil {
+		return nil, errors.New("unexpected areas call")
+	}
+	return f.areasFn(ctx)
+}
+
+func (f *fakeProfessionFetcher) fetchFirstPage(ctx context.Context, query, area string) (searchPageResponse, error) {
+	if f.pageFn == nil {
+		return searchPageResponse{}, errors.New("unexpected first page call")
+	}
+	return f.pageFn(ctx, query, area)
+}
+
+// salary — хелпер для создания зарплатной вилки; 0 означает отсутствие границы
+func salary(from, to int, currency string, gross bool) *salaryResponse {
+	s := &salaryResponse{Currency: currency, Gross: gross}
+	if from != 0 {
+		s.From = &from
+	}
+	if to != 0 {
+		s.To = &to
+	}
+	return s
+}
+
 // skills — хелпер для создания списка навыков
 func skills(names ...string) []struct {
 	Name string `json:"name"`
@@ -45,15 +86,25 @@ func TestAdapter_FetchDataProfession_Success(t *testing.T) {
 			return professionData{
 				Vacancies: []vacancyResponse{
 					{
+						ID:          "101",
+						PublishedAt: "2025-01-15T10:30:00+0300",
 						Description: "We need a Go developer",
+						Experience:  &dictionaryItem{ID: "between3And6"},
+						Employment:  &dictionaryItem{ID: "full"},
+						Schedule:    &dictionaryItem{ID: "remote"},
 						KeySkills:   skills("Golang", "  Python  ", "SQL"),
 					},
 					{
+						ID:          "102",
+						PublishedAt: "invalid",
 						Description: "Another vacancy",
 						KeySkills:   skills("golang", "Docker"),
 					},
 				},
-				TotalFound: 150,
+				TotalFound:      150,
+				IDsCollected:    148,
+				PagesFailed:     1,
+				VacanciesFailed: 146,
 			}, nil
 		},
 	}
@@ -61,20 +112,35 @@ func TestAdapter_FetchDataProfession_Success(t *testing.T) {
 	adapter := NewAdapterWithClient(fakeClient)
 
 	// Act
-	result, total, err := adapter.FetchDataProfession(ctx, query, area)
+	result, stats, err := adapter.FetchDataProfession(ctx, query, area)
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, 150, total)
+	assert.Equal(t, domain.FetchStats{
+		TotalFound:       150,
+		IDsCollected:     148,
+		VacanciesFetched: 2,
+		PagesFailed:      1,
+		VacanciesFailed:  146,
+	}, stats)
 	assert.Len(t, result, 2)
 
 	// Проверяем первую вакансию
+	assert.Equal(t, "101", result[0].ID)
+	assert.Equal(t, time.Date(2025, 1, 15, 7, 30, 0, 0, time.UTC), result[0].PublishedAt)
 	assert.Equal(t, "We need a Go developer", result[0].Description)
 	assert.Equal(t, []string{"golang", "python", "sql"}, result[0].Skills)
+	assert.Equal(t, "between3And6", result[0].Experience)
+	assert.Equal(t, "full", result[0].Employment)
+	assert.Equal(t, "remote", result[0].Schedule)
 
-	// Проверяем вторую вакансию
+	// Проверяем вторую вакансию: некорректная дата публикации не ломает разбор
+	assert.Equal(t, "102", result[1].ID)
+	assert.True(t, result[1].PublishedAt.IsZero())
 	assert.Equal(t, "Another vacancy", result[1].Description)
 	assert.Equal(t, []string{"golang", "docker"}, result[1].Skills)
+	assert.Empty(t, result[1].Experience)
+	assert.Empty(t, result[1].Schedule)
 }
 
 func TestAdapter_FetchDataProfession_EmptySkills(t *testing.T) {
@@ -102,11 +168,11 @@ func TestAdapter_FetchDataProfession_EmptySkills(t *testing.T) {
 	adapter := NewAdapterWithClient(fakeClient)
 
 	// Act
-	result, total, err := adapter.FetchDataProfession(ctx, query, area)
+	result, stats, err := adapter.FetchDataProfession(ctx, query, area)
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, 50, total)
+	assert.Equal(t, 50, stats.TotalFound)
 	assert.Len(t, result, 1)
 	assert.Empty(t, result[0].Skills)
 }
@@ -131,11 +197,11 @@ func TestAdapter_FetchDataProfession_EmptyVacancies(t *testing.T) {
 	adapter := NewAdapterWithClient(fakeClient)
 
 	// Act
-	result, total, err := adapter.FetchDataProfession(ctx, query, area)
+	result, stats, err := adapter.FetchDataProfession(ctx, query, area)
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, 0, total)
+	assert.Equal(t, 0, stats.TotalFound)
 	assert.Empty(t, result)
 }
 
@@ -164,11 +230,11 @@ func TestAdapter_FetchDataProfession_SkillFiltering(t *testing.T) {
 	adapter := NewAdapterWithClient(fakeClient)
 
 	// Act
-	result, total, err := adapter.FetchDataProfession(ctx, query, area)
+	result, stats, err := adapter.FetchDataProfession(ctx, query, area)
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, 10, total)
+	assert.Equal(t, 10, stats.TotalFound)
 	assert.Len(t, result, 1)
 	// Ожидаем: golang, python, C++, java (4 навыка)
 	// "   " — отфильтрован (пустой после trim)
@@ -193,11 +259,11 @@ func TestAdapter_FetchDataProfession_ClientError(t *testing.T) {
 	adapter := NewAdapterWithClient(fakeClient)
 
 	// Act
-	result, total, err := adapter.FetchDataProfession(ctx, query, area)
+	result, stats, err := adapter.FetchDataProfession(ctx, query, area)
 
 	// Assert
 	require.Error(t, err)
-	assert.Equal(t, 0, total)
+	assert.Equal(t, 0, stats.TotalFound)
 	assert.Empty(t, result)
 }
 
@@ -226,11 +292,11 @@ func TestAdapter_FetchDataProfession_Normalization(t *testing.T) {
 	adapter := NewAdapterWithClient(fakeClient)
 
 	// Act
-	result, total, err := adapter.FetchDataProfession(ctx, query, area)
+	result, stats, err := adapter.FetchDataProfession(ctx, query, area)
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, 5, total)
+	assert.Equal(t, 5, stats.TotalFound)
 	assert.Len(t, result, 1)
 	// Проверяем что все навыки в нижнем регистре и без пробелов
 	assert.Equal(t, []string{"golang", "python", "sql"}, result[0].Skills)
@@ -261,11 +327,11 @@ func TestAdapter_FetchDataProfession_DuplicateSkills(t *testing.T) {
 	adapter := NewAdapterWithClient(fakeClient)
 
 	// Act
-	result, total, err := adapter.FetchDataProfession(ctx, query, area)
+	result, stats, err := adapter.FetchDataProfession(ctx, query, area)
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, 5, total)
+	assert.Equal(t, 5, stats.TotalFound)
 	assert.Len(t, result, 1)
 	// Дубликаты сохраняются (это текущее поведение)
 	assert.Equal(t, []string{"golang", "golang", "go"}, result[0].Skills)
@@ -296,11 +362,219 @@ func TestAdapter_FetchDataProfession_AllSkillsFiltered(t *testing.T) {
 	adapter := NewAdapterWithClient(fakeClient)
 
 	// Act
-	result, total, err := adapter.FetchDataProfession(ctx, query, area)
+	result, stats, err := adapter.FetchDataProfession(ctx, query, area)
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, 3, total)
+	assert.Equal(t, 3, stats.TotalFound)
 	assert.Len(t, result, 1)
 	assert.Empty(t, result[0].Skills)
 }
+
+func TestAdapter_FetchDataProfession_SalaryRUR(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange: рублёвые вилки не требуют курсов валют
+	fakeClient := &fakeProfessionFetcher{
+		fn: func(ctx context.Context, query, area string) (professionData, error) {
+			return professionData{
+				Vacancies: []vacancyResponse{
+					{ID: "1", Salary: salary(100000, 200000, "RUR", true)},
+					{ID: "2", Salary: salary(150000, 0, "RUR", false)},
+					{ID: "3", Salary: salary(0, 0, "RUR", false)},
+					{ID: "4"},
+				},
+				TotalFound: 4,
+			}, nil
+		},
+	}
+
+	adapter := NewAdapterWithClient(fakeClient)
+
+	// Act
+	result, _, err := adapter.FetchDataProfession(ctx, "go developer", "113")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result, 4)
+
+	assert.Equal(t, &domain.Salary{From: 100000, To: 200000, Currency: "RUB", Gross: true, RUB: 150000}, result[0].Salary)
+	assert.Equal(t, &domain.Salary{From: 150000, Currency: "RUB", RUB: 150000}, result[1].Salary)
+	assert.Nil(t, result[2].Salary)
+	assert.Nil(t, result[3].Salary)
+}
+
+func TestAdapter_FetchDataProfession_SalaryForeignCurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	var ratesCalls int
+	fakeClient := &fakeProfessionFetcher{
+		fn: func(ctx context.Context, query, area string) (professionData, error) {
+			return professionData{
+				Vacancies: []vacancyResponse{
+					{ID: "1", Salary: salary(1000, 3000, "USD", false)},
+					{ID: "2", Salary: salary(500, 0, "GEL", false)},
+				},
+				TotalFound: 2,
+			}, nil
+		},
+		ratesFn: func(ctx context.Context) (map[string]float64, error) {
+			ratesCalls++
+			return map[string]float64{"RUR": 1, "USD": 0.01}, nil
+		},
+	}
+
+	adapter := NewAdapterWithClient(fakeClient)
+
+	// Act: второй вызов берёт курсы из кэша
+	result, _, err := adapter.FetchDataProfession(ctx, "go developer", "113")
+	require.NoError(t, err)
+	_, _, err = adapter.FetchDataProfession(ctx, "go developer", "113")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 1, ratesCalls)
+	require.Len(t, result, 2)
+	assert.Equal(t, &domain.Salary{From: 1000, To: 3000, Currency: "USD", RUB: 200000}, result[0].Salary)
+	// Валюта без курса: вилка сохраняется, оценка в рублях неизвестна
+	assert.Equal(t, &domain.Salary{From: 500, Currency: "GEL"}, result[1].Salary)
+}
+
+func TestAdapter_FetchDataProfession_CurrencyRatesError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	fakeClient := &fakeProfessionFetcher{
+		fn: func(ctx context.Context, query, area string) (professionData, error) {
+			return professionData{
+				Vacancies: []vacancyResponse{
+					{ID: "1", Salary: salary(1000, 0, "USD", false)},
+					{ID: "2", Salary: salary(90000, 0, "RUR", false)},
+				},
+				TotalFound: 2,
+			}, nil
+		},
+		ratesFn: func(ctx context.Context) (map[string]float64, error) {
+			return nil, errors.New("hh unavailable")
+		},
+	}
+
+	adapter := NewAdapterWithClient(fakeClient)
+
+	// Act
+	result, _, err := adapter.FetchDataProfession(ctx, "go developer", "113")
+
+	// Assert: ошибка курсов не ломает сбор вакансий
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, 0, result[0].Salary.RUB)
+	assert.Equal(t, 90000, result[1].Salary.RUB)
+}
+
+func TestAdapter_FetchAreas_Flatten(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &fakeProfessionFetcher{
+		areasFn: func(ctx context.Context) ([]areaResponse, error) {
+			return []areaResponse{
+				{ID: "113", Name: "Россия", Areas: []areaResponse{
+					{ID: "1", Name: "Москва"},
+					{ID: "1620", Name: "Республика Марий Эл", Areas: []areaResponse{
+						{ID: "1621", Name: "Йошкар-Ола"},
+					}},
+				}},
+				{ID: "40", Name: "Казахстан"},
+			}, nil
+		},
+	}
+
+	adapter := NewAdapterWithClient(fetcher)
+
+	areas, err := adapter.FetchAreas(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Area{
+		{ID: "113", Name: "Россия"},
+		{ID: "1", ParentID: "113", Name: "Москва"},
+		{ID: "1620", ParentID: "113", Name: "Республика Марий Эл"},
+		{ID: "1621", ParentID: "1620", Name: "Йошкар-Ола"},
+		{ID: "40", Name: "Казахстан"},
+	}, areas)
+}
+
+func TestAdapter_Name(t *testing.T) {
+	adapter := NewAdapterWithClient(&fakeProfessionFetcher{})
+
+	assert.Equal(t, domain.SourceHH, adapter.Name())
+}
+
+func TestAdapter_FetchAreas_Error(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &fakeProfessionFetcher{
+		areasFn: func(ctx context.Context) ([]areaResponse, error) {
+			return nil, errors.New("hh unavailable")
+		},
+	}
+
+	adapter := NewAdapterWithClient(fetcher)
+
+	areas, err := adapter.FetchAreas(context.Background())
+
+	require.Error(t, err)
+	assert.Nil(t, areas)
+}
+
+func TestAdapter_PreviewQuery_Success(t *testing.T) {
+	t.Parallel()
+
+	items := make([]vacancyRef, 0, 30)
+	for i := range 30 {
+		items = append(items, vacancyRef{ID: fmt.Sprintf("%d", 100+i), Name: "Go Developer", PublishedAt: "2025-01-15T10:30:00+0300"})
+	}
+
+	fetcher := &fakeProfessionFetcher{
+		pageFn: func(ctx context.Context, query, area string) (searchPageResponse, error) {
+			assert.Equal(t, "golang NOT 1С", query)
+			assert.Equal(t, "1", area)
+			return searchPageResponse{metadata: metadata{Found: 1234, Pages: 13}, Items: items}, nil
+		},
+	}
+
+	adapter := NewAdapterWithClient(fetcher)
+
+	preview, err := adapter.PreviewQuery(context.Background(), "golang NOT 1С", "1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1234, preview.TotalFound)
+	// выборка ограничена previewSampleSize
+	require.Len(t, preview.Vacancies, previewSampleSize)
+	assert.Equal(t, domain.VacancyPreview{
+		ID:          "100",
+		Name:        "Go Developer",
+		PublishedAt: time.Date(2025, 1, 15, 7, 30, 0, 0, time.UTC),
+	}, preview.Vacancies[0])
+}
+
+func TestAdapter_PreviewQuery_Error(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &fakeProfessionFetcher{
+		pageFn: func(ctx context.Context, query, area string) (searchPageResponse, error) {
+			return searchPageResponse{}, errors.New("hh unavailable")
+		},
+	}
+
+	adapter := NewAdapterWithClient(fetcher)
+
+	_, err := adapter.PreviewQuery(context.Background(), "golang", "113")
+
+	require.Error(t, err)
+}
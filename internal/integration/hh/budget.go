@@ -0,0 +1,126 @@
+package hh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultProfessionTimeout and defaultRateLimitWaitTimeout apply when the config leaves the budgets unset.
+	defaultProfessionTimeout    = 15 * time.Minute
+	defaultRateLimitWaitTimeout = 15 * time.Minute
+)
+
+var (
+	errProfessionTimeout    = errors.New("profession fetch timeout exceeded")
+	errRateLimitWaitTimeout = errors.New("rate limit wait timeout exceeded")
+)
+
+// fetchBudget bounds the fetch of a profession by two budgets: the time it works and the time it waits for
+// the rate limiter. The limiter is shared by the professions fetched concurrently, so the wait grows with their
+// number; counted apart, it does not time out a profession for the work it has not got to do. The profession
+// waits while any of its requests waits for the limiter. An exhausted budget cancels the fetch.
+type fetchBudget struct {
+	work   time.Duration
+	wait   time.Duration
+	cancel context.CancelCauseFunc
+
+	mu        sync.Mutex
+	started   time.Time
+	waiters   int
+	waitStart time.Time
+	waited    time.Duration
+	timer     *time.Timer
+}
+
+type fetchBudgetKey struct{}
+
+// withFetchBudget returns a context of the fetch bounded by the budgets and the function releasing it.
+func withFetchBudget(ctx context.Context, work, wait time.Duration) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	b := &fetchBudget{work: work, wait: wait, cancel: cancel, started: time.Now()}
+	b.mu.Lock()
+	b.timer = time.AfterFunc(work, b.check)
+	b.mu.Unlock()
+
+	return context.WithValue(ctx, fetchBudgetKey{}, b), func() {
+		b.timer.Stop()
+		cancel(nil)
+	}
+}
+
+func fetchBudgetFrom(ctx context.Context) *fetchBudget {
+	b, _ := ctx.Value(fetchBudgetKey{}).(*fetchBudget)
+	return b
+}
+
+// startWait switches the budget to waiting when the first request starts waiting for the limiter.
+func (b *fetchBudget) startWait() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.waiters++
+	if b.waiters == 1 {
+		b.waitStart = time.Now()
+		b.schedule(b.waitStart)
+	}
+}
+
+// endWait switches the budget back to working when the last waiting request gets through the limiter.
+func (b *fetchBudget) endWait() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.waiters--
+	if b.waiters == 0 {
+		now := time.Now()
+		b.waited += now.Sub(b.waitStart)
+		b.schedule(now)
+	}
+}
+
+// remaining returns the time left of the budget being spent at now and the error of exhausting it.
+func (b *fetchBudget) remaining(now time.Time) (time.Duration, error) {
+	if b.waiters > 0 {
+		return b.wait - b.waited - now.Sub(b.waitStart), errRateLimitWaitTimeout
+	}
+	return b.work - (now.Sub(b.started) - b.waited), errProfessionTimeout
+}
+
+func (b *fetchBudget) schedule(now time.Time) {
+	left, _ := b.remaining(now)
+	b.timer.Reset(max(left, 0))
+}
+
+// check cancels the fetch once the budget being spent is exhausted; the timer may fire for a budget switched since.
+func (b *fetchBudget) check() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	left, err := b.remaining(time.Now())
+	if left > 0 {
+		b.timer.Reset(left)
+		return
+	}
+	b.cancel(err)
+}
+
+// withCause adds to the error of a cancelled fetch the cause of the cancellation, such as an exhausted budget.
+func withCause(ctx context.Context, err error) error {
+	if ctx.Err() == nil {
+		return err
+	}
+	return fmt.Errorf("%w: %w", context.Cause(ctx), err)
+}
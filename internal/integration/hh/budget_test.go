@@ -0,0 +1,94 @@
+package hh
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchBudget_WorkExhausted(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx, release := withFetchBudget(context.Background(), 20*time.Millisecond, time.Hour)
+	defer release()
+
+	// Act
+	<-ctx.Done()
+
+	// Assert
+	assert.ErrorIs(t, context.Cause(ctx), errProfessionTimeout)
+	assert.ErrorIs(t, withCause(ctx, ctx.Err()), errProfessionTimeout)
+}
+
+func TestFetchBudget_WaitNotCountedAsWork(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx, release := withFetchBudget(context.Background(), 100*time.Millisecond, time.Hour)
+	defer release()
+	budget := fetchBudgetFrom(ctx)
+	require.NotNil(t, budget)
+
+	// Act
+	budget.startWait()
+	time.Sleep(200 * time.Millisecond)
+	budget.endWait()
+
+	// Assert
+	require.NoError(t, ctx.Err(), "waiting for the limiter does not spend the work budget")
+	<-ctx.Done()
+	assert.ErrorIs(t, context.Cause(ctx), errProfessionTimeout)
+}
+
+func TestFetchBudget_WaitExhausted(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx, release := withFetchBudget(context.Background(), time.Hour, 20*time.Millisecond)
+	defer release()
+	budget := fetchBudgetFrom(ctx)
+
+	// Act
+	budget.startWait()
+	budget.startWait()
+	budget.endWait()
+	<-ctx.Done()
+	budget.endWait()
+
+	// Assert
+	assert.ErrorIs(t, context.Cause(ctx), errRateLimitWaitTimeout)
+}
+
+func TestFetchBudget_Release(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx, release := withFetchBudget(context.Background(), time.Hour, time.Hour)
+
+	// Act
+	release()
+
+	// Assert
+	require.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.ErrorIs(t, context.Cause(ctx), context.Canceled)
+}
+
+func TestFetchBudget_NoBudget(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	budget := fetchBudgetFrom(ctx)
+
+	// Act
+	budget.startWait()
+	budget.endWait()
+
+	// Assert
+	assert.Nil(t, budget)
+	assert.NoError(t, withCause(ctx, nil))
+}
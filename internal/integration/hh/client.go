@@ -19,8 +19,10 @@ import (
 )
 
 const (
-	perPage = 100
-	baseURL = "https://api.hh.ru/vacancies"
+	perPage         = 100
+	baseURL         = "https://api.hh.ru/vacancies"
+	dictionariesURL = "https://api.hh.ru/dictionaries"
+	areasURL        = "https://api.hh.ru/areas"
 
 	// HH API allows access to max 2000 vacancies (20 pages * 100 per page)
 	maxVacancies = 2000
@@ -37,25 +39,45 @@ type tokenProvider interface {
 }
 
 type client struct {
-	baseURL string
-	cfg     *config.Config
-	logger  *slog.Logger
-	hClient *http.Client
-	limiter *rate.Limiter
-	token   tokenProvider
+	baseURL         string
+	dictionariesURL string
+	areasURL        string
+	cfg             *config.Config
+	logger          *slog.Logger
+	hClient         *http.Client
+	limiter         *rate.Limiter
+	token           tokenProvider
+	cache           VacancyCache
 }
 
-func newClient(cfg *config.Config, logger *slog.Logger, hClient *http.Client, token tokenProvider) *client {
+func newClient(cfg *config.Config, logger *slog.Logger, hClient *http.Client, token tokenProvider, cache VacancyCache) *client {
 	return &client{
-		baseURL: baseURL,
-		cfg:     cfg,
-		logger:  logger,
-		hClient: hClient,
-		limiter: rate.NewLimiter(rps, rps),
-		token:   token,
+		baseURL:         baseURL,
+		dictionariesURL: dictionariesURL,
+		areasURL:        areasURL,
+		cfg:             cfg,
+		logger:          logger,
+		hClient:         hClient,
+		limiter:         rate.NewLimiter(rps, rps),
+		token:           token,
+		cache:           cache,
 	}
 }
 
+func (c *client) professionTimeout() time.Duration {
+	if c.cfg.Scraping.ProfessionTimeout > 0 {
+		return c.cfg.Scraping.ProfessionTimeout
+	}
+	return defaultProfessionTimeout
+}
+
+func (c *client) rateLimitWaitTimeout() time.Duration {
+	if c.cfg.Scraping.RateLimitWaitTimeout > 0 {
+		return c.cfg.Scraping.RateLimitWaitTimeout
+	}
+	return defaultRateLimitWaitTimeout
+}
+
 func (c *client) setHeaders(req *http.Request) error {
 	accessToken, err := c.token.getToken(req.Context())
 	if err != nil {
@@ -97,7 +119,11 @@ func (c *client) doRequestWithRetry(ctx context.Context, req *http.Request) (*ht
 
 		reqClone := req.Clone(ctx)
 
-		if err := c.limiter.Wait(ctx); err != nil {
+		budget := fetchBudgetFrom(ctx)
+		budget.startWait()
+		err := c.limiter.Wait(ctx)
+		budget.endWait()
+		if err != nil {
 			return nil, fmt.Errorf("%s: rate limiter: %w", op, err)
 		}
 
@@ -167,16 +193,29 @@ func (c *client) doRequestWithRetry(ctx context.Context, req *http.Request) (*ht
 	return nil, fmt.Errorf("%s: max retry attempts exceeded", op)
 }
 
-func (c *client) fetchMeta(ctx context.Context, query, area string) (metadata, error) {
-	const op = "integration.hh.hClient.fetchMeta"
-
+// searchParams builds vacancy search parameters restricted to the publication window.
+func searchParams(query, area string, page int, window searchWindow) url.Values {
 	params := url.Values{
 		"text":         []string{query},
 		"search_field": []string{"name"},
 		"per_page":     []string{fmt.Sprintf("%d", perPage)},
-		"page":         []string{"0"},
+		"page":         []string{fmt.Sprintf("%d", page)},
 		"area":         []string{area},
 	}
+	if !window.from.IsZero() {
+		params.Set("date_from", window.from.Format(publishedAtLayout))
+	}
+	if !window.to.IsZero() {
+		params.Set("date_to", window.to.Format(publishedAtLayout))
+	}
+
+	return params
+}
+
+func (c *client) fetchMeta(ctx context.Context, query, area string, window searchWindow) (metadata, error) {
+	const op = "integration.hh.hClient.fetchMeta"
+
+	params := searchParams(query, area, 0, window)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"?"+params.Encode(), nil)
 	if err != nil {
@@ -194,27 +233,47 @@ func (c *client) fetchMeta(ctx context.Context, query, area string) (metadata, e
 		return metadata{}, fmt.Errorf("%s: decode response: %w", op, err)
 	}
 
-	if meta.Found == 0 {
-		c.logger.WarnContext(ctx, op, "event", "vacancies.not_found", "query", query)
-		return metadata{}, fmt.Errorf("%s: vacancies not found", op)
-	}
-
 	return meta, nil
 }
 
-func (c *client) fetchIDsFromPage(ctx context.Context, page int, query, area string) ([]string, error) {
-	const op = "integration.hh.hClient.fetchIDsFromPage"
+// fetchFirstPage returns the first results page of the search. The request is the one fetchMeta makes,
+// so the page comes with the search metadata at the cost of a single request.
+func (c *client) fetchFirstPage(ctx context.Context, query, area string) (searchPageResponse, error) {
+	const op = "integration.hh.hClient.fetchFirstPage"
 
-	result := make([]string, 0)
+	if query == "" {
+		return searchPageResponse{}, fmt.Errorf("%s: query cannot be empty", op)
+	}
+	if area == "" {
+		return searchPageResponse{}, fmt.Errorf("%s: area cannot be empty", op)
+	}
 
-	params := url.Values{
-		"text":         []string{query},
-		"search_field": []string{"name"},
-		"per_page":     []string{fmt.Sprintf("%d", perPage)},
-		"page":         []string{fmt.Sprintf("%d", page)},
-		"area":         []string{area},
+	params := searchParams(query, area, 0, searchWindow{})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return searchPageResponse{}, fmt.Errorf("%s: build request: %w", op, err)
+	}
+
+	resp, err := c.doRequestWithRetry(ctx, req)
+	if err != nil {
+		return searchPageResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var page searchPageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return searchPageResponse{}, fmt.Errorf("%s: decode response: %w", op, err)
 	}
 
+	return page, nil
+}
+
+func (c *client) fetchIDsFromPage(ctx context.Context, page int, query, area string, window searchWindow) ([]vacancyRef, error) {
+	const op = "integration.hh.hClient.fetchIDsFromPage"
+
+	params := searchParams(query, area, page, window)
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"?"+params.Encode(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -231,15 +290,33 @@ func (c *client) fetchIDsFromPage(ctx context.Context, page int, query, area str
 		return nil, fmt.Errorf("%s: decode response: %w", op, err)
 	}
 
-	for _, id := range ids.Items {
-		result = append(result, id.ID)
+	return ids.Items, nil
+}
+
+// fetchIDsVacancies lists the vacancies of the search. A search over the maxVacancies cap is split
+// into publication windows that stay under it, see fetchIDsSplit. Each vacancy is listed once.
+func (c *client) fetchIDsVacancies(ctx context.Context, meta metadata, query, area string, window searchWindow, stats *fetchStats) ([]vacancyRef, error) {
+	const op = "integration.hh.hClient.fetchIDsVacancies"
+
+	if meta.Found > maxVacancies {
+		refs, err := c.fetchIDsSplit(ctx, meta, query, area, window, stats)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return refs, nil
 	}
 
-	return result, nil
+	refs, err := c.fetchIDsPages(ctx, meta, query, area, window, stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return dedupVacancyRefs(refs), nil
 }
 
-func (c *client) fetchIDsVacancies(ctx context.Context, meta metadata, query, area string) ([]string, error) {
-	const op = "integration.hh.hClient.fetchIDsVacancies"
+// fetchIDsPages lists the search result pages, which hold at most maxVacancies vacancies.
+func (c *client) fetchIDsPages(ctx context.Context, meta metadata, query, area string, window searchWindow, stats *fetchStats) ([]vacancyRef, error) {
+	const op = "integration.hh.hClient.fetchIDsPages"
 
 	lenFound := 0
 	if meta.Found > maxVacancies {
@@ -248,7 +325,7 @@ func (c *client) fetchIDsVacancies(ctx context.Context, meta metadata, query, ar
 		lenFound = meta.Found
 	}
 
-	ids := make([]string, 0, lenFound)
+	ids := make([]vacancyRef, 0, lenFound)
 
 	var mu sync.Mutex
 	var wg sync.WaitGroup
@@ -273,7 +350,7 @@ func (c *client) fetchIDsVacancies(ctx context.Context, meta metadata, query, ar
 			defer wg.Done()
 			defer func() { <-sem }()
 
-			temp, err := c.fetchIDsFromPage(ctx, i, query, area)
+			temp, err := c.fetchIDsFromPage(ctx, i, query, area, window)
 			if err != nil {
 				failedPages.Add(1)
 
@@ -300,6 +377,8 @@ func (c *client) fetchIDsVacancies(ctx context.Context, meta metadata, query, ar
 	failed := int(failedPages.Load())
 	success := int(successPages.Load())
 
+	stats.addPagesFailed(failed)
+
 	if failed > 0 {
 		c.logger.WarnContext(ctx, op,
 			"event", "partial_data_loss",
@@ -340,7 +419,7 @@ func (c *client) fetchDataVacancy(ctx context.Context, id string) (vacancyRespon
 	return data, nil
 }
 
-func (c *client) fetchDataVacancies(ctx context.Context, ids []string) ([]vacancyResponse, error) {
+func (c *client) fetchDataVacancies(ctx context.Context, ids []string, stats *fetchStats) ([]vacancyResponse, error) {
 	const op = "integration.hh.hClient.fetchDataVacancies"
 
 	jobs := make(chan string, workers)
@@ -413,6 +492,8 @@ func (c *client) fetchDataVacancies(ctx context.Context, ids []string) ([]vacanc
 	failed := int(failedVacancies.Load())
 	success := int(successVacancies.Load())
 
+	stats.addVacanciesFailed(failed)
+
 	if failed > 0 {
 		c.logger.WarnContext(ctx, op,
 			"event", "partial_data_loss",
@@ -432,8 +513,8 @@ func (c *client) fetchDataVacancies(ctx context.Context, ids []string) ([]vacanc
 func (c *client) fetchDataProfession(ctx context.Context, query, area string) (professionData, error) {
 	const op = "integration.hh.hClient.fetchDataProfession"
 
-	ctx, cancel := context.WithTimeout(ctx, 15*time.Minute)
-	defer cancel()
+	ctx, release := withFetchBudget(ctx, c.professionTimeout(), c.rateLimitWaitTimeout())
+	defer release()
 
 	if query == "" {
 		return professionData{}, fmt.Errorf("%s: query cannot be empty", op)
@@ -442,29 +523,119 @@ func (c *client) fetchDataProfession(ctx context.Context, query, area string) (p
 		return professionData{}, fmt.Errorf("%s: area cannot be empty", op)
 	}
 
-	meta, err := c.fetchMeta(ctx, query, area)
+	meta, err := c.fetchMeta(ctx, query, area, searchWindow{})
 	if err != nil {
-		return professionData{}, fmt.Errorf("%s: %w", op, err)
+		return professionData{}, fmt.Errorf("%s: %w", op, withCause(ctx, err))
 	}
 
-	ids, err := c.fetchIDsVacancies(ctx, meta, query, area)
-	if err != nil {
-		return professionData{}, fmt.Errorf("%s: %w", op, err)
+	if meta.Found == 0 {
+		c.logger.WarnContext(ctx, op, "event", "vacancies.not_found", "query", query)
+		return professionData{}, fmt.Errorf("%s: vacancies not found", op)
 	}
 
-	data, err := c.fetchDataVacancies(ctx, ids)
+	stats := &fetchStats{}
+
+	var data []vacancyResponse
+	if c.cache != nil {
+		data, err = c.fetchDataIncremental(ctx, meta, query, area, stats)
+	} else {
+		data, err = c.fetchDataFull(ctx, meta, query, area, stats)
+	}
 	if err != nil {
-		return professionData{}, fmt.Errorf("%s: %w", op, err)
+		return professionData{}, fmt.Errorf("%s: %w", op, withCause(ctx, err))
 	}
 
 	c.logger.InfoContext(ctx, op, "event", "data_collected", "query", query, "vacancies_count", len(data), "total_found", meta.Found)
 
 	return professionData{
-		Vacancies:  data,
-		TotalFound: meta.Found,
+		Vacancies:       data,
+		TotalFound:      meta.Found,
+		IDsCollected:    int(stats.idsCollected.Load()),
+		PagesFailed:     int(stats.pagesFailed.Load()),
+		VacanciesFailed: int(stats.vacanciesFailed.Load()),
 	}, nil
 }
 
+// fetchDataFull lists every vacancy of the search and fetches all their bodies.
+func (c *client) fetchDataFull(ctx context.Context, meta metadata, query, area string, stats *fetchStats) ([]vacancyResponse, error) {
+	refs, err := c.fetchIDsVacancies(ctx, meta, query, area, searchWindow{}, stats)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+
+	stats.setIDsCollected(len(ids))
+
+	return c.fetchDataVacancies(ctx, ids, stats)
+}
+
+// fetchCurrencyRates returns hh currency rates keyed by hh currency code.
+// A rate is the amount of the currency per one rouble.
+func (c *client) fetchCurrencyRates(ctx context.Context) (map[string]float64, error) {
+	const op = "integration.hh.hClient.fetchCurrencyRates"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.dictionariesURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: build request: %w", op, err)
+	}
+
+	resp, err := c.doRequestWithRetry(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var dict dictionariesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dict); err != nil {
+		return nil, fmt.Errorf("%s: decode response: %w", op, err)
+	}
+
+	rates := make(map[string]float64, len(dict.Currency))
+	for _, cur := range dict.Currency {
+		if cur.Rate <= 0 {
+			continue
+		}
+		rates[cur.Code] = cur.Rate
+	}
+
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%s: no currency rates", op)
+	}
+
+	return rates, nil
+}
+
+// fetchAreas returns the hh areas tree: countries with nested regions and cities.
+func (c *client) fetchAreas(ctx context.Context) ([]areaResponse, error) {
+	const op = "integration.hh.hClient.fetchAreas"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.areasURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: build request: %w", op, err)
+	}
+
+	resp, err := c.doRequestWithRetry(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var areas []areaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&areas); err != nil {
+		return nil, fmt.Errorf("%s: decode response: %w", op, err)
+	}
+
+	if len(areas) == 0 {
+		return nil, fmt.Errorf("%s: no areas", op)
+	}
+
+	return areas, nil
+}
+
 func isRetryable(statusCode int) bool {
 	switch statusCode {
 	case http.StatusTooManyRequests:
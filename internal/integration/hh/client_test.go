@@ -52,7 +52,7 @@ func (w *testClientWriter) Write(p []byte) (n int, err error) {
 
 // newTestClient создаёт client с кастомным baseURL для тестов
 func newTestClient(baseURL string, cfg *config.Config, logger *slog.Logger, token tokenProvider) *client {
-	c := newClient(cfg, logger, &http.Client{}, token)
+	c := newClient(cfg, logger, &http.Client{}, token, nil)
 	c.baseURL = baseURL
 	return c
 }
@@ -85,7 +85,7 @@ func TestClient_SetHeaders(t *testing.T) {
 	logger := newTestClientLogger()
 	tokenProvider := &mockTokenProvider{token: "test-token-123"}
 
-	c := newClient(cfg, logger, &http.Client{}, tokenProvider)
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
 
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://test.com", nil)
 	require.NoError(t, err)
@@ -106,7 +106,7 @@ func TestClient_SetHeaders_TokenError(t *testing.T) {
 	logger := newTestClientLogger()
 	tokenProvider := &mockTokenProvider{err: fmt.Errorf("token error")}
 
-	c := newClient(cfg, logger, &http.Client{}, tokenProvider)
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
 
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://test.com", nil)
 	require.NoError(t, err)
@@ -125,7 +125,7 @@ func TestClient_CalculateWait(t *testing.T) {
 	logger := newTestClientLogger()
 	tokenProvider := &mockTokenProvider{}
 
-	c := newClient(cfg, logger, &http.Client{}, tokenProvider)
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
 
 	tests := []struct {
 		name     string
@@ -166,7 +166,7 @@ func TestClient_DoRequestWithRetry_Success(t *testing.T) {
 	}))
 	defer server.Close()
 
-	c := newClient(cfg, logger, &http.Client{}, tokenProvider)
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
 	require.NoError(t, err)
@@ -200,7 +200,7 @@ func TestClient_DoRequestWithRetry_Retry500(t *testing.T) {
 	}))
 	defer server.Close()
 
-	c := newClient(cfg, logger, &http.Client{}, tokenProvider)
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
 	require.NoError(t, err)
@@ -234,7 +234,7 @@ func TestClient_DoRequestWithRetry_Retry403(t *testing.T) {
 	}))
 	defer server.Close()
 
-	c := newClient(cfg, logger, &http.Client{}, tokenProvider)
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
 	require.NoError(t, err)
@@ -263,7 +263,7 @@ func TestClient_DoRequestWithRetry_MaxRetries(t *testing.T) {
 	}))
 	defer server.Close()
 
-	c := newClient(cfg, logger, &http.Client{}, tokenProvider)
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
 	require.NoError(t, err)
@@ -291,7 +291,7 @@ func TestClient_DoRequestWithRetry_ContextCancelled(t *testing.T) {
 	}))
 	defer server.Close()
 
-	c := newClient(cfg, logger, &http.Client{}, tokenProvider)
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
 	require.NoError(t, err)
@@ -368,11 +368,13 @@ func TestClient_FetchIDsVacancies_PartialFailure(t *testing.T) {
 		Pages: 2,
 	}
 
-	ids, err := c.fetchIDsVacancies(ctx, meta, "test", "1")
+	stats := &fetchStats{}
+	ids, err := c.fetchIDsVacancies(ctx, meta, "test", "1", searchWindow{}, stats)
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, ids)
 	assert.Greater(t, len(ids), 0)
+	assert.Equal(t, int32(1), stats.pagesFailed.Load())
 }
 
 // TestClient_FetchIDsVacancies_AllFailed тестирует полный провал при получении ID вакансий
@@ -396,7 +398,7 @@ func TestClient_FetchIDsVacancies_AllFailed(t *testing.T) {
 		Pages: 2,
 	}
 
-	ids, err := c.fetchIDsVacancies(ctx, meta, "test", "1")
+	ids, err := c.fetchIDsVacancies(ctx, meta, "test", "1", searchWindow{}, nil)
 
 	require.Error(t, err)
 	assert.Nil(t, ids)
@@ -438,11 +440,13 @@ func TestClient_FetchDataVacancies_PartialFailure(t *testing.T) {
 
 	ids := []string{"vacancy-1", "vacancy-2", "vacancy-3"}
 
-	data, err := c.fetchDataVacancies(ctx, ids)
+	stats := &fetchStats{}
+	data, err := c.fetchDataVacancies(ctx, ids, stats)
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, data)
 	assert.Greater(t, len(data), 0)
+	assert.Equal(t, int32(1), stats.vacanciesFailed.Load())
 }
 
 // TestClient_FetchDataVacancies_AllFailed тестирует полный провал при получении данных вакансий
@@ -463,9 +467,133 @@ func TestClient_FetchDataVacancies_AllFailed(t *testing.T) {
 
 	ids := []string{"vacancy-1", "vacancy-2"}
 
-	data, err := c.fetchDataVacancies(ctx, ids)
+	data, err := c.fetchDataVacancies(ctx, ids, nil)
 
 	require.Error(t, err)
 	assert.Nil(t, data)
 	assert.Contains(t, err.Error(), "all vacancies fetch failed")
 }
+
+// TestClient_FetchCurrencyRates тестирует получение курсов валют из справочника hh
+func TestClient_FetchCurrencyRates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := newTestConfig()
+	logger := newTestClientLogger()
+	tokenProvider := &mockTokenProvider{token: "test-token"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"currency": [
+			{"code": "RUR", "rate": 1.0},
+			{"code": "USD", "rate": 0.0105},
+			{"code": "XXX", "rate": 0}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
+	c.dictionariesURL = server.URL
+
+	rates, err := c.fetchCurrencyRates(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"RUR": 1.0, "USD": 0.0105}, rates)
+}
+
+// TestClient_FetchFirstPage тестирует получение первой страницы поиска вместе с метаданными одним запросом
+func TestClient_FetchFirstPage(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "golang", r.URL.Query().Get("text"))
+		assert.Equal(t, "0", r.URL.Query().Get("page"))
+		assert.Equal(t, "1", r.URL.Query().Get("area"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"found": 250, "pages": 3, "items": [
+			{"id": "101", "name": "Go Developer", "published_at": "2025-01-15T10:30:00+0300"},
+			{"id": "102", "name": "Golang Backend Engineer", "published_at": "2025-01-14T09:00:00+0300"}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL, newTestConfig(), newTestClientLogger(), &mockTokenProvider{token: "test-token"})
+
+	page, err := c.fetchFirstPage(context.Background(), "golang", "1")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+	assert.Equal(t, 250, page.Found)
+	assert.Equal(t, 3, page.Pages)
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, "Golang Backend Engineer", page.Items[1].Name)
+}
+
+// TestClient_FetchFirstPage_EmptyQuery тестирует отказ без запроса к hh
+func TestClient_FetchFirstPage_EmptyQuery(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient("http://127.0.0.1:0", newTestConfig(), newTestClientLogger(), &mockTokenProvider{token: "test-token"})
+
+	_, err := c.fetchFirstPage(context.Background(), "", "113")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "query cannot be empty")
+}
+
+// TestClient_FetchAreas тестирует получение дерева регионов hh
+func TestClient_FetchAreas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := newTestConfig()
+	logger := newTestClientLogger()
+	tokenProvider := &mockTokenProvider{token: "test-token"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[
+			{"id": "113", "parent_id": null, "name": "Россия", "areas": [
+				{"id": "1", "parent_id": "113", "name": "Москва", "areas": []}
+			]}
+		]`))
+	}))
+	defer server.Close()
+
+	c := newClient(cfg, logger, &http.Client{}, tokenProvider, nil)
+	c.areasURL = server.URL
+
+	areas, err := c.fetchAreas(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, areas, 1)
+	assert.Equal(t, "113", areas[0].ID)
+	require.Len(t, areas[0].Areas, 1)
+	assert.Equal(t, "Москва", areas[0].Areas[0].Name)
+}
+
+// TestClient_FetchAreas_Empty тестирует пустой ответ справочника регионов
+func TestClient_FetchAreas_Empty(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c := newClient(newTestConfig(), newTestClientLogger(), &http.Client{}, &mockTokenProvider{token: "test-token"}, nil)
+	c.areasURL = server.URL
+
+	_, err := c.fetchAreas(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no areas")
+}
@@ -0,0 +1,215 @@
+package hh
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"psa/internal/domain"
+)
+
+const (
+	// hh keeps a vacancy published for 30 days unless it is republished
+	vacancyLifetime = 30 * 24 * time.Hour
+
+	// cursorFullRefresh is the cursor age after which the full search is repeated,
+	// dropping vacancies closed before the end of their lifetime
+	cursorFullRefresh = 7 * 24 * time.Hour
+
+	// cursorOverlap covers vacancies that reached the search index after the previous run had started
+	cursorOverlap = time.Hour
+)
+
+// VacancyCache remembers vacancies fetched by previous runs so that only new and republished vacancies
+// are requested again. A cursor is keyed by search query and area; bodies are keyed by vacancy ID and
+// shared by all searches. A missing cursor is returned as nil, missing bodies are absent from the map.
+type VacancyCache interface {
+	GetVacancyCursor(ctx context.Context, key string) (*domain.VacancyCursor, error)
+	SaveVacancyCursor(ctx context.Context, key string, cursor domain.VacancyCursor) error
+	GetVacancyBodies(ctx context.Context, ids []string) (map[string][]byte, error)
+	SaveVacancyBodies(ctx context.Context, bodies map[string][]byte) error
+}
+
+// fetchDataIncremental lists vacancies published since the previous run with date_from and merges them with
+// the vacancies remembered by the cursor. Bodies are fetched only for vacancies that are new or were republished,
+// the rest come from the cache. Without a cursor, or when it is older than cursorFullRefresh, the full search is used.
+func (c *client) fetchDataIncremental(ctx context.Context, meta metadata, query, area string, stats *fetchStats) ([]vacancyResponse, error) {
+	const op = "integration.hh.hClient.fetchDataIncremental"
+
+	startedAt := time.Now().UTC()
+	key := cursorKey(query, area)
+
+	cursor, err := c.cache.GetVacancyCursor(ctx, key)
+	if err != nil {
+		c.logger.WarnContext(ctx, op, "event", "cursor_load_failed", "query", query, "error", err)
+		cursor = nil
+	}
+
+	full := cursor == nil || startedAt.Sub(cursor.FetchedAt) >= cursorFullRefresh
+
+	var refs []vacancyRef
+	if !full {
+		window := searchWindow{from: cursor.FetchedAt.Add(-cursorOverlap)}
+
+		updated, err := c.fetchMeta(ctx, query, area, window)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if updated.Found > 0 {
+			refs, err = c.fetchIDsVacancies(ctx, updated, query, area, window, stats)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	var known map[string]time.Time
+	if full {
+		refs, err = c.fetchIDsVacancies(ctx, meta, query, area, searchWindow{}, stats)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	} else {
+		known = cursor.Vacancies
+	}
+
+	published := mergeVacancyRefs(known, refs, startedAt)
+
+	ids := make([]string, 0, len(published))
+	for id := range published {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	// The remembered vacancies are not searched again and some of them may have been closed early, so there can be
+	// more IDs than the search finds; ids_collected is bounded by total_found to count the same population.
+	stats.setIDsCollected(min(len(ids), meta.Found))
+
+	cached := c.cachedVacancies(ctx, ids, published)
+
+	missing := make([]string, 0, len(ids)-len(cached))
+	result := make([]vacancyResponse, 0, len(ids))
+	for _, id := range ids {
+		if v, ok := cached[id]; ok {
+			result = append(result, v)
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	if len(missing) > 0 {
+		fetched, err := c.fetchDataVacancies(ctx, missing, stats)
+		if err != nil {
+			if len(result) == 0 {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			c.logger.WarnContext(ctx, op, "event", "fetch_updated_failed", "query", query, "error", err)
+		}
+
+		c.saveVacancyBodies(ctx, fetched)
+		result = append(result, fetched...)
+	}
+
+	if err := c.cache.SaveVacancyCursor(ctx, key, domain.VacancyCursor{
+		FetchedAt: startedAt,
+		Vacancies: published,
+	}); err != nil {
+		c.logger.WarnContext(ctx, op, "event", "cursor_save_failed", "query", query, "error", err)
+	}
+
+	c.logger.InfoContext(ctx, op,
+		"event", "incremental_collected",
+		"query", query,
+		"area", area,
+		"full_search", full,
+		"vacancies_count", len(ids),
+		"fetched", len(missing),
+		"reused", len(cached),
+	)
+
+	return result, nil
+}
+
+// mergeVacancyRefs adds found vacancies to the known ones, dropping known vacancies past their lifetime.
+func mergeVacancyRefs(known map[string]time.Time, refs []vacancyRef, now time.Time) map[string]time.Time {
+	result := make(map[string]time.Time, len(known)+len(refs))
+	for id, publishedAt := range known {
+		if now.Sub(publishedAt) < vacancyLifetime {
+			result[id] = publishedAt
+		}
+	}
+
+	for _, ref := range refs {
+		if ref.ID == "" {
+			continue
+		}
+		publishedAt := parsePublishedAt(ref.PublishedAt)
+		if publishedAt.IsZero() {
+			publishedAt = now
+		}
+		result[ref.ID] = publishedAt
+	}
+
+	return result
+}
+
+// cachedVacancies returns cached bodies of the vacancies that were not republished since they were cached.
+func (c *client) cachedVacancies(ctx context.Context, ids []string, published map[string]time.Time) map[string]vacancyResponse {
+	const op = "integration.hh.hClient.cachedVacancies"
+
+	result := make(map[string]vacancyResponse)
+	if len(ids) == 0 {
+		return result
+	}
+
+	bodies, err := c.cache.GetVacancyBodies(ctx, ids)
+	if err != nil {
+		c.logger.WarnContext(ctx, op, "event", "bodies_load_failed", "error", err)
+		return result
+	}
+
+	for id, body := range bodies {
+		var v vacancyResponse
+		if err := json.Unmarshal(body, &v); err != nil {
+			continue
+		}
+		if !parsePublishedAt(v.PublishedAt).Equal(published[id]) {
+			continue
+		}
+		result[id] = v
+	}
+
+	return result
+}
+
+func (c *client) saveVacancyBodies(ctx context.Context, vacancies []vacancyResponse) {
+	const op = "integration.hh.hClient.saveVacancyBodies"
+
+	if len(vacancies) == 0 {
+		return
+	}
+
+	bodies := make(map[string][]byte, len(vacancies))
+	for _, v := range vacancies {
+		body, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		bodies[v.ID] = body
+	}
+
+	if err := c.cache.SaveVacancyBodies(ctx, bodies); err != nil {
+		c.logger.WarnContext(ctx, op, "event", "bodies_save_failed", "error", err)
+	}
+}
+
+// cursorKey identifies a search by area and a hash of the query, which can be long.
+func cursorKey(query, area string) string {
+	sum := sha256.Sum256([]byte(query))
+	return area + ":" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,289 @@
+package hh
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+)
+
+// fakeVacancyCache — VacancyCache в памяти
+type fakeVacancyCache struct {
+	mu      sync.Mutex
+	cursors map[string]domain.VacancyCursor
+	bodies  map[string][]byte
+}
+
+func newFakeVacancyCache() *fakeVacancyCache {
+	return &fakeVacancyCache{
+		cursors: make(map[string]domain.VacancyCursor),
+		bodies:  make(map[string][]byte),
+	}
+}
+
+func (f *fakeVacancyCache) GetVacancyCursor(_ context.Context, key string) (*domain.VacancyCursor, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cursor, ok := f.cursors[key]
+	if !ok {
+		return nil, nil
+	}
+	return &cursor, nil
+}
+
+func (f *fakeVacancyCache) SaveVacancyCursor(_ context.Context, key string, cursor domain.VacancyCursor) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cursors[key] = cursor
+	return nil
+}
+
+func (f *fakeVacancyCache) GetVacancyBodies(_ context.Context, ids []string) (map[string][]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	result := make(map[string][]byte)
+	for _, id := range ids {
+		if body, ok := f.bodies[id]; ok {
+			result[id] = body
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeVacancyCache) SaveVacancyBodies(_ context.Context, bodies map[string][]byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for id, body := range bodies {
+		f.bodies[id] = body
+	}
+	return nil
+}
+
+// fakeSearch — тестовый сервер hh: поиск отдаёт all без date_from и updated с ним,
+// детальные запросы вакансий записываются
+type fakeSearch struct {
+	mu        sync.Mutex
+	all       []vacancyRef
+	updated   []vacancyRef
+	dateFrom  []string
+	requested []string
+}
+
+func (s *fakeSearch) handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		id := strings.TrimPrefix(r.URL.Path, "/")
+		if id != "" {
+			s.requested = append(s.requested, id)
+			publishedAt := ""
+			for _, ref := range append(append([]vacancyRef{}, s.all...), s.updated...) {
+				if ref.ID == id {
+					publishedAt = ref.PublishedAt
+				}
+			}
+			_ = json.NewEncoder(w).Encode(vacancyResponse{ID: id, PublishedAt: publishedAt, Description: "body " + id})
+			return
+		}
+
+		items := s.all
+		if dateFrom := r.URL.Query().Get("date_from"); dateFrom != "" {
+			s.dateFrom = append(s.dateFrom, dateFrom)
+			items = s.updated
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"found": len(items),
+			"pages": 1,
+			"items": items,
+		})
+	})
+}
+
+func (s *fakeSearch) reset(all, updated []vacancyRef) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.all = all
+	s.updated = updated
+	s.dateFrom = nil
+	s.requested = nil
+}
+
+func ref(id string, publishedAt time.Time) vacancyRef {
+	return vacancyRef{ID: id, PublishedAt: publishedAt.Format(publishedAtLayout)}
+}
+
+func vacancyIDs(vacancies []vacancyResponse) []string {
+	ids := make([]string, len(vacancies))
+	for i, v := range vacancies {
+		ids[i] = v.ID
+	}
+	return ids
+}
+
+func TestClient_FetchDataProfession_Incremental(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	search := &fakeSearch{}
+	server := httptest.NewServer(search.handler())
+	defer server.Close()
+
+	cache := newFakeVacancyCache()
+	c := newClient(newTestConfig(), newTestClientLogger(), &http.Client{}, &mockTokenProvider{token: "test-token"}, cache)
+	c.baseURL = server.URL
+
+	search.reset([]vacancyRef{ref("1", now.Add(-48*time.Hour)), ref("2", now.Add(-24*time.Hour))}, nil)
+
+	// Act: первый запуск без курсора — полный поиск
+	data, err := c.fetchDataProfession(ctx, "golang", "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"1", "2"}, vacancyIDs(data.Vacancies))
+	assert.Empty(t, search.dateFrom)
+	assert.ElementsMatch(t, []string{"1", "2"}, search.requested)
+
+	cursor, err := cache.GetVacancyCursor(ctx, cursorKey("golang", "1"))
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+	assert.Len(t, cursor.Vacancies, 2)
+	assert.Len(t, cache.bodies, 2)
+
+	// Arrange: вакансия 2 переопубликована, вакансия 3 новая
+	search.reset(
+		[]vacancyRef{ref("1", now.Add(-48*time.Hour)), ref("2", now), ref("3", now)},
+		[]vacancyRef{ref("2", now), ref("3", now)},
+	)
+
+	// Act: второй запуск со свежим курсором — только новые и переопубликованные
+	data, err = c.fetchDataProfession(ctx, "golang", "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, vacancyIDs(data.Vacancies))
+	require.Len(t, search.dateFrom, 2, "meta and page requests use date_from")
+	assert.Equal(t, cursor.FetchedAt.Add(-cursorOverlap).Format(publishedAtLayout), search.dateFrom[0])
+	assert.ElementsMatch(t, []string{"2", "3"}, search.requested)
+	assert.Equal(t, 3, data.TotalFound)
+}
+
+func TestClient_FetchDataProfession_StaleCursor(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	search := &fakeSearch{}
+	server := httptest.NewServer(search.handler())
+	defer server.Close()
+
+	cache := newFakeVacancyCache()
+	require.NoError(t, cache.SaveVacancyCursor(ctx, cursorKey("golang", "1"), domain.VacancyCursor{
+		FetchedAt: now.Add(-cursorFullRefresh - time.Hour),
+		Vacancies: map[string]time.Time{"closed": now.Add(-72 * time.Hour)},
+	}))
+
+	c := newClient(newTestConfig(), newTestClientLogger(), &http.Client{}, &mockTokenProvider{token: "test-token"}, cache)
+	c.baseURL = server.URL
+
+	search.reset([]vacancyRef{ref("1", now)}, nil)
+
+	// Act
+	data, err := c.fetchDataProfession(ctx, "golang", "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, vacancyIDs(data.Vacancies))
+	assert.Empty(t, search.dateFrom)
+
+	cursor, err := cache.GetVacancyCursor(ctx, cursorKey("golang", "1"))
+	require.NoError(t, err)
+	assert.NotContains(t, cursor.Vacancies, "closed", "full search drops vacancies closed early")
+}
+
+func TestClient_FetchDataProfession_IncrementalIDsBoundedByFound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	search := &fakeSearch{}
+	server := httptest.NewServer(search.handler())
+	defer server.Close()
+
+	// вакансия closed запомнена курсором, но поиск её больше не находит
+	cache := newFakeVacancyCache()
+	require.NoError(t, cache.SaveVacancyCursor(ctx, cursorKey("golang", "1"), domain.VacancyCursor{
+		FetchedAt: now.Add(-time.Hour),
+		Vacancies: map[string]time.Time{"1": now.Add(-48 * time.Hour), "closed": now.Add(-72 * time.Hour)},
+	}))
+
+	c := newClient(newTestConfig(), newTestClientLogger(), &http.Client{}, &mockTokenProvider{token: "test-token"}, cache)
+	c.baseURL = server.URL
+
+	search.reset(
+		[]vacancyRef{ref("1", now.Add(-48*time.Hour)), ref("2", now)},
+		[]vacancyRef{ref("2", now)},
+	)
+
+	// Act
+	data, err := c.fetchDataProfession(ctx, "golang", "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"1", "2", "closed"}, vacancyIDs(data.Vacancies))
+	assert.Equal(t, 2, data.TotalFound)
+	assert.Equal(t, 2, data.IDsCollected, "ids_collected does not exceed total_found")
+}
+
+func TestMergeVacancyRefs(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	t.Run("истёкшие вакансии удаляются", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		known := map[string]time.Time{
+			"old":   now.Add(-vacancyLifetime - time.Hour),
+			"fresh": now.Add(-time.Hour),
+		}
+
+		// Act
+		result := mergeVacancyRefs(known, []vacancyRef{ref("new", now), {ID: ""}}, now)
+
+		// Assert
+		assert.Equal(t, map[string]time.Time{
+			"fresh": now.Add(-time.Hour),
+			"new":   now,
+		}, result)
+	})
+
+	t.Run("переопубликованная вакансия обновляет дату", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		result := mergeVacancyRefs(map[string]time.Time{"1": now.Add(-48 * time.Hour)}, []vacancyRef{ref("1", now)}, now)
+
+		// Assert
+		assert.Equal(t, now, result["1"])
+	})
+}
@@ -1,24 +1,102 @@
 package hh
 
+import "sync/atomic"
+
 type metadata struct {
 	Found int `json:"found"`
 	Pages int `json:"pages"`
 }
 
 type vacancyIDResponse struct {
-	Items []struct {
-		ID string `json:"id"`
-	} `json:"items"`
+	Items []vacancyRef `json:"items"`
+}
+
+// searchPageResponse is a search results page together with the metadata of the whole search.
+type searchPageResponse struct {
+	metadata
+	Items []vacancyRef `json:"items"`
+}
+
+// vacancyRef is a vacancy of a search results page. hh moves PublishedAt forward when a vacancy is republished.
+type vacancyRef struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	PublishedAt string `json:"published_at"`
 }
 
 type vacancyResponse struct {
-	Description string `json:"description"`
+	ID          string          `json:"id"`
+	PublishedAt string          `json:"published_at"`
+	Description string          `json:"description"`
+	Salary      *salaryResponse `json:"salary"`
+	Experience  *dictionaryItem `json:"experience"`
+	Employment  *dictionaryItem `json:"employment"`
+	Schedule    *dictionaryItem `json:"schedule"`
 	KeySkills   []struct {
 		Name string `json:"name"`
 	} `json:"key_skills"`
 }
 
+type dictionaryItem struct {
+	ID string `json:"id"`
+}
+
+func (d *dictionaryItem) id() string {
+	if d == nil {
+		return ""
+	}
+	return d.ID
+}
+
+type salaryResponse struct {
+	From     *int   `json:"from"`
+	To       *int   `json:"to"`
+	Currency string `json:"currency"`
+	Gross    bool   `json:"gross"`
+}
+
+type dictionariesResponse struct {
+	Currency []struct {
+		Code string  `json:"code"`
+		Rate float64 `json:"rate"`
+	} `json:"currency"`
+}
+
+type areaResponse struct {
+	ID    string         `json:"id"`
+	Name  string         `json:"name"`
+	Areas []areaResponse `json:"areas"`
+}
+
 type professionData struct {
-	Vacancies  []vacancyResponse
-	TotalFound int
+	Vacancies       []vacancyResponse
+	TotalFound      int
+	IDsCollected    int
+	PagesFailed     int
+	VacanciesFailed int
+}
+
+// fetchStats accumulates what a profession fetch collected and lost. A nil fetchStats discards the counts.
+type fetchStats struct {
+	idsCollected    atomic.Int32
+	pagesFailed     atomic.Int32
+	vacanciesFailed atomic.Int32
+}
+
+func (s *fetchStats) setIDsCollected(n int) {
+	if s != nil {
+		s.idsCollected.Store(int32(n))
+	}
+}
+
+func (s *fetchStats) addPagesFailed(n int) {
+	if s != nil {
+		s.pagesFailed.Add(int32(n))
+	}
+}
+
+func (s *fetchStats) addVacanciesFailed(n int) {
+	if s != nil {
+		s.vacanciesFailed.Add(int32(n))
+	}
 }
@@ -0,0 +1,136 @@
+package hh
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// minSplitWindow is the narrowest publication window a search is split into.
+// A window that is still over the cap is listed truncated.
+const minSplitWindow = time.Minute
+
+// searchWindow restricts a search to vacancies published within it. A zero bound is open.
+type searchWindow struct {
+	from time.Time
+	to   time.Time
+}
+
+// fetchIDsSplit lists a search that is over the maxVacancies cap by splitting it into publication windows.
+// A window over the cap is halved again until every window fits or reaches minSplitWindow. Windows share
+// their bounds, so the collected vacancies are de-duplicated.
+func (c *client) fetchIDsSplit(ctx context.Context, meta metadata, query, area string, window searchWindow, stats *fetchStats) ([]vacancyRef, error) {
+	const op = "integration.hh.hClient.fetchIDsSplit"
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var (
+		refs      []vacancyRef
+		listed    int
+		failed    int
+		truncated int
+	)
+
+	pending := splitWindow(window, now)
+	for len(pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		w := pending[0]
+		pending = pending[1:]
+
+		wMeta, err := c.fetchMeta(ctx, query, area, w)
+		if err != nil {
+			failed++
+			stats.addPagesFailed(1)
+			c.logger.ErrorContext(ctx, op, "event", "fetch_window_failed", "query", query, "from", w.from, "to", w.to, "error", err)
+			continue
+		}
+		if wMeta.Found == 0 {
+			continue
+		}
+
+		if wMeta.Found > maxVacancies {
+			if halves := splitWindow(w, now); halves != nil {
+				pending = append(halves, pending...)
+				continue
+			}
+
+			truncated++
+			c.logger.WarnContext(ctx, op, "event", "window_truncated", "query", query, "from", w.from, "to", w.to, "found", wMeta.Found)
+		}
+
+		windowRefs, err := c.fetchIDsPages(ctx, wMeta, query, area, w, stats)
+		if err != nil {
+			failed++
+			c.logger.ErrorContext(ctx, op, "event", "fetch_window_failed", "query", query, "from", w.from, "to", w.to, "error", err)
+			continue
+		}
+
+		listed++
+		refs = append(refs, windowRefs...)
+	}
+
+	if listed == 0 && failed > 0 {
+		return nil, fmt.Errorf("%s: all windows failed", op)
+	}
+
+	result := dedupVacancyRefs(refs)
+
+	c.logger.InfoContext(ctx, op,
+		"event", "search_split",
+		"query", query,
+		"area", area,
+		"total_found", meta.Found,
+		"windows", listed,
+		"failed_windows", failed,
+		"truncated_windows", truncated,
+		"vacancies_count", len(result),
+	)
+
+	return result, nil
+}
+
+// splitWindow halves the window, or returns nil when it is too narrow to split. An open upper bound
+// is taken as now and an open lower bound as vacancyLifetime before now; the outer halves keep them
+// open so vacancies outside these assumptions are still found.
+func splitWindow(w searchWindow, now time.Time) []searchWindow {
+	to := w.to
+	if to.IsZero() {
+		to = now
+	}
+	from := w.from
+	if from.IsZero() {
+		from = now.Add(-vacancyLifetime)
+		if !from.Before(to) {
+			from = to.Add(-vacancyLifetime)
+		}
+	}
+
+	if to.Sub(from) < 2*minSplitWindow {
+		return nil
+	}
+
+	mid := from.Add(to.Sub(from) / 2).Truncate(time.Second)
+
+	return []searchWindow{
+		{from: w.from, to: mid},
+		{from: mid, to: w.to},
+	}
+}
+
+// dedupVacancyRefs drops repeated vacancies, keeping the first occurrence.
+func dedupVacancyRefs(refs []vacancyRef) []vacancyRef {
+	seen := make(map[string]struct{}, len(refs))
+	result := make([]vacancyRef, 0, len(refs))
+	for _, ref := range refs {
+		if _, ok := seen[ref.ID]; ok {
+			continue
+		}
+		seen[ref.ID] = struct{}{}
+		result = append(result, ref)
+	}
+
+	return result
+}
@@ -0,0 +1,175 @@
+package hh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWindowSearchServer — тестовый сервер поиска hh, фильтрующий вакансии по date_from/date_to включительно
+// и, как hh, отдающий не больше maxVacancies вакансий выдачи
+func newWindowSearchServer(t *testing.T, vacancies []vacancyRef, metaCalls *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		var from, to time.Time
+		if v := q.Get("date_from"); v != "" {
+			from = parsePublishedAt(v)
+		}
+		if v := q.Get("date_to"); v != "" {
+			to = parsePublishedAt(v)
+		}
+
+		var found []vacancyRef
+		for _, v := range vacancies {
+			publishedAt := parsePublishedAt(v.PublishedAt)
+			if !from.IsZero() && publishedAt.Before(from) {
+				continue
+			}
+			if !to.IsZero() && publishedAt.After(to) {
+				continue
+			}
+			found = append(found, v)
+		}
+
+		page, _ := strconv.Atoi(q.Get("page"))
+		if page == 0 {
+			metaCalls.Add(1)
+		}
+
+		visible := found[:min(len(found), maxVacancies)]
+		start := min(page*perPage, len(visible))
+		end := min(start+perPage, len(visible))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"found": len(found),
+			"pages": (len(visible) + perPage - 1) / perPage,
+			"items": visible[start:end],
+		})
+	}))
+}
+
+func TestClient_FetchIDsVacancies_SplitsOverCap(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	const total = 4500
+	vacancies := make([]vacancyRef, total)
+	for i := range vacancies {
+		publishedAt := now.Add(-time.Duration(i) * 9 * time.Minute)
+		vacancies[i] = vacancyRef{ID: fmt.Sprintf("v%d", i), PublishedAt: publishedAt.Format(publishedAtLayout)}
+	}
+
+	var metaCalls atomic.Int32
+	server := newWindowSearchServer(t, vacancies, &metaCalls)
+	defer server.Close()
+
+	c := newTestClient(server.URL, newTestConfig(), newTestClientLogger(), &mockTokenProvider{token: "test-token"})
+
+	// Act
+	refs, err := c.fetchIDsVacancies(ctx, metadata{Found: total, Pages: maxVacancies / perPage}, "java", "1", searchWindow{}, nil)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, refs, total, "every vacancy is listed exactly once")
+
+	seen := make(map[string]bool, total)
+	for _, ref := range refs {
+		assert.False(t, seen[ref.ID], "duplicate %s", ref.ID)
+		seen[ref.ID] = true
+	}
+	assert.Greater(t, int(metaCalls.Load()), 2)
+}
+
+func TestClient_FetchIDsVacancies_UnderCapNotSplit(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	vacancies := []vacancyRef{
+		{ID: "1", PublishedAt: now.Format(publishedAtLayout)},
+		{ID: "2", PublishedAt: now.Format(publishedAtLayout)},
+	}
+
+	var metaCalls atomic.Int32
+	server := newWindowSearchServer(t, vacancies, &metaCalls)
+	defer server.Close()
+
+	c := newTestClient(server.URL, newTestConfig(), newTestClientLogger(), &mockTokenProvider{token: "test-token"})
+
+	// Act
+	refs, err := c.fetchIDsVacancies(ctx, metadata{Found: 2, Pages: 1}, "go", "1", searchWindow{}, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, refs, 2)
+	assert.Equal(t, int32(1), metaCalls.Load(), "only the first page is requested")
+}
+
+func TestSplitWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("открытое окно делится по сроку жизни вакансии", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		halves := splitWindow(searchWindow{}, now)
+
+		// Assert
+		mid := now.Add(-vacancyLifetime / 2)
+		assert.Equal(t, []searchWindow{{to: mid}, {from: mid}}, halves)
+	})
+
+	t.Run("закрытое окно делится пополам", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		from := now.Add(-2 * time.Hour)
+
+		// Act
+		halves := splitWindow(searchWindow{from: from, to: now}, now)
+
+		// Assert
+		mid := now.Add(-time.Hour)
+		assert.Equal(t, []searchWindow{{from: from, to: mid}, {from: mid, to: now}}, halves)
+	})
+
+	t.Run("узкое окно не делится", func(t *testing.T) {
+		t.Parallel()
+
+		// Act
+		halves := splitWindow(searchWindow{from: now.Add(-minSplitWindow), to: now}, now)
+
+		// Assert
+		assert.Nil(t, halves)
+	})
+}
+
+func TestDedupVacancyRefs(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	result := dedupVacancyRefs([]vacancyRef{{ID: "1"}, {ID: "2"}, {ID: "1"}})
+
+	// Assert
+	assert.Equal(t, []vacancyRef{{ID: "1"}, {ID: "2"}}, result)
+}
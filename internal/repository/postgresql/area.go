@@ -0,0 +1,70 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+// ReplaceAreas atomically replaces the whole areas dictionary.
+func (s *Storage) ReplaceAreas(ctx context.Context, areas []domain.Area) error {
+	const op = "repository.postgresql.area.ReplaceAreas"
+
+	params := make([]postgresql.InsertAreasParams, len(areas))
+	for i, a := range areas {
+		params[i] = postgresql.InsertAreasParams{
+			ID:       a.ID,
+			ParentID: optionalText(a.ParentID),
+			Name:     a.Name,
+		}
+	}
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: begin: %w", op, err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := s.Queries.WithTx(tx)
+
+	if err := q.DeleteAreas(ctx); err != nil {
+		return fmt.Errorf("%s: delete areas: %w", op, err)
+	}
+
+	if _, err := q.InsertAreas(ctx, params); err != nil {
+		return fmt.Errorf("%s: insert areas: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// SearchAreas returns areas whose name contains query, case-insensitively.
+// Names where the match is closer to the beginning come first.
+func (s *Storage) SearchAreas(ctx context.Context, query string, limit int) ([]domain.Area, error) {
+	const op = "repository.postgresql.area.SearchAreas"
+
+	rows, err := s.Queries.SearchAreas(ctx, postgresql.SearchAreasParams{
+		Query:    query,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]domain.Area, len(rows))
+	for i, row := range rows {
+		result[i] = domain.Area{
+			ID:       row.ID,
+			ParentID: row.ParentID.String,
+			Name:     row.Name,
+		}
+	}
+
+	return result, nil
+}
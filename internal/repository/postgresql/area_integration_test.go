@@ -0,0 +1,72 @@
+//go:build integration
+
+// Интеграционные тесты для area репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func cleanAreaTables(ctx context.Context, t *testing.T, storage *postgresql.Storage) {
+	t.Helper()
+	_, err := storage.Pool.Exec(ctx, `TRUNCATE area`)
+	require.NoError(t, err)
+}
+
+func TestAreaRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	areas := []domain.Area{
+		{ID: "113", Name: "Россия"},
+		{ID: "1", ParentID: "113", Name: "Москва"},
+		{ID: "2019", ParentID: "113", Name: "Московская область"},
+		{ID: "2", ParentID: "113", Name: "Санкт-Петербург"},
+	}
+
+	t.Run("ReplaceAndSearchAreas_Success", func(t *testing.T) {
+		cleanAreaTables(ctx, t, storage)
+
+		// Тест
+		require.NoError(t, storage.ReplaceAreas(ctx, areas))
+
+		// Assert - поиск без учёта регистра, совпадение в начале названия выше
+		result, err := storage.SearchAreas(ctx, "моск", 10)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Area{
+			{ID: "1", ParentID: "113", Name: "Москва"},
+			{ID: "2019", ParentID: "113", Name: "Московская область"},
+		}, result)
+	})
+
+	t.Run("ReplaceAreas_RemovesStale", func(t *testing.T) {
+		cleanAreaTables(ctx, t, storage)
+		require.NoError(t, storage.ReplaceAreas(ctx, areas))
+
+		// Тест
+		require.NoError(t, storage.ReplaceAreas(ctx, []domain.Area{{ID: "113", Name: "Россия"}}))
+
+		// Assert
+		result, err := storage.SearchAreas(ctx, "", 10)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Area{{ID: "113", Name: "Россия"}}, result)
+	})
+
+	t.Run("SearchAreas_Limit", func(t *testing.T) {
+		cleanAreaTables(ctx, t, storage)
+		require.NoError(t, storage.ReplaceAreas(ctx, areas))
+
+		// Тест
+		result, err := storage.SearchAreas(ctx, "", 2)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+	})
+}
@@ -9,6 +9,77 @@ import (
 	"context"
 )
 
+// iteratorForInsertAreas implements pgx.CopyFromSource.
+type iteratorForInsertAreas struct {
+	rows                 []InsertAreasParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertAreas) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertAreas) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].ParentID,
+		r.rows[0].Name,
+	}, nil
+}
+
+func (r iteratorForInsertAreas) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertAreas(ctx context.Context, arg []InsertAreasParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"area"}, []string{"id", "parent_id", "name"}, &iteratorForInsertAreas{rows: arg})
+}
+
+// iteratorForInsertBreakdown implements pgx.CopyFromSource.
+type iteratorForInsertBreakdown struct {
+	rows                 []InsertBreakdownParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertBreakdown) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertBreakdown) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ProfessionID,
+		r.rows[0].ScrapedAtID,
+		r.rows[0].Dimension,
+		r.rows[0].Value,
+		r.rows[0].Count,
+		r.rows[0].Area,
+	}, nil
+}
+
+func (r iteratorForInsertBreakdown) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertBreakdown(ctx context.Context, arg []InsertBreakdownParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"stat_breakdown"}, []string{"profession_id", "scraped_at_id", "dimension", "value", "count", "area"}, &iteratorForInsertBreakdown{rows: arg})
+}
+
 // iteratorForInsertExtractedSkills implements pgx.CopyFromSource.
 type iteratorForInsertExtractedSkills struct {
 	rows                 []InsertExtractedSkillsParams
@@ -33,6 +104,9 @@ func (r iteratorForInsertExtractedSkills) Values() ([]interface{}, error) {
 		r.rows[0].Skill,
 		r.rows[0].Count,
 		r.rows[0].ScrapedAtID,
+		r.rows[0].ExtractorVersion,
+		r.rows[0].Area,
+		r.rows[0].Percentage,
 	}, nil
 }
 
@@ -41,7 +115,7 @@ func (r iteratorForInsertExtractedSkills) Err() error {
 }
 
 func (q *Queries) InsertExtractedSkills(ctx context.Context, arg []InsertExtractedSkillsParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"skill_extracted"}, []string{"profession_id", "skill", "count", "scraped_at_id"}, &iteratorForInsertExtractedSkills{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"skill_extracted"}, []string{"profession_id", "skill", "count", "scraped_at_id", "extractor_version", "area", "percentage"}, &iteratorForInsertExtractedSkills{rows: arg})
 }
 
 // iteratorForInsertFormalSkills implements pgx.CopyFromSource.
@@ -68,6 +142,9 @@ func (r iteratorForInsertFormalSkills) Values() ([]interface{}, error) {
 		r.rows[0].Skill,
 		r.rows[0].Count,
 		r.rows[0].ScrapedAtID,
+		r.rows[0].ExtractorVersion,
+		r.rows[0].Area,
+		r.rows[0].Percentage,
 	}, nil
 }
 
@@ -76,5 +153,131 @@ func (r iteratorForInsertFormalSkills) Err() error {
 }
 
 func (q *Queries) InsertFormalSkills(ctx context.Context, arg []InsertFormalSkillsParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"skill_formal"}, []string{"profession_id", "skill", "count", "scraped_at_id"}, &iteratorForInsertFormalSkills{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"skill_formal"}, []string{"profession_id", "skill", "count", "scraped_at_id", "extractor_version", "area", "percentage"}, &iteratorForInsertFormalSkills{rows: arg})
+}
+
+// iteratorForInsertScrapingJobs implements pgx.CopyFromSource.
+type iteratorForInsertScrapingJobs struct {
+	rows                 []InsertScrapingJobsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertScrapingJobs) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertScrapingJobs) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].RunID,
+		r.rows[0].SessionID,
+		r.rows[0].ProfessionID,
+		r.rows[0].Area,
+		r.rows[0].SaveToDb,
+		r.rows[0].Replace,
+		r.rows[0].MaxAttempts,
+	}, nil
+}
+
+func (r iteratorForInsertScrapingJobs) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertScrapingJobs(ctx context.Context, arg []InsertScrapingJobsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"scraping_job"}, []string{"id", "run_id", "session_id", "profession_id", "area", "save_to_db", "replace", "max_attempts"}, &iteratorForInsertScrapingJobs{rows: arg})
+}
+
+// iteratorForInsertSkillSalaries implements pgx.CopyFromSource.
+type iteratorForInsertSkillSalaries struct {
+	rows                 []InsertSkillSalariesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertSkillSalaries) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertSkillSalaries) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ProfessionID,
+		r.rows[0].ScrapedAtID,
+		r.rows[0].Skill,
+		r.rows[0].WithCount,
+		r.rows[0].WithMedian,
+		r.rows[0].WithoutCount,
+		r.rows[0].WithoutMedian,
+		r.rows[0].Area,
+	}, nil
+}
+
+func (r iteratorForInsertSkillSalaries) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertSkillSalaries(ctx context.Context, arg []InsertSkillSalariesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"skill_salary"}, []string{"profession_id", "scraped_at_id", "skill", "with_count", "with_median", "without_count", "without_median", "area"}, &iteratorForInsertSkillSalaries{rows: arg})
+}
+
+// iteratorForInsertVacancies implements pgx.CopyFromSource.
+type iteratorForInsertVacancies struct {
+	rows                 []InsertVacanciesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertVacancies) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertVacancies) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ExternalID,
+		r.rows[0].ProfessionID,
+		r.rows[0].ScrapedAtID,
+		r.rows[0].Description,
+		r.rows[0].DescriptionHash,
+		r.rows[0].KeySkills,
+		r.rows[0].PublishedAt,
+		r.rows[0].SalaryFrom,
+		r.rows[0].SalaryTo,
+		r.rows[0].SalaryCurrency,
+		r.rows[0].SalaryGross,
+		r.rows[0].SalaryRub,
+		r.rows[0].Experience,
+		r.rows[0].Employment,
+		r.rows[0].Schedule,
+		r.rows[0].Area,
+		r.rows[0].Source,
+	}, nil
+}
+
+func (r iteratorForInsertVacancies) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertVacancies(ctx context.Context, arg []InsertVacanciesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"vacancy"}, []string{"external_id", "profession_id", "scraped_at_id", "description", "description_hash", "key_skills", "published_at", "salary_from", "salary_to", "salary_currency", "salary_gross", "salary_rub", "experience", "employment", "schedule", "area", "source"}, &iteratorForInsertVacancies{rows: arg})
 }
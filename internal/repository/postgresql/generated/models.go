@@ -11,6 +11,12 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Area struct {
+	ID       string      `json:"id"`
+	ParentID pgtype.Text `json:"parent_id"`
+	Name     string      `json:"name"`
+}
+
 type Profession struct {
 	ID           uuid.UUID `json:"id"`
 	Name         string    `json:"name"`
@@ -28,29 +34,121 @@ type RefreshToken struct {
 type Scraping struct {
 	ID        uuid.UUID `json:"id"`
 	ScrapedAt time.Time `json:"scraped_at"`
+	Status    string    `json:"status"`
+}
+
+type ScrapingJob struct {
+	ID           uuid.UUID          `json:"id"`
+	RunID        uuid.UUID          `json:"run_id"`
+	SessionID    uuid.UUID          `json:"session_id"`
+	ProfessionID uuid.UUID          `json:"profession_id"`
+	Area         string             `json:"area"`
+	SaveToDb     bool               `json:"save_to_db"`
+	Replace      bool               `json:"replace"`
+	Status       string             `json:"status"`
+	Attempts     int32              `json:"attempts"`
+	MaxAttempts  int32              `json:"max_attempts"`
+	RunAfter     time.Time          `json:"run_after"`
+	LockedAt     pgtype.Timestamptz `json:"locked_at"`
+	LastError    string             `json:"last_error"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+}
+
+type ScrapingProfessionRun struct {
+	ID               uuid.UUID   `json:"id"`
+	SessionID        uuid.UUID   `json:"session_id"`
+	ProfessionID     uuid.UUID   `json:"profession_id"`
+	Area             string      `json:"area"`
+	TotalFound       int32       `json:"total_found"`
+	IdsCollected     int32       `json:"ids_collected"`
+	VacanciesFetched int32       `json:"vacancies_fetched"`
+	PagesFailed      int32       `json:"pages_failed"`
+	VacanciesFailed  int32       `json:"vacancies_failed"`
+	CreatedAt        time.Time   `json:"created_at"`
+	RunID            pgtype.UUID `json:"run_id"`
+	Status           string      `json:"status"`
+	Error            string      `json:"error"`
+	StartedAt        time.Time   `json:"started_at"`
+}
+
+type ScrapingRun struct {
+	ID          uuid.UUID          `json:"id"`
+	Mode        string             `json:"mode"`
+	Trigger     string             `json:"trigger"`
+	TriggeredBy pgtype.UUID        `json:"triggered_by"`
+	Status      string             `json:"status"`
+	Error       string             `json:"error"`
+	StartedAt   time.Time          `json:"started_at"`
+	FinishedAt  pgtype.Timestamptz `json:"finished_at"`
+}
+
+type Skill struct {
+	ID       uuid.UUID   `json:"id"`
+	Name     string      `json:"name"`
+	Category string      `json:"category"`
+	ParentID pgtype.UUID `json:"parent_id"`
+}
+
+type SkillAlias struct {
+	ID        uuid.UUID `json:"id"`
+	Alias     string    `json:"alias"`
+	Skill     string    `json:"skill"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type SkillExtracted struct {
-	ID           uuid.UUID `json:"id"`
-	ProfessionID uuid.UUID `json:"profession_id"`
-	Skill        string    `json:"skill"`
-	Count        int32     `json:"count"`
-	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	ID               uuid.UUID     `json:"id"`
+	ProfessionID     uuid.UUID     `json:"profession_id"`
+	Skill            string        `json:"skill"`
+	Count            int32         `json:"count"`
+	ScrapedAtID      uuid.UUID     `json:"scraped_at_id"`
+	ExtractorVersion string        `json:"extractor_version"`
+	Area             string        `json:"area"`
+	SkillID          pgtype.UUID   `json:"skill_id"`
+	Percentage       pgtype.Float8 `json:"percentage"`
 }
 
 type SkillFormal struct {
+	ID               uuid.UUID     `json:"id"`
+	ProfessionID     uuid.UUID     `json:"profession_id"`
+	Skill            string        `json:"skill"`
+	Count            int32         `json:"count"`
+	ScrapedAtID      uuid.UUID     `json:"scraped_at_id"`
+	ExtractorVersion string        `json:"extractor_version"`
+	Area             string        `json:"area"`
+	SkillID          pgtype.UUID   `json:"skill_id"`
+	Percentage       pgtype.Float8 `json:"percentage"`
+}
+
+type SkillSalary struct {
+	ID            uuid.UUID `json:"id"`
+	ProfessionID  uuid.UUID `json:"profession_id"`
+	ScrapedAtID   uuid.UUID `json:"scraped_at_id"`
+	Skill         string    `json:"skill"`
+	WithCount     int32     `json:"with_count"`
+	WithMedian    int32     `json:"with_median"`
+	WithoutCount  int32     `json:"without_count"`
+	WithoutMedian int32     `json:"without_median"`
+	Area          string    `json:"area"`
+}
+
+type Stat struct {
 	ID           uuid.UUID `json:"id"`
 	ProfessionID uuid.UUID `json:"profession_id"`
-	Skill        string    `json:"skill"`
-	Count        int32     `json:"count"`
+	VacancyCount int32     `json:"vacancy_count"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
 }
 
-type Stat struct {
+type StatBreakdown struct {
 	ID           uuid.UUID `json:"id"`
 	ProfessionID uuid.UUID `json:"profession_id"`
-	VacancyCount int32     `json:"vacancy_count"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Dimension    string    `json:"dimension"`
+	Value        string    `json:"value"`
+	Count        int32     `json:"count"`
+	Area         string    `json:"area"`
 }
 
 type StatDaily struct {
@@ -58,6 +156,18 @@ type StatDaily struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	VacancyCount int32     `json:"vacancy_count"`
 	ScrapedAt    time.Time `json:"scraped_at"`
+	Area         string    `json:"area"`
+}
+
+type StatSalary struct {
+	ID           uuid.UUID `json:"id"`
+	ProfessionID uuid.UUID `json:"profession_id"`
+	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	SampleSize   int32     `json:"sample_size"`
+	P25          int32     `json:"p25"`
+	Median       int32     `json:"median"`
+	P75          int32     `json:"p75"`
+	Area         string    `json:"area"`
 }
 
 type User struct {
@@ -67,3 +177,23 @@ type User struct {
 	IsAdmin        bool               `json:"is_admin"`
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 }
+
+type Vacancy struct {
+	ExternalID      string             `json:"external_id"`
+	ProfessionID    uuid.UUID          `json:"profession_id"`
+	ScrapedAtID     uuid.UUID          `json:"scraped_at_id"`
+	Description     string             `json:"description"`
+	DescriptionHash string             `json:"description_hash"`
+	KeySkills       []string           `json:"key_skills"`
+	PublishedAt     pgtype.Timestamptz `json:"published_at"`
+	SalaryFrom      pgtype.Int4        `json:"salary_from"`
+	SalaryTo        pgtype.Int4        `json:"salary_to"`
+	SalaryCurrency  pgtype.Text        `json:"salary_currency"`
+	SalaryGross     pgtype.Bool        `json:"salary_gross"`
+	SalaryRub       pgtype.Int4        `json:"salary_rub"`
+	Experience      pgtype.Text        `json:"experience"`
+	Employment      pgtype.Text        `json:"employment"`
+	Schedule        pgtype.Text        `json:"schedule"`
+	Area            string             `json:"area"`
+	Source          string             `json:"source"`
+}
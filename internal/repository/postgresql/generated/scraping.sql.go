@@ -11,8 +11,47 @@ import (
 	"github.com/google/uuid"
 )
 
+const finishQueuedScraping = `-- name: FinishQueuedScraping :exec
+UPDATE scraping
+SET status = CASE
+                 WHEN EXISTS (SELECT 1
+                              FROM scraping_job
+                              WHERE session_id = $1
+                                AND run_id = $2
+                                AND status = 'cancelled') THEN 'failed'
+                 WHEN NOT EXISTS (SELECT 1
+                                  FROM scraping_job
+                                  WHERE session_id = $1
+                                    AND run_id = $2
+                                    AND status = 'done') THEN 'failed'
+                 WHEN EXISTS (SELECT 1
+                              FROM scraping_job
+                              WHERE session_id = $1
+                                AND run_id = $2
+                                AND status = 'failed') THEN 'partial'
+                 ELSE 'complete'
+    END
+WHERE id = $1
+  AND status = 'running'
+  AND NOT EXISTS (SELECT 1
+                  FROM scraping_job
+                  WHERE session_id = $1
+                    AND run_id = $2
+                    AND status IN ('pending', 'running'))
+`
+
+type FinishQueuedScrapingParams struct {
+	ID    uuid.UUID `json:"id"`
+	RunID uuid.UUID `json:"run_id"`
+}
+
+func (q *Queries) FinishQueuedScraping(ctx context.Context, arg FinishQueuedScrapingParams) error {
+	_, err := q.db.Exec(ctx, finishQueuedScraping, arg.ID, arg.RunID)
+	return err
+}
+
 const getAllScrapingDates = `-- name: GetAllScrapingDates :many
-SELECT id, scraped_at
+SELECT id, scraped_at, status
 FROM scraping
 ORDER BY scraped_at DESC
 `
@@ -26,7 +65,7 @@ func (q *Queries) GetAllScrapingDates(ctx context.Context) ([]Scraping, error) {
 	var items []Scraping
 	for rows.Next() {
 		var i Scraping
-		if err := rows.Scan(&i.ID, &i.ScrapedAt); err != nil {
+		if err := rows.Scan(&i.ID, &i.ScrapedAt, &i.Status); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -38,15 +77,29 @@ func (q *Queries) GetAllScrapingDates(ctx context.Context) ([]Scraping, error) {
 }
 
 const getLatestScraping = `-- name: GetLatestScraping :one
-SELECT id, scraped_at
+SELECT id, scraped_at, status
 FROM scraping
+WHERE status = 'complete'
 ORDER BY scraped_at DESC LIMIT 1
 `
 
 func (q *Queries) GetLatestScraping(ctx context.Context) (Scraping, error) {
 	row := q.db.QueryRow(ctx, getLatestScraping)
 	var i Scraping
-	err := row.Scan(&i.ID, &i.ScrapedAt)
+	err := row.Scan(&i.ID, &i.ScrapedAt, &i.Status)
+	return i, err
+}
+
+const getScrapingByID = `-- name: GetScrapingByID :one
+SELECT id, scraped_at, status
+FROM scraping
+WHERE id = $1
+`
+
+func (q *Queries) GetScrapingByID(ctx context.Context, id uuid.UUID) (Scraping, error) {
+	row := q.db.QueryRow(ctx, getScrapingByID, id)
+	var i Scraping
+	err := row.Scan(&i.ID, &i.ScrapedAt, &i.Status)
 	return i, err
 }
 
@@ -61,3 +114,19 @@ func (q *Queries) InsertScrapingDate(ctx context.Context) (uuid.UUID, error) {
 	err := row.Scan(&id)
 	return id, err
 }
+
+const updateScrapingStatus = `-- name: UpdateScrapingStatus :exec
+UPDATE scraping
+SET status = $2
+WHERE id = $1
+`
+
+type UpdateScrapingStatusParams struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+}
+
+func (q *Queries) UpdateScrapingStatus(ctx context.Context, arg UpdateScrapingStatusParams) error {
+	_, err := q.db.Exec(ctx, updateScrapingStatus, arg.ID, arg.Status)
+	return err
+}
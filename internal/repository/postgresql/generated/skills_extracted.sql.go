@@ -10,28 +10,51 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deleteExtractedSkillsByProfessionAndSession = `-- name: DeleteExtractedSkillsByProfessionAndSession :exec
+DELETE
+FROM skill_extracted
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3
+`
+
+type DeleteExtractedSkillsByProfessionAndSessionParams struct {
+	ProfessionID uuid.UUID `json:"profession_id"`
+	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
+}
+
+func (q *Queries) DeleteExtractedSkillsByProfessionAndSession(ctx context.Context, arg DeleteExtractedSkillsByProfessionAndSessionParams) error {
+	_, err := q.db.Exec(ctx, deleteExtractedSkillsByProfessionAndSession, arg.ProfessionID, arg.ScrapedAtID, arg.Area)
+	return err
+}
+
 const getExtractedSkillsByProfessionAndDate = `-- name: GetExtractedSkillsByProfessionAndDate :many
-SELECT skill, count
+SELECT skill, count, percentage
 FROM skill_extracted
 WHERE profession_id = $1
   AND scraped_at_id = $2
+  AND area = $3
 ORDER BY count DESC
 `
 
 type GetExtractedSkillsByProfessionAndDateParams struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
 }
 
 type GetExtractedSkillsByProfessionAndDateRow struct {
-	Skill string `json:"skill"`
-	Count int32  `json:"count"`
+	Skill      string        `json:"skill"`
+	Count      int32         `json:"count"`
+	Percentage pgtype.Float8 `json:"percentage"`
 }
 
 func (q *Queries) GetExtractedSkillsByProfessionAndDate(ctx context.Context, arg GetExtractedSkillsByProfessionAndDateParams) ([]GetExtractedSkillsByProfessionAndDateRow, error) {
-	rows, err := q.db.Query(ctx, getExtractedSkillsByProfessionAndDate, arg.ProfessionID, arg.ScrapedAtID)
+	rows, err := q.db.Query(ctx, getExtractedSkillsByProfessionAndDate, arg.ProfessionID, arg.ScrapedAtID, arg.Area)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +62,7 @@ func (q *Queries) GetExtractedSkillsByProfessionAndDate(ctx context.Context, arg
 	var items []GetExtractedSkillsByProfessionAndDateRow
 	for rows.Next() {
 		var i GetExtractedSkillsByProfessionAndDateRow
-		if err := rows.Scan(&i.Skill, &i.Count); err != nil {
+		if err := rows.Scan(&i.Skill, &i.Count, &i.Percentage); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -56,6 +79,8 @@ FROM skill_extracted s
          JOIN scraping sc ON s.scraped_at_id = sc.id
 WHERE s.profession_id = $1
   AND sc.scraped_at BETWEEN $2 AND $3
+  AND s.area = $4
+  AND sc.status = 'complete'
 ORDER BY sc.scraped_at ASC
 `
 
@@ -63,6 +88,7 @@ type GetExtractedSkillsWithDatesByProfessionAndDateRangeParams struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	ScrapedAt    time.Time `json:"scraped_at"`
 	ScrapedAt_2  time.Time `json:"scraped_at_2"`
+	Area         string    `json:"area"`
 }
 
 type GetExtractedSkillsWithDatesByProfessionAndDateRangeRow struct {
@@ -72,7 +98,12 @@ type GetExtractedSkillsWithDatesByProfessionAndDateRangeRow struct {
 }
 
 func (q *Queries) GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx context.Context, arg GetExtractedSkillsWithDatesByProfessionAndDateRangeParams) ([]GetExtractedSkillsWithDatesByProfessionAndDateRangeRow, error) {
-	rows, err := q.db.Query(ctx, getExtractedSkillsWithDatesByProfessionAndDateRange, arg.ProfessionID, arg.ScrapedAt, arg.ScrapedAt_2)
+	rows, err := q.db.Query(ctx, getExtractedSkillsWithDatesByProfessionAndDateRange,
+		arg.ProfessionID,
+		arg.ScrapedAt,
+		arg.ScrapedAt_2,
+		arg.Area,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +128,8 @@ FROM skill_extracted s
          JOIN scraping sc ON s.scraped_at_id = sc.id
 WHERE s.profession_id = ANY ($1::uuid[])
   AND sc.scraped_at BETWEEN $2 AND $3
+  AND s.area = $4
+  AND sc.status = 'complete'
 ORDER BY s.profession_id, sc.scraped_at
 `
 
@@ -104,6 +137,7 @@ type GetExtractedSkillsWithDatesByProfessionsAndDateRangeParams struct {
 	Column1     []uuid.UUID `json:"column_1"`
 	ScrapedAt   time.Time   `json:"scraped_at"`
 	ScrapedAt_2 time.Time   `json:"scraped_at_2"`
+	Area        string      `json:"area"`
 }
 
 type GetExtractedSkillsWithDatesByProfessionsAndDateRangeRow struct {
@@ -114,7 +148,12 @@ type GetExtractedSkillsWithDatesByProfessionsAndDateRangeRow struct {
 }
 
 func (q *Queries) GetExtractedSkillsWithDatesByProfessionsAndDateRange(ctx context.Context, arg GetExtractedSkillsWithDatesByProfessionsAndDateRangeParams) ([]GetExtractedSkillsWithDatesByProfessionsAndDateRangeRow, error) {
-	rows, err := q.db.Query(ctx, getExtractedSkillsWithDatesByProfessionsAndDateRange, arg.Column1, arg.ScrapedAt, arg.ScrapedAt_2)
+	rows, err := q.db.Query(ctx, getExtractedSkillsWithDatesByProfessionsAndDateRange,
+		arg.Column1,
+		arg.ScrapedAt,
+		arg.ScrapedAt_2,
+		arg.Area,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -139,8 +178,32 @@ func (q *Queries) GetExtractedSkillsWithDatesByProfessionsAndDateRange(ctx conte
 }
 
 type InsertExtractedSkillsParams struct {
+	ProfessionID     uuid.UUID     `json:"profession_id"`
+	Skill            string        `json:"skill"`
+	Count            int32         `json:"count"`
+	ScrapedAtID      uuid.UUID     `json:"scraped_at_id"`
+	ExtractorVersion string        `json:"extractor_version"`
+	Area             string        `json:"area"`
+	Percentage       pgtype.Float8 `json:"percentage"`
+}
+
+const linkExtractedSkills = `-- name: LinkExtractedSkills :exec
+UPDATE skill_extracted se
+SET skill_id = s.id
+FROM skill s
+WHERE se.skill = s.name
+  AND se.profession_id = $1
+  AND se.scraped_at_id = $2
+  AND se.area = $3
+`
+
+type LinkExtractedSkillsParams struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
-	Skill        string    `json:"skill"`
-	Count        int32     `json:"count"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
+}
+
+func (q *Queries) LinkExtractedSkills(ctx context.Context, arg LinkExtractedSkillsParams) error {
+	_, err := q.db.Exec(ctx, linkExtractedSkills, arg.ProfessionID, arg.ScrapedAtID, arg.Area)
+	return err
 }
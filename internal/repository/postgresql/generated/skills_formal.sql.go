@@ -10,28 +10,51 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deleteFormalSkillsByProfessionAndSession = `-- name: DeleteFormalSkillsByProfessionAndSession :exec
+DELETE
+FROM skill_formal
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3
+`
+
+type DeleteFormalSkillsByProfessionAndSessionParams struct {
+	ProfessionID uuid.UUID `json:"profession_id"`
+	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
+}
+
+func (q *Queries) DeleteFormalSkillsByProfessionAndSession(ctx context.Context, arg DeleteFormalSkillsByProfessionAndSessionParams) error {
+	_, err := q.db.Exec(ctx, deleteFormalSkillsByProfessionAndSession, arg.ProfessionID, arg.ScrapedAtID, arg.Area)
+	return err
+}
+
 const getFormalSkillsByProfessionAndDate = `-- name: GetFormalSkillsByProfessionAndDate :many
-SELECT skill, count
+SELECT skill, count, percentage
 FROM skill_formal
 WHERE profession_id = $1
   AND scraped_at_id = $2
+  AND area = $3
 ORDER BY count DESC
 `
 
 type GetFormalSkillsByProfessionAndDateParams struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
 }
 
 type GetFormalSkillsByProfessionAndDateRow struct {
-	Skill string `json:"skill"`
-	Count int32  `json:"count"`
+	Skill      string        `json:"skill"`
+	Count      int32         `json:"count"`
+	Percentage pgtype.Float8 `json:"percentage"`
 }
 
 func (q *Queries) GetFormalSkillsByProfessionAndDate(ctx context.Context, arg GetFormalSkillsByProfessionAndDateParams) ([]GetFormalSkillsByProfessionAndDateRow, error) {
-	rows, err := q.db.Query(ctx, getFormalSkillsByProfessionAndDate, arg.ProfessionID, arg.ScrapedAtID)
+	rows, err := q.db.Query(ctx, getFormalSkillsByProfessionAndDate, arg.ProfessionID, arg.ScrapedAtID, arg.Area)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +62,54 @@ func (q *Queries) GetFormalSkillsByProfessionAndDate(ctx context.Context, arg Ge
 	var items []GetFormalSkillsByProfessionAndDateRow
 	for rows.Next() {
 		var i GetFormalSkillsByProfessionAndDateRow
-		if err := rows.Scan(&i.Skill, &i.Count); err != nil {
+		if err := rows.Scan(&i.Skill, &i.Count, &i.Percentage); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFormalSkillsByProfessionsAndSession = `-- name: GetFormalSkillsByProfessionsAndSession :many
+SELECT profession_id, skill, count, percentage
+FROM skill_formal
+WHERE profession_id = ANY ($1::uuid[])
+  AND scraped_at_id = $2
+  AND area = $3
+ORDER BY profession_id, count DESC
+`
+
+type GetFormalSkillsByProfessionsAndSessionParams struct {
+	Column1     []uuid.UUID `json:"column_1"`
+	ScrapedAtID uuid.UUID   `json:"scraped_at_id"`
+	Area        string      `json:"area"`
+}
+
+type GetFormalSkillsByProfessionsAndSessionRow struct {
+	ProfessionID uuid.UUID     `json:"profession_id"`
+	Skill        string        `json:"skill"`
+	Count        int32         `json:"count"`
+	Percentage   pgtype.Float8 `json:"percentage"`
+}
+
+func (q *Queries) GetFormalSkillsByProfessionsAndSession(ctx context.Context, arg GetFormalSkillsByProfessionsAndSessionParams) ([]GetFormalSkillsByProfessionsAndSessionRow, error) {
+	rows, err := q.db.Query(ctx, getFormalSkillsByProfessionsAndSession, arg.Column1, arg.ScrapedAtID, arg.Area)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFormalSkillsByProfessionsAndSessionRow
+	for rows.Next() {
+		var i GetFormalSkillsByProfessionsAndSessionRow
+		if err := rows.Scan(
+			&i.ProfessionID,
+			&i.Skill,
+			&i.Count,
+			&i.Percentage,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -56,6 +126,8 @@ FROM skill_formal s
          JOIN scraping sc ON s.scraped_at_id = sc.id
 WHERE s.profession_id = $1
   AND sc.scraped_at BETWEEN $2 AND $3
+  AND s.area = $4
+  AND sc.status = 'complete'
 ORDER BY sc.scraped_at ASC
 `
 
@@ -63,6 +135,7 @@ type GetFormalSkillsWithDatesByProfessionAndDateRangeParams struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	ScrapedAt    time.Time `json:"scraped_at"`
 	ScrapedAt_2  time.Time `json:"scraped_at_2"`
+	Area         string    `json:"area"`
 }
 
 type GetFormalSkillsWithDatesByProfessionAndDateRangeRow struct {
@@ -72,7 +145,12 @@ type GetFormalSkillsWithDatesByProfessionAndDateRangeRow struct {
 }
 
 func (q *Queries) GetFormalSkillsWithDatesByProfessionAndDateRange(ctx context.Context, arg GetFormalSkillsWithDatesByProfessionAndDateRangeParams) ([]GetFormalSkillsWithDatesByProfessionAndDateRangeRow, error) {
-	rows, err := q.db.Query(ctx, getFormalSkillsWithDatesByProfessionAndDateRange, arg.ProfessionID, arg.ScrapedAt, arg.ScrapedAt_2)
+	rows, err := q.db.Query(ctx, getFormalSkillsWithDatesByProfessionAndDateRange,
+		arg.ProfessionID,
+		arg.ScrapedAt,
+		arg.ScrapedAt_2,
+		arg.Area,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +175,8 @@ FROM skill_formal s
          JOIN scraping sc ON s.scraped_at_id = sc.id
 WHERE s.profession_id = ANY ($1::uuid[])
   AND sc.scraped_at BETWEEN $2 AND $3
+  AND s.area = $4
+  AND sc.status = 'complete'
 ORDER BY s.profession_id, sc.scraped_at
 `
 
@@ -104,6 +184,7 @@ type GetFormalSkillsWithDatesByProfessionsAndDateRangeParams struct {
 	Column1     []uuid.UUID `json:"column_1"`
 	ScrapedAt   time.Time   `json:"scraped_at"`
 	ScrapedAt_2 time.Time   `json:"scraped_at_2"`
+	Area        string      `json:"area"`
 }
 
 type GetFormalSkillsWithDatesByProfessionsAndDateRangeRow struct {
@@ -114,7 +195,12 @@ type GetFormalSkillsWithDatesByProfessionsAndDateRangeRow struct {
 }
 
 func (q *Queries) GetFormalSkillsWithDatesByProfessionsAndDateRange(ctx context.Context, arg GetFormalSkillsWithDatesByProfessionsAndDateRangeParams) ([]GetFormalSkillsWithDatesByProfessionsAndDateRangeRow, error) {
-	rows, err := q.db.Query(ctx, getFormalSkillsWithDatesByProfessionsAndDateRange, arg.Column1, arg.ScrapedAt, arg.ScrapedAt_2)
+	rows, err := q.db.Query(ctx, getFormalSkillsWithDatesByProfessionsAndDateRange,
+		arg.Column1,
+		arg.ScrapedAt,
+		arg.ScrapedAt_2,
+		arg.Area,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -139,8 +225,32 @@ func (q *Queries) GetFormalSkillsWithDatesByProfessionsAndDateRange(ctx context.
 }
 
 type InsertFormalSkillsParams struct {
+	ProfessionID     uuid.UUID     `json:"profession_id"`
+	Skill            string        `json:"skill"`
+	Count            int32         `json:"count"`
+	ScrapedAtID      uuid.UUID     `json:"scraped_at_id"`
+	ExtractorVersion string        `json:"extractor_version"`
+	Area             string        `json:"area"`
+	Percentage       pgtype.Float8 `json:"percentage"`
+}
+
+const linkFormalSkills = `-- name: LinkFormalSkills :exec
+UPDATE skill_formal sf
+SET skill_id = s.id
+FROM skill s
+WHERE sf.skill = s.name
+  AND sf.profession_id = $1
+  AND sf.scraped_at_id = $2
+  AND sf.area = $3
+`
+
+type LinkFormalSkillsParams struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
-	Skill        string    `json:"skill"`
-	Count        int32     `json:"count"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
+}
+
+func (q *Queries) LinkFormalSkills(ctx context.Context, arg LinkFormalSkillsParams) error {
+	_, err := q.db.Exec(ctx, linkFormalSkills, arg.ProfessionID, arg.ScrapedAtID, arg.Area)
+	return err
 }
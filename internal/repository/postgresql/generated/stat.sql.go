@@ -12,22 +12,48 @@ import (
 	"github.com/google/uuid"
 )
 
-const getLatestStatByProfessionID = `-- name: GetLatestStatByProfessionID :one
+const deleteStatByProfessionAndSession = `-- name: DeleteStatByProfessionAndSession :exec
+DELETE
+FROM stat
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3
+`
+
+type DeleteStatByProfessionAndSessionParams struct {
+	ProfessionID uuid.UUID `json:"profession_id"`
+	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
+}
+
+func (q *Queries) DeleteStatByProfessionAndSession(ctx context.Context, arg DeleteStatByProfessionAndSessionParams) error {
+	_, err := q.db.Exec(ctx, deleteStatByProfessionAndSession, arg.ProfessionID, arg.ScrapedAtID, arg.Area)
+	return err
+}
+
+const getStatByProfessionAndSession = `-- name: GetStatByProfessionAndSession :one
 SELECT profession_id, vacancy_count, scraped_at_id
 FROM stat
 WHERE profession_id = $1
-ORDER BY scraped_at_id DESC LIMIT 1
+  AND scraped_at_id = $2
+  AND area = $3
 `
 
-type GetLatestStatByProfessionIDRow struct {
+type GetStatByProfessionAndSessionParams struct {
+	ProfessionID uuid.UUID `json:"profession_id"`
+	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
+}
+
+type GetStatByProfessionAndSessionRow struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	VacancyCount int32     `json:"vacancy_count"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
 }
 
-func (q *Queries) GetLatestStatByProfessionID(ctx context.Context, professionID uuid.UUID) (GetLatestStatByProfessionIDRow, error) {
-	row := q.db.QueryRow(ctx, getLatestStatByProfessionID, professionID)
-	var i GetLatestStatByProfessionIDRow
+func (q *Queries) GetStatByProfessionAndSession(ctx context.Context, arg GetStatByProfessionAndSessionParams) (GetStatByProfessionAndSessionRow, error) {
+	row := q.db.QueryRow(ctx, getStatByProfessionAndSession, arg.ProfessionID, arg.ScrapedAtID, arg.Area)
+	var i GetStatByProfessionAndSessionRow
 	err := row.Scan(&i.ProfessionID, &i.VacancyCount, &i.ScrapedAtID)
 	return i, err
 }
@@ -38,6 +64,8 @@ FROM stat
          JOIN scraping sc ON stat.scraped_at_id = sc.id
 WHERE profession_id = ANY ($1::uuid[])
   AND sc.scraped_at BETWEEN $2 AND $3
+  AND stat.area = $4
+  AND sc.status = 'complete'
 ORDER BY profession_id, sc.scraped_at
 `
 
@@ -45,6 +73,7 @@ type GetStatsByProfessionsAndDateRangeParams struct {
 	Column1     []uuid.UUID `json:"column_1"`
 	ScrapedAt   time.Time   `json:"scraped_at"`
 	ScrapedAt_2 time.Time   `json:"scraped_at_2"`
+	Area        string      `json:"area"`
 }
 
 type GetStatsByProfessionsAndDateRangeRow struct {
@@ -54,7 +83,12 @@ type GetStatsByProfessionsAndDateRangeRow struct {
 }
 
 func (q *Queries) GetStatsByProfessionsAndDateRange(ctx context.Context, arg GetStatsByProfessionsAndDateRangeParams) ([]GetStatsByProfessionsAndDateRangeRow, error) {
-	rows, err := q.db.Query(ctx, getStatsByProfessionsAndDateRange, arg.Column1, arg.ScrapedAt, arg.ScrapedAt_2)
+	rows, err := q.db.Query(ctx, getStatsByProfessionsAndDateRange,
+		arg.Column1,
+		arg.ScrapedAt,
+		arg.ScrapedAt_2,
+		arg.Area,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -73,19 +107,66 @@ func (q *Queries) GetStatsByProfessionsAndDateRange(ctx context.Context, arg Get
 	return items, nil
 }
 
+const getStatsByProfessionsAndSession = `-- name: GetStatsByProfessionsAndSession :many
+SELECT profession_id, vacancy_count, scraped_at_id
+FROM stat
+WHERE profession_id = ANY ($1::uuid[])
+  AND scraped_at_id = $2
+  AND area = $3
+ORDER BY profession_id
+`
+
+type GetStatsByProfessionsAndSessionParams struct {
+	Column1     []uuid.UUID `json:"column_1"`
+	ScrapedAtID uuid.UUID   `json:"scraped_at_id"`
+	Area        string      `json:"area"`
+}
+
+type GetStatsByProfessionsAndSessionRow struct {
+	ProfessionID uuid.UUID `json:"profession_id"`
+	VacancyCount int32     `json:"vacancy_count"`
+	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+}
+
+func (q *Queries) GetStatsByProfessionsAndSession(ctx context.Context, arg GetStatsByProfessionsAndSessionParams) ([]GetStatsByProfessionsAndSessionRow, error) {
+	rows, err := q.db.Query(ctx, getStatsByProfessionsAndSession, arg.Column1, arg.ScrapedAtID, arg.Area)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStatsByProfessionsAndSessionRow
+	for rows.Next() {
+		var i GetStatsByProfessionsAndSessionRow
+		if err := rows.Scan(&i.ProfessionID, &i.VacancyCount, &i.ScrapedAtID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertStat = `-- name: InsertStat :one
-INSERT INTO stat (profession_id, vacancy_count, scraped_at_id)
-VALUES ($1, $2, $3) RETURNING id
+INSERT INTO stat (profession_id, vacancy_count, scraped_at_id, area)
+VALUES ($1, $2, $3, $4) RETURNING id
 `
 
 type InsertStatParams struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	VacancyCount int32     `json:"vacancy_count"`
 	ScrapedAtID  uuid.UUID `json:"scraped_at_id"`
+	Area         string    `json:"area"`
 }
 
 func (q *Queries) InsertStat(ctx context.Context, arg InsertStatParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertStat, arg.ProfessionID, arg.VacancyCount, arg.ScrapedAtID)
+	row := q.db.QueryRow(ctx, insertStat,
+		arg.ProfessionID,
+		arg.VacancyCount,
+		arg.ScrapedAtID,
+		arg.Area,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -17,17 +17,23 @@ SELECT DISTINCT ON (DATE(scraped_at))
     profession_id, vacancy_count, scraped_at
 FROM stat_daily
 WHERE profession_id = $1
+  AND area = $2
 ORDER BY DATE(scraped_at), scraped_at DESC
 `
 
+type GetStatDailyByProfessionIDParams struct {
+	ProfessionID uuid.UUID `json:"profession_id"`
+	Area         string    `json:"area"`
+}
+
 type GetStatDailyByProfessionIDRow struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	VacancyCount int32     `json:"vacancy_count"`
 	ScrapedAt    time.Time `json:"scraped_at"`
 }
 
-func (q *Queries) GetStatDailyByProfessionID(ctx context.Context, professionID uuid.UUID) ([]GetStatDailyByProfessionIDRow, error) {
-	rows, err := q.db.Query(ctx, getStatDailyByProfessionID, professionID)
+func (q *Queries) GetStatDailyByProfessionID(ctx context.Context, arg GetStatDailyByProfessionIDParams) ([]GetStatDailyByProfessionIDRow, error) {
+	rows, err := q.db.Query(ctx, getStatDailyByProfessionID, arg.ProfessionID, arg.Area)
 	if err != nil {
 		return nil, err
 	}
@@ -50,17 +56,23 @@ const getStatDailyByProfessionIDs = `-- name: GetStatDailyByProfessionIDs :many
 SELECT profession_id, vacancy_count, scraped_at
 FROM stat_daily
 WHERE profession_id = ANY ($1::uuid[])
+  AND area = $2
 ORDER BY profession_id, scraped_at
 `
 
+type GetStatDailyByProfessionIDsParams struct {
+	Column1 []uuid.UUID `json:"column_1"`
+	Area    string      `json:"area"`
+}
+
 type GetStatDailyByProfessionIDsRow struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	VacancyCount int32     `json:"vacancy_count"`
 	ScrapedAt    time.Time `json:"scraped_at"`
 }
 
-func (q *Queries) GetStatDailyByProfessionIDs(ctx context.Context, dollar_1 []uuid.UUID) ([]GetStatDailyByProfessionIDsRow, error) {
-	rows, err := q.db.Query(ctx, getStatDailyByProfessionIDs, dollar_1)
+func (q *Queries) GetStatDailyByProfessionIDs(ctx context.Context, arg GetStatDailyByProfessionIDsParams) ([]GetStatDailyByProfessionIDsRow, error) {
+	rows, err := q.db.Query(ctx, getStatDailyByProfessionIDs, arg.Column1, arg.Area)
 	if err != nil {
 		return nil, err
 	}
@@ -80,18 +92,24 @@ func (q *Queries) GetStatDailyByProfessionIDs(ctx context.Context, dollar_1 []uu
 }
 
 const insertStatDaily = `-- name: InsertStatDaily :one
-INSERT INTO stat_daily (profession_id, vacancy_count, scraped_at)
-VALUES ($1, $2, $3) RETURNING id
+INSERT INTO stat_daily (profession_id, vacancy_count, scraped_at, area)
+VALUES ($1, $2, $3, $4) RETURNING id
 `
 
 type InsertStatDailyParams struct {
 	ProfessionID uuid.UUID `json:"profession_id"`
 	VacancyCount int32     `json:"vacancy_count"`
 	ScrapedAt    time.Time `json:"scraped_at"`
+	Area         string    `json:"area"`
 }
 
 func (q *Queries) InsertStatDaily(ctx context.Context, arg InsertStatDailyParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertStatDaily, arg.ProfessionID, arg.VacancyCount, arg.ScrapedAt)
+	row := q.db.QueryRow(ctx, insertStatDaily,
+		arg.ProfessionID,
+		arg.VacancyCount,
+		arg.ScrapedAt,
+		arg.Area,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
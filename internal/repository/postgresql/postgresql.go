@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"psa/internal/config"
@@ -48,3 +49,44 @@ func (s *Storage) Close() {
 func (s *Storage) Ping(ctx context.Context) error {
 	return s.Pool.Ping(ctx)
 }
+
+type txKey struct{}
+
+// InTx runs fn in a transaction: the storage methods that support it, called with the context fn gets, run in
+// the transaction. It is committed when fn returns nil and rolled back otherwise. InTx called within a transaction
+// runs fn in a savepoint of it.
+func (s *Storage) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	const op = "repository.postgresql.InTx"
+
+	tx, err := s.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: begin: %w", op, err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// begin starts a transaction, or a savepoint of the transaction of InTx the context belongs to.
+func (s *Storage) begin(ctx context.Context) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return s.Pool.Begin(ctx)
+}
+
+// queries returns the queries bound to the transaction of InTx the context belongs to, if any.
+func (s *Storage) queries(ctx context.Context) *postgresql.Queries {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return s.Queries.WithTx(tx)
+	}
+	return s.Queries
+}
@@ -2,28 +2,54 @@ package postgresql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
 	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
 )
 
 func (s *Storage) CreateScrapingSession(ctx context.Context) (uuid.UUID, error) {
 	return s.Queries.InsertScrapingDate(ctx)
 }
 
+// GetLatestScraping returns the latest complete session; running, partial and failed sessions are skipped.
 func (s *Storage) GetLatestScraping(ctx context.Context) (domain.Scraping, error) {
 	const op = "repository.postgresql.scraping.GetLatestScraping"
 
 	row, err := s.Queries.GetLatestScraping(ctx)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Scraping{}, domain.ErrScrapingNotFound
+		}
+		return domain.Scraping{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return domain.Scraping{
+		ID:        row.ID,
+		ScrapedAt: row.ScrapedAt,
+		Status:    row.Status,
+	}, nil
+}
+
+func (s *Storage) GetScrapingByID(ctx context.Context, id uuid.UUID) (domain.Scraping, error) {
+	const op = "repository.postgresql.scraping.GetScrapingByID"
+
+	row, err := s.Queries.GetScrapingByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Scraping{}, domain.ErrScrapingNotFound
+		}
 		return domain.Scraping{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return domain.Scraping{
 		ID:        row.ID,
 		ScrapedAt: row.ScrapedAt,
+		Status:    row.Status,
 	}, nil
 }
 
@@ -46,6 +72,36 @@ func (s *Storage) GetAllScrapingDates(ctx context.Context) ([]domain.Scraping, e
 	return scrapings, nil
 }
 
+func (s *Storage) SetScrapingStatus(ctx context.Context, id uuid.UUID, status string) error {
+	const op = "repository.postgresql.scraping.SetScrapingStatus"
+
+	err := s.Queries.UpdateScrapingStatus(ctx, postgresql.UpdateScrapingStatusParams{
+		ID:     id,
+		Status: status,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// FinishQueuedScraping sets the status of a running session once the run has no queued jobs for it left:
+// complete when every job is done, partial when some failed, failed when the run was cancelled or nothing was done.
+func (s *Storage) FinishQueuedScraping(ctx context.Context, sessionID uuid.UUID, runID uuid.UUID) error {
+	const op = "repository.postgresql.scraping.FinishQueuedScraping"
+
+	err := s.queries(ctx).FinishQueuedScraping(ctx, postgresql.FinishQueuedScrapingParams{
+		ID:    sessionID,
+		RunID: runID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
 func (s *Storage) ExistsScrapingSessionInCurrMonth(ctx context.Context) (bool, error) {
 	const op = "repository.postgresql.scraping.ExistsScrapingSessionInCurrMonth"
 
@@ -59,3 +115,80 @@ func (s *Storage) ExistsScrapingSessionInCurrMonth(ctx context.Context) (bool, e
 
 	return exists, nil
 }
+
+// ClearProfessionSession deletes everything a session holds for the profession in the area,
+// so that the profession can be scraped into the session again without duplicate rows. Within InTx the data is
+// deleted in its transaction, so that it is replaced atomically.
+func (s *Storage) ClearProfessionSession(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string) error {
+	const op = "repository.postgresql.scraping.ClearProfessionSession"
+
+	tx, err := s.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: begin: %w", op, err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := s.Queries.WithTx(tx)
+
+	if err := q.DeleteStatByProfessionAndSession(ctx, postgresql.DeleteStatByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete stat: %w", op, err)
+	}
+
+	if err := q.DeleteSalaryStatByProfessionAndSession(ctx, postgresql.DeleteSalaryStatByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete salary stat: %w", op, err)
+	}
+
+	if err := q.DeleteBreakdownByProfessionAndSession(ctx, postgresql.DeleteBreakdownByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete breakdown: %w", op, err)
+	}
+
+	if err := q.DeleteFormalSkillsByProfessionAndSession(ctx, postgresql.DeleteFormalSkillsByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete formal skills: %w", op, err)
+	}
+
+	if err := q.DeleteExtractedSkillsByProfessionAndSession(ctx, postgresql.DeleteExtractedSkillsByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete extracted skills: %w", op, err)
+	}
+
+	if err := q.DeleteSkillSalariesByProfessionAndSession(ctx, postgresql.DeleteSkillSalariesByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete skill salaries: %w", op, err)
+	}
+
+	if err := q.DeleteVacanciesByProfessionAndSession(ctx, postgresql.DeleteVacanciesByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete vacancies: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return nil
+}
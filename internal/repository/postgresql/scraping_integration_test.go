@@ -6,6 +6,7 @@ package postgresql_test
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"psa/internal/config"
+	"psa/internal/domain"
 	"psa/internal/repository/postgresql"
 	"psa/tests/containers"
 )
@@ -79,8 +81,8 @@ func createScrapingSession(ctx context.Context, t *testing.T, storage *postgresq
 
 	id := uuid.New()
 	_, err := storage.Pool.Exec(ctx, `
-		INSERT INTO scraping (id, scraped_at)
-		VALUES ($1, $2)
+		INSERT INTO scraping (id, scraped_at, status)
+		VALUES ($1, $2, 'complete')
 	`, id, scrapedAt)
 	require.NoError(t, err)
 
@@ -101,11 +103,12 @@ func TestScrapingRepository(t *testing.T) {
 		require.NoError(t, err)
 		require.NotEqual(t, uuid.Nil, id)
 
-		// Проверяем что сессия действительно создана
-		scraping, err := storage.GetLatestScraping(ctx)
+		// Проверяем что сессия действительно создана и ещё не завершена
+		scraping, err := storage.GetScrapingByID(ctx, id)
 		require.NoError(t, err)
 		require.Equal(t, id, scraping.ID)
 		require.False(t, scraping.ScrapedAt.IsZero())
+		require.Equal(t, domain.ScrapingStatusRunning, scraping.Status)
 	})
 
 	t.Run("CreateScrapingSession_MultipleSessions", func(t *testing.T) {
@@ -157,8 +160,7 @@ func TestScrapingRepository(t *testing.T) {
 		latest, err := storage.GetLatestScraping(ctx)
 
 		// Assert
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "GetLatestScraping")
+		require.ErrorIs(t, err, domain.ErrScrapingNotFound)
 		require.Empty(t, latest)
 	})
 
@@ -177,6 +179,42 @@ func TestScrapingRepository(t *testing.T) {
 		require.False(t, latest.ScrapedAt.IsZero())
 	})
 
+	t.Run("GetLatestScraping_SkipsIncompleteSessions", func(t *testing.T) {
+		cleanScrapingTable(ctx, t, storage)
+
+		now := time.Now()
+		completeID := createScrapingSession(ctx, t, storage, now.Add(-2*time.Hour))
+		partialID := createScrapingSession(ctx, t, storage, now.Add(-1*time.Hour))
+		require.NoError(t, storage.SetScrapingStatus(ctx, partialID, domain.ScrapingStatusPartial))
+		_, err := storage.CreateScrapingSession(ctx)
+		require.NoError(t, err)
+
+		// Тест
+		latest, err := storage.GetLatestScraping(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, completeID, latest.ID)
+		require.Equal(t, domain.ScrapingStatusComplete, latest.Status)
+	})
+
+	t.Run("SetScrapingStatus_Success", func(t *testing.T) {
+		cleanScrapingTable(ctx, t, storage)
+
+		id, err := storage.CreateScrapingSession(ctx)
+		require.NoError(t, err)
+
+		// Тест
+		err = storage.SetScrapingStatus(ctx, id, domain.ScrapingStatusComplete)
+
+		// Assert
+		require.NoError(t, err)
+
+		latest, err := storage.GetLatestScraping(ctx)
+		require.NoError(t, err)
+		require.Equal(t, id, latest.ID)
+	})
+
 	t.Run("GetAllScrapingDates_Success", func(t *testing.T) {
 		cleanScrapingTable(ctx, t, storage)
 
@@ -307,4 +345,114 @@ func TestScrapingRepository(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, exists)
 	})
+
+	t.Run("GetScrapingByID_Success", func(t *testing.T) {
+		cleanScrapingTable(ctx, t, storage)
+
+		scrapedAt := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+		id := createScrapingSession(ctx, t, storage, scrapedAt)
+
+		// Тест
+		session, err := storage.GetScrapingByID(ctx, id)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, id, session.ID)
+		require.True(t, scrapedAt.Equal(session.ScrapedAt))
+	})
+
+	t.Run("GetScrapingByID_NotFound", func(t *testing.T) {
+		cleanScrapingTable(ctx, t, storage)
+
+		// Тест
+		_, err := storage.GetScrapingByID(ctx, uuid.New())
+
+		// Assert
+		require.ErrorIs(t, err, domain.ErrScrapingNotFound)
+	})
+
+	t.Run("ClearProfessionSession_DeletesOnlyProfessionArea", func(t *testing.T) {
+		cleanScrapingTable(ctx, t, storage)
+		_, err := storage.Pool.Exec(ctx, `TRUNCATE profession RESTART IDENTITY CASCADE`)
+		require.NoError(t, err)
+
+		sessionID := createScrapingSession(ctx, t, storage, time.Now())
+		cleared := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		kept := createProfession(ctx, t, storage, "Java Developer", "java", true)
+
+		for _, professionID := range []uuid.UUID{cleared, kept} {
+			require.NoError(t, storage.SaveStat(ctx, sessionID, professionID, testArea, 100))
+			require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, "v1", map[string]int{"go": 10}, nil))
+			require.NoError(t, storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, "v1", map[string]int{"docker": 5}, nil))
+			require.NoError(t, storage.SaveVacancies(ctx, sessionID, professionID, testArea, []domain.VacancyData{
+				{ID: "1", Source: "hh", Description: "go"},
+			}))
+		}
+
+		// Тест
+		err = storage.ClearProfessionSession(ctx, sessionID, cleared, testArea)
+
+		// Assert
+		require.NoError(t, err)
+
+		for _, table := range []string{"stat", "skill_formal", "skill_extracted", "vacancy"} {
+			var clearedCount, keptCount int
+			require.NoError(t, storage.Pool.QueryRow(ctx,
+				`SELECT COUNT(*) FILTER (WHERE profession_id = $1), COUNT(*) FILTER (WHERE profession_id = $2) FROM `+table,
+				cleared, kept).Scan(&clearedCount, &keptCount))
+			require.Zero(t, clearedCount, table)
+			require.Equal(t, 1, keptCount, table)
+		}
+	})
+
+	t.Run("InTx_RollbackKeepsClearedProfession", func(t *testing.T) {
+		cleanScrapingTable(ctx, t, storage)
+		_, err := storage.Pool.Exec(ctx, `TRUNCATE profession RESTART IDENTITY CASCADE`)
+		require.NoError(t, err)
+
+		sessionID := createScrapingSession(ctx, t, storage, time.Now())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		require.NoError(t, storage.SaveStat(ctx, sessionID, professionID, testArea, 100))
+
+		// Тест - очистка и сохранение в одной транзакции, сохранение данных профессии прерывается ошибкой
+		err = storage.InTx(ctx, func(ctx context.Context) error {
+			if err := storage.ClearProfessionSession(ctx, sessionID, professionID, testArea); err != nil {
+				return err
+			}
+			if err := storage.SaveStat(ctx, sessionID, professionID, testArea, 200); err != nil {
+				return err
+			}
+			return errors.New("save vacancies failed")
+		})
+
+		// Assert: в сессии остались прежние данные профессии
+		require.Error(t, err)
+		stat, err := storage.GetStatByProfessionAndSession(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Equal(t, int32(100), stat.VacancyCount)
+	})
+
+	t.Run("InTx_CommitReplacesProfession", func(t *testing.T) {
+		cleanScrapingTable(ctx, t, storage)
+		_, err := storage.Pool.Exec(ctx, `TRUNCATE profession RESTART IDENTITY CASCADE`)
+		require.NoError(t, err)
+
+		sessionID := createScrapingSession(ctx, t, storage, time.Now())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		require.NoError(t, storage.SaveStat(ctx, sessionID, professionID, testArea, 100))
+
+		// Тест
+		err = storage.InTx(ctx, func(ctx context.Context) error {
+			if err := storage.ClearProfessionSession(ctx, sessionID, professionID, testArea); err != nil {
+				return err
+			}
+			return storage.SaveStat(ctx, sessionID, professionID, testArea, 200)
+		})
+
+		// Assert
+		require.NoError(t, err)
+		stat, err := storage.GetStatByProfessionAndSession(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Equal(t, int32(200), stat.VacancyCount)
+	})
 }
@@ -0,0 +1,169 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+func (s *Storage) EnqueueScrapingJobs(ctx context.Context, jobs []domain.ScrapingJob) error {
+	const op = "repository.postgresql.scraping_job.EnqueueScrapingJobs"
+
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	params := make([]postgresql.InsertScrapingJobsParams, len(jobs))
+	for i, job := range jobs {
+		params[i] = postgresql.InsertScrapingJobsParams{
+			ID:           job.ID,
+			RunID:        job.RunID,
+			SessionID:    job.SessionID,
+			ProfessionID: job.ProfessionID,
+			Area:         job.Area,
+			SaveToDb:     job.SaveToDB,
+			Replace:      job.Replace,
+			MaxAttempts:  int32(job.MaxAttempts),
+		}
+	}
+
+	if _, err := s.Queries.InsertScrapingJobs(ctx, params); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ClaimScrapingJob takes the earliest due job and marks it running, skipping jobs locked by other workers.
+// A job running since before staleBefore is considered abandoned by a crashed worker and is claimed again
+// if it has attempts left.
+// When no job is due, domain.ErrNoScrapingJob is returned.
+func (s *Storage) ClaimScrapingJob(ctx context.Context, staleBefore time.Time) (domain.ScrapingJob, error) {
+	const op = "repository.postgresql.scraping_job.ClaimScrapingJob"
+
+	row, err := s.Queries.ClaimScrapingJob(ctx, staleBefore)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ScrapingJob{}, domain.ErrNoScrapingJob
+		}
+		return domain.ScrapingJob{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toScrapingJob(row), nil
+}
+
+func (s *Storage) CompleteScrapingJob(ctx context.Context, id uuid.UUID) error {
+	const op = "repository.postgresql.scraping_job.CompleteScrapingJob"
+
+	if err := s.Queries.CompleteScrapingJob(ctx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RetryScrapingJob returns the running job to the queue, due at runAfter.
+func (s *Storage) RetryScrapingJob(ctx context.Context, id uuid.UUID, runAfter time.Time, errText string) error {
+	const op = "repository.postgresql.scraping_job.RetryScrapingJob"
+
+	err := s.Queries.RetryScrapingJob(ctx, postgresql.RetryScrapingJobParams{
+		ID:        id,
+		RunAfter:  runAfter,
+		LastError: errText,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReleaseScrapingJob returns the running job of a stopping worker to the queue, due at once. The attempt counted
+// when the job was claimed is given back, as the job was interrupted rather than failed.
+func (s *Storage) ReleaseScrapingJob(ctx context.Context, id uuid.UUID, errText string) error {
+	const op = "repository.postgresql.scraping_job.ReleaseScrapingJob"
+
+	err := s.Queries.ReleaseScrapingJob(ctx, postgresql.ReleaseScrapingJobParams{
+		ID:        id,
+		LastError: errText,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) FailScrapingJob(ctx context.Context, id uuid.UUID, errText string) error {
+	const op = "repository.postgresql.scraping_job.FailScrapingJob"
+
+	err := s.Queries.FailScrapingJob(ctx, postgresql.FailScrapingJobParams{
+		ID:        id,
+		LastError: errText,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// FailStaleScrapingJobs fails the jobs abandoned by crashed workers since before staleBefore that have no attempts
+// left, and returns them.
+func (s *Storage) FailStaleScrapingJobs(ctx context.Context, staleBefore time.Time) ([]domain.ScrapingJob, error) {
+	const op = "repository.postgresql.scraping_job.FailStaleScrapingJobs"
+
+	rows, err := s.Queries.FailStaleScrapingJobs(ctx, staleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	jobs := make([]domain.ScrapingJob, len(rows))
+	for i, row := range rows {
+		jobs[i] = toScrapingJob(row)
+	}
+
+	return jobs, nil
+}
+
+// CancelScrapingJobs cancels the pending and running jobs of the run and returns them.
+// A worker still processing a cancelled job finishes it, but its outcome no longer changes the job.
+func (s *Storage) CancelScrapingJobs(ctx context.Context, runID uuid.UUID) ([]domain.ScrapingJob, error) {
+	const op = "repository.postgresql.scraping_job.CancelScrapingJobs"
+
+	rows, err := s.queries(ctx).CancelScrapingJobs(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	jobs := make([]domain.ScrapingJob, len(rows))
+	for i, row := range rows {
+		jobs[i] = toScrapingJob(row)
+	}
+
+	return jobs, nil
+}
+
+func toScrapingJob(row postgresql.ScrapingJob) domain.ScrapingJob {
+	return domain.ScrapingJob{
+		ID:           row.ID,
+		RunID:        row.RunID,
+		SessionID:    row.SessionID,
+		ProfessionID: row.ProfessionID,
+		Area:         row.Area,
+		SaveToDB:     row.SaveToDb,
+		Replace:      row.Replace,
+		Status:       row.Status,
+		Attempts:     int(row.Attempts),
+		MaxAttempts:  int(row.MaxAttempts),
+		RunAfter:     row.RunAfter,
+		LastError:    row.LastError,
+	}
+}
@@ -0,0 +1,348 @@
+//go:build integration
+
+// Интеграционные тесты для scraping_job репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func enqueueScrapingJob(ctx context.Context, t *testing.T, storage *postgresql.Storage, runID, professionID uuid.UUID) uuid.UUID {
+	t.Helper()
+	id := uuid.New()
+	err := storage.EnqueueScrapingJobs(ctx, []domain.ScrapingJob{{
+		ID:           id,
+		RunID:        runID,
+		SessionID:    uuid.New(),
+		ProfessionID: professionID,
+		Area:         domain.DefaultArea,
+		SaveToDB:     true,
+		Replace:      true,
+		MaxAttempts:  3,
+	}})
+	require.NoError(t, err)
+	return id
+}
+
+func TestScrapingJobRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	t.Run("ClaimScrapingJob_Success", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		jobID := enqueueScrapingJob(ctx, t, storage, runID, professionID)
+
+		// Тест
+		job, err := storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, jobID, job.ID)
+		require.Equal(t, runID, job.RunID)
+		require.Equal(t, professionID, job.ProfessionID)
+		require.Equal(t, domain.DefaultArea, job.Area)
+		require.True(t, job.SaveToDB)
+		require.True(t, job.Replace)
+		require.Equal(t, domain.ScrapingJobStatusRunning, job.Status)
+		require.Equal(t, 1, job.Attempts)
+		require.Equal(t, 3, job.MaxAttempts)
+
+		_, err = storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.ErrorIs(t, err, domain.ErrNoScrapingJob)
+	})
+
+	t.Run("ClaimScrapingJob_Empty", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		// Тест
+		_, err := storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+
+		// Assert
+		require.ErrorIs(t, err, domain.ErrNoScrapingJob)
+	})
+
+	t.Run("ClaimScrapingJob_StaleLease", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		jobID := enqueueScrapingJob(ctx, t, storage, runID, professionID)
+
+		_, err := storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		// Тест: воркер, забравший задание, считается упавшим
+		job, err := storage.ClaimScrapingJob(ctx, time.Now().Add(time.Minute))
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, jobID, job.ID)
+		require.Equal(t, 2, job.Attempts)
+	})
+
+	t.Run("ClaimScrapingJob_StaleLeaseWithoutAttempts", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		jobID := enqueueScrapingJob(ctx, t, storage, runID, professionID)
+
+		// три попытки, каждый воркер считается упавшим
+		for range 3 {
+			_, err := storage.ClaimScrapingJob(ctx, time.Now().Add(time.Minute))
+			require.NoError(t, err)
+		}
+
+		// Тест: попытки исчерпаны, задание больше не выдаётся
+		_, err := storage.ClaimScrapingJob(ctx, time.Now().Add(time.Minute))
+		require.ErrorIs(t, err, domain.ErrNoScrapingJob)
+
+		failed, err := storage.FailStaleScrapingJobs(ctx, time.Now().Add(time.Minute))
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, failed, 1)
+		require.Equal(t, jobID, failed[0].ID)
+		require.Equal(t, domain.ScrapingJobStatusFailed, failed[0].Status)
+		require.Equal(t, 3, failed[0].Attempts)
+	})
+
+	t.Run("RetryScrapingJob_DelaysJob", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		jobID := enqueueScrapingJob(ctx, t, storage, runID, professionID)
+
+		_, err := storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		// Тест
+		err = storage.RetryScrapingJob(ctx, jobID, time.Now().Add(time.Hour), "hh: 503")
+
+		// Assert
+		require.NoError(t, err)
+
+		_, err = storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.ErrorIs(t, err, domain.ErrNoScrapingJob)
+
+		var status, lastError string
+		err = storage.Pool.QueryRow(ctx, `SELECT status, last_error FROM scraping_job WHERE id = $1`, jobID).Scan(&status, &lastError)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingJobStatusPending, status)
+		require.Equal(t, "hh: 503", lastError)
+	})
+
+	t.Run("ReleaseScrapingJob_KeepsAttempts", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		jobID := enqueueScrapingJob(ctx, t, storage, runID, professionID)
+
+		claimed, err := storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, 1, claimed.Attempts)
+
+		// Тест: воркер останавливается и возвращает задание в очередь
+		err = storage.ReleaseScrapingJob(ctx, jobID, "context canceled")
+
+		// Assert - задание сразу доступно, попытка не израсходована
+		require.NoError(t, err)
+
+		job, err := storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, jobID, job.ID)
+		require.Equal(t, 1, job.Attempts)
+		require.Equal(t, "context canceled", job.LastError)
+	})
+
+	t.Run("FinishScrapingRun_WaitsForJobs", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		firstID := enqueueScrapingJob(ctx, t, storage, runID, professionID)
+		secondID := enqueueScrapingJob(ctx, t, storage, runID, professionID)
+
+		_, err := storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		_, err = storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		// Тест: первое задание завершено, второе ещё выполняется
+		require.NoError(t, storage.CompleteScrapingJob(ctx, firstID))
+		require.NoError(t, storage.FinishScrapingRun(ctx, runID, domain.ScrapingRunStatusCompleted, ""))
+
+		// Assert
+		result, err := storage.GetScrapingRunByID(ctx, runID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingRunStatusRunning, result.Status)
+
+		require.NoError(t, storage.FailScrapingJob(ctx, secondID, "hh: 503"))
+		require.NoError(t, storage.FinishScrapingRun(ctx, runID, domain.ScrapingRunStatusCompleted, ""))
+
+		result, err = storage.GetScrapingRunByID(ctx, runID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingRunStatusCompleted, result.Status)
+		require.NotNil(t, result.FinishedAt)
+	})
+
+	t.Run("CancelScrapingJobs_Success", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		otherRunID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		runningID := enqueueScrapingJob(ctx, t, storage, runID, professionID)
+		enqueueScrapingJob(ctx, t, storage, runID, professionID)
+		otherID := enqueueScrapingJob(ctx, t, storage, otherRunID, professionID)
+
+		_, err := storage.Pool.Exec(ctx, `UPDATE scraping_job SET run_after = NOW() - INTERVAL '1 minute' WHERE id = $1`, runningID)
+		require.NoError(t, err)
+		_, err = storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		// Тест
+		cancelled, err := storage.CancelScrapingJobs(ctx, runID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, cancelled, 2)
+		for _, job := range cancelled {
+			require.Equal(t, runID, job.RunID)
+			require.Equal(t, domain.ScrapingJobStatusCancelled, job.Status)
+		}
+
+		// завершение отменённого задания не меняет его статус
+		require.NoError(t, storage.CompleteScrapingJob(ctx, runningID))
+
+		var status string
+		err = storage.Pool.QueryRow(ctx, `SELECT status FROM scraping_job WHERE id = $1`, runningID).Scan(&status)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingJobStatusCancelled, status)
+
+		job, err := storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, otherID, job.ID)
+	})
+
+	t.Run("CancelScrapingJobs_PendingFailsSession", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+		cleanScrapingTable(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		sessionID, err := storage.CreateScrapingSession(ctx)
+		require.NoError(t, err)
+
+		jobs := make([]domain.ScrapingJob, 2)
+		for i := range jobs {
+			jobs[i] = domain.ScrapingJob{
+				ID:           uuid.New(),
+				RunID:        runID,
+				SessionID:    sessionID,
+				ProfessionID: professionID,
+				Area:         domain.DefaultArea,
+				SaveToDB:     true,
+				MaxAttempts:  3,
+			}
+		}
+		require.NoError(t, storage.EnqueueScrapingJobs(ctx, jobs))
+
+		// Тест: все задания ещё в очереди, воркеры их не брали
+		err = storage.InTx(ctx, func(ctx context.Context) error {
+			if _, err := storage.CancelScrapingJobs(ctx, runID); err != nil {
+				return err
+			}
+			if err := storage.FinishQueuedScraping(ctx, sessionID, runID); err != nil {
+				return err
+			}
+			return storage.FinishScrapingRun(ctx, runID, domain.ScrapingRunStatusCancelled, "cancelled")
+		})
+
+		// Assert
+		require.NoError(t, err)
+
+		session, err := storage.GetScrapingByID(ctx, sessionID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingStatusFailed, session.Status)
+
+		run, err := storage.GetScrapingRunByID(ctx, runID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingRunStatusCancelled, run.Status)
+	})
+
+	t.Run("FinishScrapingRun_KeepsFinishedRun", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		require.NoError(t, storage.FinishScrapingRun(ctx, runID, domain.ScrapingRunStatusCancelled, "cancelled"))
+
+		// Тест
+		err := storage.FinishScrapingRun(ctx, runID, domain.ScrapingRunStatusCompleted, "")
+
+		// Assert
+		require.NoError(t, err)
+
+		result, err := storage.GetScrapingRunByID(ctx, runID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingRunStatusCancelled, result.Status)
+	})
+
+	t.Run("FinishQueuedScraping_Partial", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+		cleanScrapingTable(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		professionID := createProfession(ctx, t, storage, "Go Developer", "golang", true)
+		sessionID, err := storage.CreateScrapingSession(ctx)
+		require.NoError(t, err)
+
+		jobs := make([]domain.ScrapingJob, 2)
+		for i := range jobs {
+			jobs[i] = domain.ScrapingJob{
+				ID:           uuid.New(),
+				RunID:        runID,
+				SessionID:    sessionID,
+				ProfessionID: professionID,
+				Area:         domain.DefaultArea,
+				SaveToDB:     true,
+				MaxAttempts:  3,
+			}
+		}
+		require.NoError(t, storage.EnqueueScrapingJobs(ctx, jobs))
+
+		_, err = storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		_, err = storage.ClaimScrapingJob(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		// Тест: пока одно задание выполняется, статус сессии не меняется
+		require.NoError(t, storage.CompleteScrapingJob(ctx, jobs[0].ID))
+		require.NoError(t, storage.FinishQueuedScraping(ctx, sessionID, runID))
+
+		session, err := storage.GetScrapingByID(ctx, sessionID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingStatusRunning, session.Status)
+
+		require.NoError(t, storage.FailScrapingJob(ctx, jobs[1].ID, "hh: 503"))
+		require.NoError(t, storage.FinishQueuedScraping(ctx, sessionID, runID))
+
+		// Assert
+		session, err = storage.GetScrapingByID(ctx, sessionID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingStatusPartial, session.Status)
+	})
+}
@@ -0,0 +1,117 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+func (s *Storage) SaveProfessionRun(ctx context.Context, runID uuid.UUID, run domain.ProfessionRun) error {
+	const op = "repository.postgresql.scraping_profession_run.SaveProfessionRun"
+
+	err := s.Queries.InsertProfessionRun(ctx, postgresql.InsertProfessionRunParams{
+		RunID:            pgtype.UUID{Bytes: runID, Valid: true},
+		SessionID:        run.SessionID,
+		ProfessionID:     run.ProfessionID,
+		Area:             run.Area,
+		Status:           run.Status,
+		Error:            run.Error,
+		TotalFound:       int32(run.Stats.TotalFound),
+		IdsCollected:     int32(run.Stats.IDsCollected),
+		VacanciesFetched: int32(run.Stats.VacanciesFetched),
+		PagesFailed:      int32(run.Stats.PagesFailed),
+		VacanciesFailed:  int32(run.Stats.VacanciesFailed),
+		StartedAt:        run.StartedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetProfessionRunByProfessionAndSession returns the fetch stats of the latest successful run of the profession into
+// the session: the data of the session was saved by it, whatever runs failed since.
+func (s *Storage) GetProfessionRunByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.FetchStats, error) {
+	const op = "repository.postgresql.scraping_profession_run.GetProfessionRunByProfessionAndSession"
+
+	row, err := s.Queries.GetProfessionRunByProfessionAndSession(ctx, postgresql.GetProfessionRunByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		SessionID:    sessionID,
+		Area:         area,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.FetchStats{}, domain.ErrProfessionRunNotFound
+		}
+		return domain.FetchStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return domain.FetchStats{
+		TotalFound:       int(row.TotalFound),
+		IDsCollected:     int(row.IdsCollected),
+		VacanciesFetched: int(row.VacanciesFetched),
+		PagesFailed:      int(row.PagesFailed),
+		VacanciesFailed:  int(row.VacanciesFailed),
+	}, nil
+}
+
+// GetProfessionRunsByRun returns the outcome of every profession processed within the run.
+func (s *Storage) GetProfessionRunsByRun(ctx context.Context, runID uuid.UUID) ([]domain.ProfessionRun, error) {
+	const op = "repository.postgresql.scraping_profession_run.GetProfessionRunsByRun"
+
+	rows, err := s.Queries.GetProfessionRunsByRun(ctx, pgtype.UUID{Bytes: runID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]domain.ProfessionRun, len(rows))
+	for i, row := range rows {
+		result[i] = domain.ProfessionRun{
+			ProfessionID:   row.ProfessionID,
+			ProfessionName: row.ProfessionName,
+			Area:           row.Area,
+			SessionID:      row.SessionID,
+			Status:         row.Status,
+			Error:          row.Error,
+			Stats: domain.FetchStats{
+				TotalFound:       int(row.TotalFound),
+				IDsCollected:     int(row.IdsCollected),
+				VacanciesFetched: int(row.VacanciesFetched),
+				PagesFailed:      int(row.PagesFailed),
+				VacanciesFailed:  int(row.VacanciesFailed),
+			},
+			StartedAt:  row.StartedAt,
+			FinishedAt: row.CreatedAt,
+		}
+	}
+
+	return result, nil
+}
+
+// GetSucceededProfessionAreas returns the profession and area pairs scraped into the session successfully.
+func (s *Storage) GetSucceededProfessionAreas(ctx context.Context, sessionID uuid.UUID) ([]domain.ProfessionArea, error) {
+	const op = "repository.postgresql.scraping_profession_run.GetSucceededProfessionAreas"
+
+	rows, err := s.Queries.GetSucceededProfessionAreasBySession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]domain.ProfessionArea, len(rows))
+	for i, row := range rows {
+		result[i] = domain.ProfessionArea{
+			ProfessionID: row.ProfessionID,
+			Area:         row.Area,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,197 @@
+//go:build integration
+
+// Интеграционные тесты для scraping_profession_run репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func cleanProfessionRunTables(ctx context.Context, t *testing.T, storage *postgresql.Storage) {
+	t.Helper()
+	_, err := storage.Pool.Exec(ctx, `TRUNCATE scraping_profession_run, scraping_run, profession RESTART IDENTITY CASCADE`)
+	require.NoError(t, err)
+}
+
+func TestProfessionRunRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	t.Run("SaveAndGetProfessionRun_Success", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		// дневной запуск использует временную сессию без записи в scraping
+		sessionID := uuid.New()
+		stats := domain.FetchStats{
+			TotalFound:       8000,
+			IDsCollected:     7900,
+			VacanciesFetched: 7850,
+			PagesFailed:      1,
+			VacanciesFailed:  50,
+		}
+
+		// Тест
+		err := storage.SaveProfessionRun(ctx, runID, domain.ProfessionRun{
+			ProfessionID: professionID,
+			Area:         testArea,
+			SessionID:    sessionID,
+			Status:       domain.ProfessionRunStatusSuccess,
+			Stats:        stats,
+			StartedAt:    time.Now().UTC(),
+		})
+
+		// Assert
+		require.NoError(t, err)
+
+		result, err := storage.GetProfessionRunByProfessionAndSession(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Equal(t, stats, result)
+	})
+
+	t.Run("GetProfessionRunByProfessionAndSession_NotFound", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		sessionID := uuid.New()
+		require.NoError(t, storage.SaveProfessionRun(ctx, runID, domain.ProfessionRun{
+			ProfessionID: professionID,
+			Area:         "1",
+			SessionID:    sessionID,
+			Status:       domain.ProfessionRunStatusSuccess,
+			Stats:        domain.FetchStats{TotalFound: 1},
+			StartedAt:    time.Now().UTC(),
+		}))
+
+		// Тест
+		_, err := storage.GetProfessionRunByProfessionAndSession(ctx, professionID, sessionID, testArea)
+
+		// Assert
+		require.ErrorIs(t, err, domain.ErrProfessionRunNotFound)
+	})
+
+	t.Run("GetProfessionRunByProfessionAndSession_SkipsLaterFailedRun", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		retryRunID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		sessionID := uuid.New()
+		stats := domain.FetchStats{TotalFound: 100, IDsCollected: 100, VacanciesFetched: 98, VacanciesFailed: 2}
+
+		require.NoError(t, storage.SaveProfessionRun(ctx, runID, domain.ProfessionRun{
+			ProfessionID: professionID,
+			Area:         testArea,
+			SessionID:    sessionID,
+			Status:       domain.ProfessionRunStatusSuccess,
+			Stats:        stats,
+			StartedAt:    time.Now().UTC(),
+		}))
+		require.NoError(t, storage.SaveProfessionRun(ctx, retryRunID, domain.ProfessionRun{
+			ProfessionID: professionID,
+			Area:         testArea,
+			SessionID:    sessionID,
+			Status:       domain.ProfessionRunStatusFailed,
+			Error:        "hh: 503",
+			Stats:        domain.FetchStats{TotalFound: 100},
+			StartedAt:    time.Now().UTC(),
+		}))
+
+		// Тест
+		result, err := storage.GetProfessionRunByProfessionAndSession(ctx, professionID, sessionID, testArea)
+
+		// Assert - покрытие берётся из запуска, сохранившего данные сессии
+		require.NoError(t, err)
+		require.Equal(t, stats, result)
+	})
+
+	t.Run("GetProfessionRunsByRun_Success", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		goID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		javaID := createProfession(ctx, t, storage, "Java Developer", "java developer", true)
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		otherRunID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		sessionID := uuid.New()
+		startedAt := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+
+		require.NoError(t, storage.SaveProfessionRun(ctx, runID, domain.ProfessionRun{
+			ProfessionID: goID,
+			Area:         testArea,
+			SessionID:    sessionID,
+			Status:       domain.ProfessionRunStatusSuccess,
+			Stats:        domain.FetchStats{TotalFound: 100, VacanciesFetched: 100},
+			StartedAt:    startedAt,
+		}))
+		require.NoError(t, storage.SaveProfessionRun(ctx, runID, domain.ProfessionRun{
+			ProfessionID: javaID,
+			Area:         testArea,
+			SessionID:    sessionID,
+			Status:       domain.ProfessionRunStatusFailed,
+			Error:        "hh unavailable",
+			StartedAt:    startedAt.Add(time.Minute),
+		}))
+		require.NoError(t, storage.SaveProfessionRun(ctx, otherRunID, domain.ProfessionRun{
+			ProfessionID: goID,
+			Area:         testArea,
+			SessionID:    uuid.New(),
+			Status:       domain.ProfessionRunStatusSuccess,
+			StartedAt:    startedAt,
+		}))
+
+		// Тест
+		result, err := storage.GetProfessionRunsByRun(ctx, runID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+
+		require.Equal(t, goID, result[0].ProfessionID)
+		require.Equal(t, "Go Developer", result[0].ProfessionName)
+		require.Equal(t, sessionID, result[0].SessionID)
+		require.Equal(t, domain.ProfessionRunStatusSuccess, result[0].Status)
+		require.Equal(t, 100, result[0].Stats.VacanciesFetched)
+		require.True(t, startedAt.Equal(result[0].StartedAt))
+		require.False(t, result[0].FinishedAt.IsZero())
+
+		require.Equal(t, "Java Developer", result[1].ProfessionName)
+		require.Equal(t, domain.ProfessionRunStatusFailed, result[1].Status)
+		require.Equal(t, "hh unavailable", result[1].Error)
+	})
+
+	t.Run("GetSucceededProfessionAreas_Success", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		goID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		javaID := createProfession(ctx, t, storage, "Java Developer", "java developer", true)
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+		sessionID := uuid.New()
+
+		for _, run := range []domain.ProfessionRun{
+			{ProfessionID: goID, Area: testArea, Status: domain.ProfessionRunStatusFailed},
+			{ProfessionID: goID, Area: testArea, Status: domain.ProfessionRunStatusSuccess},
+			{ProfessionID: javaID, Area: testArea, Status: domain.ProfessionRunStatusFailed},
+		} {
+			run.SessionID = sessionID
+			run.StartedAt = time.Now().UTC()
+			require.NoError(t, storage.SaveProfessionRun(ctx, runID, run))
+		}
+
+		// Тест
+		result, err := storage.GetSucceededProfessionAreas(ctx, sessionID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, []domain.ProfessionArea{{ProfessionID: goID, Area: testArea}}, result)
+	})
+}
@@ -0,0 +1,107 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+func (s *Storage) CreateScrapingRun(ctx context.Context, run domain.ScrapingRun) error {
+	const op = "repository.postgresql.scraping_run.CreateScrapingRun"
+
+	var triggeredBy pgtype.UUID
+	if run.TriggeredBy != nil {
+		triggeredBy = pgtype.UUID{Bytes: *run.TriggeredBy, Valid: true}
+	}
+
+	err := s.Queries.InsertScrapingRun(ctx, postgresql.InsertScrapingRunParams{
+		ID:          run.ID,
+		Mode:        run.Mode,
+		Trigger:     run.Trigger,
+		TriggeredBy: triggeredBy,
+		Status:      run.Status,
+		StartedAt:   run.StartedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// FinishScrapingRun records the outcome of a running run; a finished run is left as is. A run is not completed
+// while it has pending or running jobs: the worker that ends its last job completes it.
+func (s *Storage) FinishScrapingRun(ctx context.Context, id uuid.UUID, status, errText string) error {
+	const op = "repository.postgresql.scraping_run.FinishScrapingRun"
+
+	err := s.queries(ctx).FinishScrapingRun(ctx, postgresql.FinishScrapingRunParams{
+		ID:     id,
+		Status: status,
+		Error:  errText,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetScrapingRuns returns the latest runs, newest first, without their profession outcomes.
+func (s *Storage) GetScrapingRuns(ctx context.Context, limit int) ([]domain.ScrapingRun, error) {
+	const op = "repository.postgresql.scraping_run.GetScrapingRuns"
+
+	rows, err := s.Queries.GetScrapingRuns(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]domain.ScrapingRun, len(rows))
+	for i, row := range rows {
+		result[i] = toDomainScrapingRun(row)
+	}
+
+	return result, nil
+}
+
+func (s *Storage) GetScrapingRunByID(ctx context.Context, id uuid.UUID) (domain.ScrapingRun, error) {
+	const op = "repository.postgresql.scraping_run.GetScrapingRunByID"
+
+	row, err := s.Queries.GetScrapingRunByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ScrapingRun{}, domain.ErrScrapingRunNotFound
+		}
+		return domain.ScrapingRun{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toDomainScrapingRun(row), nil
+}
+
+func toDomainScrapingRun(row postgresql.ScrapingRun) domain.ScrapingRun {
+	run := domain.ScrapingRun{
+		ID:        row.ID,
+		Mode:      row.Mode,
+		Trigger:   row.Trigger,
+		Status:    row.Status,
+		Error:     row.Error,
+		StartedAt: row.StartedAt,
+	}
+
+	if row.TriggeredBy.Valid {
+		triggeredBy := uuid.UUID(row.TriggeredBy.Bytes)
+		run.TriggeredBy = &triggeredBy
+	}
+	if row.FinishedAt.Valid {
+		finishedAt := row.FinishedAt.Time
+		run.FinishedAt = &finishedAt
+	}
+
+	return run
+}
@@ -0,0 +1,102 @@
+//go:build integration
+
+// Интеграционные тесты для scraping_run репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func createScrapingRun(ctx context.Context, t *testing.T, storage *postgresql.Storage, startedAt time.Time) uuid.UUID {
+	t.Helper()
+	id := uuid.New()
+	err := storage.CreateScrapingRun(ctx, domain.ScrapingRun{
+		ID:        id,
+		Mode:      domain.ScrapingModeArchive,
+		Trigger:   domain.ScrapingTriggerCron,
+		Status:    domain.ScrapingRunStatusRunning,
+		StartedAt: startedAt,
+	})
+	require.NoError(t, err)
+	return id
+}
+
+func TestScrapingRunRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	t.Run("CreateAndGetScrapingRun_Success", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		startedAt := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+		runID := createScrapingRun(ctx, t, storage, startedAt)
+
+		// Тест
+		result, err := storage.GetScrapingRunByID(ctx, runID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, runID, result.ID)
+		require.Equal(t, domain.ScrapingModeArchive, result.Mode)
+		require.Equal(t, domain.ScrapingTriggerCron, result.Trigger)
+		require.Nil(t, result.TriggeredBy)
+		require.Equal(t, domain.ScrapingRunStatusRunning, result.Status)
+		require.True(t, startedAt.Equal(result.StartedAt))
+		require.Nil(t, result.FinishedAt)
+	})
+
+	t.Run("FinishScrapingRun_Success", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		runID := createScrapingRun(ctx, t, storage, time.Now().UTC())
+
+		// Тест
+		err := storage.FinishScrapingRun(ctx, runID, domain.ScrapingRunStatusFailed, "get active professions: timeout")
+
+		// Assert
+		require.NoError(t, err)
+
+		result, err := storage.GetScrapingRunByID(ctx, runID)
+		require.NoError(t, err)
+		require.Equal(t, domain.ScrapingRunStatusFailed, result.Status)
+		require.Equal(t, "get active professions: timeout", result.Error)
+		require.NotNil(t, result.FinishedAt)
+	})
+
+	t.Run("GetScrapingRuns_NewestFirst", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		now := time.Now().UTC()
+		oldID := createScrapingRun(ctx, t, storage, now.Add(-48*time.Hour))
+		midID := createScrapingRun(ctx, t, storage, now.Add(-24*time.Hour))
+		newID := createScrapingRun(ctx, t, storage, now)
+
+		// Тест
+		result, err := storage.GetScrapingRuns(ctx, 2)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Equal(t, newID, result[0].ID)
+		require.Equal(t, midID, result[1].ID)
+		require.NotEqual(t, oldID, result[1].ID)
+	})
+
+	t.Run("GetScrapingRunByID_NotFound", func(t *testing.T) {
+		cleanProfessionRunTables(ctx, t, storage)
+
+		// Тест
+		_, err := storage.GetScrapingRunByID(ctx, uuid.New())
+
+		// Assert
+		require.ErrorIs(t, err, domain.ErrScrapingRunNotFound)
+	})
+}
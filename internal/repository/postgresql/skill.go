@@ -3,51 +3,194 @@ package postgresql
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"psa/internal/domain"
 	postgresql "psa/internal/repository/postgresql/generated"
 )
 
-func (s *Storage) SaveFormalSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int) error {
+// SaveFormalSkills saves formal skill counts. A skill missing from percentages is saved without a percentage.
+func (s *Storage) SaveFormalSkills(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	professionID uuid.UUID,
+	area string,
+	extractorVersion string,
+	skills map[string]int,
+	percentages map[string]float64,
+) error {
+	const op = "repository.postgresql.skill.SaveFormalSkills"
+
+	if _, err := s.queries(ctx).InsertFormalSkills(ctx, formalSkillsParams(sessionID, professionID, area, extractorVersion, skills, percentages)); err != nil {
+		return fmt.Errorf("%s: insert: %w", op, err)
+	}
+
+	if err := s.queries(ctx).LinkFormalSkills(ctx, postgresql.LinkFormalSkillsParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: link catalogue: %w", op, err)
+	}
+
+	return nil
+}
+
+// SaveExtractedSkills saves extracted skill counts. A skill missing from percentages is saved without a percentage.
+func (s *Storage) SaveExtractedSkills(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	professionID uuid.UUID,
+	area string,
+	extractorVersion string,
+	skills map[string]int,
+	percentages map[string]float64,
+) error {
+	const op = "repository.postgresql.skill.SaveExtractedSkills"
+
+	if _, err := s.queries(ctx).InsertExtractedSkills(ctx, extractedSkillsParams(sessionID, professionID, area, extractorVersion, skills, percentages)); err != nil {
+		return fmt.Errorf("%s: insert: %w", op, err)
+	}
+
+	if err := s.queries(ctx).LinkExtractedSkills(ctx, postgresql.LinkExtractedSkillsParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: link catalogue: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReplaceSkills atomically replaces formal and extracted skills of the profession in the session and area.
+func (s *Storage) ReplaceSkills(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	professionID uuid.UUID,
+	area string,
+	extractorVersion string,
+	formalSkills map[string]int,
+	formalPercentages map[string]float64,
+	extractedSkills map[string]int,
+	extractedPercentages map[string]float64,
+) error {
+	const op = "repository.postgresql.skill.ReplaceSkills"
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: begin: %w", op, err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := s.Queries.WithTx(tx)
+
+	if err := q.DeleteFormalSkillsByProfessionAndSession(ctx, postgresql.DeleteFormalSkillsByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete formal skills: %w", op, err)
+	}
+
+	if err := q.DeleteExtractedSkillsByProfessionAndSession(ctx, postgresql.DeleteExtractedSkillsByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: delete extracted skills: %w", op, err)
+	}
+
+	if _, err := q.InsertFormalSkills(ctx, formalSkillsParams(sessionID, professionID, area, extractorVersion, formalSkills, formalPercentages)); err != nil {
+		return fmt.Errorf("%s: insert formal skills: %w", op, err)
+	}
+
+	if _, err := q.InsertExtractedSkills(ctx, extractedSkillsParams(sessionID, professionID, area, extractorVersion, extractedSkills, extractedPercentages)); err != nil {
+		return fmt.Errorf("%s: insert extracted skills: %w", op, err)
+	}
+
+	if err := q.LinkFormalSkills(ctx, postgresql.LinkFormalSkillsParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: link formal skills: %w", op, err)
+	}
+
+	if err := q.LinkExtractedSkills(ctx, postgresql.LinkExtractedSkillsParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	}); err != nil {
+		return fmt.Errorf("%s: link extracted skills: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return nil
+}
+
+func formalSkillsParams(
+	sessionID, professionID uuid.UUID,
+	area, extractorVersion string,
+	skills map[string]int,
+	percentages map[string]float64,
+) []postgresql.InsertFormalSkillsParams {
 	params := make([]postgresql.InsertFormalSkillsParams, 0, len(skills))
 	for skill, count := range skills {
 		params = append(params, postgresql.InsertFormalSkillsParams{
-			ProfessionID: professionID,
-			Skill:        skill,
-			Count:        int32(count),
-			ScrapedAtID:  sessionID,
+			ProfessionID:     professionID,
+			Skill:            skill,
+			Count:            int32(count),
+			ScrapedAtID:      sessionID,
+			ExtractorVersion: extractorVersion,
+			Area:             area,
+			Percentage:       skillPercentage(percentages, skill),
 		})
 	}
 
-	_, err := s.Queries.InsertFormalSkills(ctx, params)
-
-	return err
+	return params
 }
 
-func (s *Storage) SaveExtractedSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int) error {
+func extractedSkillsParams(
+	sessionID, professionID uuid.UUID,
+	area, extractorVersion string,
+	skills map[string]int,
+	percentages map[string]float64,
+) []postgresql.InsertExtractedSkillsParams {
 	params := make([]postgresql.InsertExtractedSkillsParams, 0, len(skills))
 	for skill, count := range skills {
 		params = append(params, postgresql.InsertExtractedSkillsParams{
-			ProfessionID: professionID,
-			Skill:        skill,
-			Count:        int32(count),
-			ScrapedAtID:  sessionID,
+			ProfessionID:     professionID,
+			Skill:            skill,
+			Count:            int32(count),
+			ScrapedAtID:      sessionID,
+			ExtractorVersion: extractorVersion,
+			Area:             area,
+			Percentage:       skillPercentage(percentages, skill),
 		})
 	}
 
-	_, err := s.Queries.InsertExtractedSkills(ctx, params)
+	return params
+}
 
-	return err
+func skillPercentage(percentages map[string]float64, skill string) pgtype.Float8 {
+	percentage, ok := percentages[skill]
+	return pgtype.Float8{Float64: percentage, Valid: ok}
 }
 
-func (s *Storage) GetFormalSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID) ([]domain.Skill, error) {
+func (s *Storage) GetFormalSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string) ([]domain.Skill, error) {
 	const op = "repository.postgresql.skill.GetFormalSkillsByProfessionAndDate"
 
 	rows, err := s.Queries.GetFormalSkillsByProfessionAndDate(ctx, postgresql.GetFormalSkillsByProfessionAndDateParams{
 		ProfessionID: professionID,
 		ScrapedAtID:  scrapedAtID,
+		Area:         area,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -56,20 +199,22 @@ func (s *Storage) GetFormalSkillsByProfessionAndDate(ctx context.Context, profes
 	skills := make([]domain.Skill, len(rows))
 	for i, row := range rows {
 		skills[i] = domain.Skill{
-			Skill: row.Skill,
-			Count: row.Count,
+			Skill:      row.Skill,
+			Count:      row.Count,
+			Percentage: percentagePtr(row.Percentage),
 		}
 	}
 
 	return skills, nil
 }
 
-func (s *Storage) GetExtractedSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID) ([]domain.Skill, error) {
+func (s *Storage) GetExtractedSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string) ([]domain.Skill, error) {
 	const op = "repository.postgresql.skill.GetExtractedSkillsByProfessionAndDate"
 
 	rows, err := s.Queries.GetExtractedSkillsByProfessionAndDate(ctx, postgresql.GetExtractedSkillsByProfessionAndDateParams{
 		ProfessionID: professionID,
 		ScrapedAtID:  scrapedAtID,
+		Area:         area,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -78,8 +223,116 @@ func (s *Storage) GetExtractedSkillsByProfessionAndDate(ctx context.Context, pro
 	skills := make([]domain.Skill, len(rows))
 	for i, row := range rows {
 		skills[i] = domain.Skill{
-			Skill: row.Skill,
-			Count: row.Count,
+			Skill:      row.Skill,
+			Count:      row.Count,
+			Percentage: percentagePtr(row.Percentage),
+		}
+	}
+
+	return skills, nil
+}
+
+func percentagePtr(percentage pgtype.Float8) *float64 {
+	if !percentage.Valid {
+		return nil
+	}
+	return &percentage.Float64
+}
+
+func (s *Storage) GetFormalSkillsWithDatesByProfessionAndDateRange(ctx context.Context, professionID uuid.UUID, area string, from, to time.Time) ([]domain.SkillSnapshot, error) {
+	const op = "repository.postgresql.skill.GetFormalSkillsWithDatesByProfessionAndDateRange"
+
+	rows, err := s.Queries.GetFormalSkillsWithDatesByProfessionAndDateRange(ctx, postgresql.GetFormalSkillsWithDatesByProfessionAndDateRangeParams{
+		ProfessionID: professionID,
+		ScrapedAt:    from,
+		ScrapedAt_2:  to,
+		Area:         area,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	snapshots := make([]domain.SkillSnapshot, len(rows))
+	for i, row := range rows {
+		snapshots[i] = domain.SkillSnapshot{
+			Skill:     row.Skill,
+			Count:     row.Count,
+			ScrapedAt: row.ScrapedAt,
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (s *Storage) GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx context.Context, professionID uuid.UUID, area string, from, to time.Time) ([]domain.SkillSnapshot, error) {
+	const op = "repository.postgresql.skill.GetExtractedSkillsWithDatesByProfessionAndDateRange"
+
+	rows, err := s.Queries.GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx, postgresql.GetExtractedSkillsWithDatesByProfessionAndDateRangeParams{
+		ProfessionID: professionID,
+		ScrapedAt:    from,
+		ScrapedAt_2:  to,
+		Area:         area,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	snapshots := make([]domain.SkillSnapshot, len(rows))
+	for i, row := range rows {
+		snapshots[i] = domain.SkillSnapshot{
+			Skill:     row.Skill,
+			Count:     row.Count,
+			ScrapedAt: row.ScrapedAt,
+		}
+	}
+
+	return snapshots, nil
+}
+
+// GetFormalSkillsByProfessionsAndSession returns the formal skills of the professions collected in the session,
+// grouped by profession.
+func (s *Storage) GetFormalSkillsByProfessionsAndSession(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string) (map[uuid.UUID][]domain.Skill, error) {
+	const op = "repository.postgresql.skill.GetFormalSkillsByProfessionsAndSession"
+
+	rows, err := s.Queries.GetFormalSkillsByProfessionsAndSession(ctx, postgresql.GetFormalSkillsByProfessionsAndSessionParams{
+		Column1:     professionIDs,
+		ScrapedAtID: sessionID,
+		Area:        area,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Группируем по profession_id
+	result := make(map[uuid.UUID][]domain.Skill)
+	for _, row := range rows {
+		result[row.ProfessionID] = append(result[row.ProfessionID], domain.Skill{
+			ProfessionID: row.ProfessionID,
+			Skill:        row.Skill,
+			Count:        row.Count,
+			Percentage:   percentagePtr(row.Percentage),
+			ScrapedAtID:  sessionID,
+		})
+	}
+
+	return result, nil
+}
+
+// GetCatalogSkillsByNames returns catalogue entries of the given skills. Skills missing from the catalogue are skipped.
+func (s *Storage) GetCatalogSkillsByNames(ctx context.Context, names []string) ([]domain.CatalogSkill, error) {
+	const op = "repository.postgresql.skill.GetCatalogSkillsByNames"
+
+	rows, err := s.Queries.GetCatalogSkillsByNames(ctx, names)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	skills := make([]domain.CatalogSkill, len(rows))
+	for i, row := range rows {
+		skills[i] = domain.CatalogSkill{
+			Name:     row.Name,
+			Category: row.Category,
+			Parent:   row.Parent.String,
 		}
 	}
 
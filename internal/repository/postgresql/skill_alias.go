@@ -0,0 +1,105 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+func (s *Storage) GetSkillAliases(ctx context.Context) ([]domain.SkillAlias, error) {
+	const op = "repository.postgresql.skill_alias.GetSkillAliases"
+
+	rows, err := s.queries(ctx).GetSkillAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	aliases := make([]domain.SkillAlias, len(rows))
+	for i, row := range rows {
+		aliases[i] = toDomainSkillAlias(row)
+	}
+
+	return aliases, nil
+}
+
+// LockSkillAliases serializes the changes of the aliases until the end of the transaction of InTx, so that
+// the aliases read to validate a change cannot be changed by another one before it is written.
+func (s *Storage) LockSkillAliases(ctx context.Context) error {
+	const op = "repository.postgresql.skill_alias.LockSkillAliases"
+
+	if err := s.queries(ctx).LockSkillAliases(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) AddSkillAlias(ctx context.Context, alias, skill string) (domain.SkillAlias, error) {
+	const op = "repository.postgresql.skill_alias.AddSkillAlias"
+
+	row, err := s.queries(ctx).InsertSkillAlias(ctx, postgresql.InsertSkillAliasParams{
+		Alias: alias,
+		Skill: skill,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgErrUniqueViolation {
+			return domain.SkillAlias{}, domain.ErrSkillAliasAlreadyExists
+		}
+		return domain.SkillAlias{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toDomainSkillAlias(row), nil
+}
+
+func (s *Storage) UpdateSkillAlias(ctx context.Context, id uuid.UUID, alias, skill string) (domain.SkillAlias, error) {
+	const op = "repository.postgresql.skill_alias.UpdateSkillAlias"
+
+	row, err := s.queries(ctx).UpdateSkillAlias(ctx, postgresql.UpdateSkillAliasParams{
+		ID:    id,
+		Alias: alias,
+		Skill: skill,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.SkillAlias{}, domain.ErrSkillAliasNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgErrUniqueViolation {
+			return domain.SkillAlias{}, domain.ErrSkillAliasAlreadyExists
+		}
+		return domain.SkillAlias{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toDomainSkillAlias(row), nil
+}
+
+func (s *Storage) DeleteSkillAlias(ctx context.Context, id uuid.UUID) error {
+	const op = "repository.postgresql.skill_alias.DeleteSkillAlias"
+
+	deleted, err := s.Queries.DeleteSkillAlias(ctx, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if deleted == 0 {
+		return domain.ErrSkillAliasNotFound
+	}
+
+	return nil
+}
+
+func toDomainSkillAlias(row postgresql.SkillAlias) domain.SkillAlias {
+	return domain.SkillAlias{
+		ID:        row.ID,
+		Alias:     row.Alias,
+		Skill:     row.Skill,
+		CreatedAt: row.CreatedAt,
+	}
+}
@@ -0,0 +1,161 @@
+//go:build integration
+
+// Интеграционные тесты для skill_alias репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func cleanSkillAliasTable(ctx context.Context, t *testing.T, storage *postgresql.Storage) {
+	t.Helper()
+	_, err := storage.Pool.Exec(ctx, `TRUNCATE skill_alias`)
+	require.NoError(t, err)
+}
+
+func TestSkillAliasRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	t.Run("SeededAliases", func(t *testing.T) {
+		// Тест: миграция заполняет словарь распространёнными синонимами
+		aliases, err := storage.GetSkillAliases(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		canonical := make(map[string]string, len(aliases))
+		for _, a := range aliases {
+			canonical[a.Alias] = a.Skill
+		}
+		require.Equal(t, "go", canonical["golang"])
+		require.Equal(t, "postgresql", canonical["postgres"])
+	})
+
+	t.Run("AddAndGetSkillAliases_Success", func(t *testing.T) {
+		cleanSkillAliasTable(ctx, t, storage)
+
+		// Тест
+		created, err := storage.AddSkillAlias(ctx, "k8s", "kubernetes")
+		require.NoError(t, err)
+		_, err = storage.AddSkillAlias(ctx, "pg", "postgresql")
+		require.NoError(t, err)
+
+		// Assert
+		require.NotEqual(t, uuid.Nil, created.ID)
+		require.False(t, created.CreatedAt.IsZero())
+
+		aliases, err := storage.GetSkillAliases(ctx)
+		require.NoError(t, err)
+		require.Len(t, aliases, 2)
+		// порядок по каноническому навыку
+		require.Equal(t, "kubernetes", aliases[0].Skill)
+		require.Equal(t, "postgresql", aliases[1].Skill)
+	})
+
+	t.Run("AddSkillAlias_AlreadyExists", func(t *testing.T) {
+		cleanSkillAliasTable(ctx, t, storage)
+
+		_, err := storage.AddSkillAlias(ctx, "k8s", "kubernetes")
+		require.NoError(t, err)
+
+		// Тест
+		_, err = storage.AddSkillAlias(ctx, "k8s", "k8s engine")
+
+		// Assert
+		require.ErrorIs(t, err, domain.ErrSkillAliasAlreadyExists)
+	})
+
+	t.Run("UpdateSkillAlias_Success", func(t *testing.T) {
+		cleanSkillAliasTable(ctx, t, storage)
+
+		created, err := storage.AddSkillAlias(ctx, "pg", "postgres")
+		require.NoError(t, err)
+
+		// Тест
+		updated, err := storage.UpdateSkillAlias(ctx, created.ID, "pg", "postgresql")
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, created.ID, updated.ID)
+		require.Equal(t, "postgresql", updated.Skill)
+	})
+
+	t.Run("UpdateSkillAlias_NotFound", func(t *testing.T) {
+		cleanSkillAliasTable(ctx, t, storage)
+
+		// Тест
+		_, err := storage.UpdateSkillAlias(ctx, uuid.New(), "pg", "postgresql")
+
+		// Assert
+		require.ErrorIs(t, err, domain.ErrSkillAliasNotFound)
+	})
+
+	t.Run("DeleteSkillAlias_Success", func(t *testing.T) {
+		cleanSkillAliasTable(ctx, t, storage)
+
+		created, err := storage.AddSkillAlias(ctx, "k8s", "kubernetes")
+		require.NoError(t, err)
+
+		// Тест
+		err = storage.DeleteSkillAlias(ctx, created.ID)
+
+		// Assert
+		require.NoError(t, err)
+		require.ErrorIs(t, storage.DeleteSkillAlias(ctx, created.ID), domain.ErrSkillAliasNotFound)
+	})
+
+	t.Run("LockSkillAliases_SerializesChanges", func(t *testing.T) {
+		cleanSkillAliasTable(ctx, t, storage)
+
+		locked := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan error, 1)
+		go func() {
+			done <- storage.InTx(ctx, func(ctx context.Context) error {
+				if err := storage.LockSkillAliases(ctx); err != nil {
+					return err
+				}
+				close(locked)
+				<-release
+				_, err := storage.AddSkillAlias(ctx, "k8s", "kubernetes")
+				return err
+			})
+		}()
+		<-locked
+
+		// Тест: вторая транзакция ждёт блокировку и видит синоним, записанный первой
+		var aliases []domain.SkillAlias
+		second := make(chan error, 1)
+		go func() {
+			second <- storage.InTx(ctx, func(ctx context.Context) error {
+				if err := storage.LockSkillAliases(ctx); err != nil {
+					return err
+				}
+				var err error
+				aliases, err = storage.GetSkillAliases(ctx)
+				return err
+			})
+		}()
+
+		select {
+		case err := <-second:
+			t.Fatalf("second transaction was not blocked: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+		close(release)
+
+		// Assert
+		require.NoError(t, <-done)
+		require.NoError(t, <-second)
+		require.Len(t, aliases, 1)
+		require.Equal(t, "k8s", aliases[0].Alias)
+	})
+}
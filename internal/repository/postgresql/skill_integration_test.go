@@ -14,11 +14,16 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"psa/internal/config"
+	"psa/internal/domain"
 	"psa/internal/repository/postgresql"
 	"psa/tests/containers"
 )
 
-const migrationsPathSkill = "migrations"
+const (
+	migrationsPathSkill  = "migrations"
+	testExtractorVersion = "ngram-v1"
+	testArea             = "113"
+)
 
 func mustParsePortForSkill(t *testing.T, portStr string) int {
 	t.Helper()
@@ -95,8 +100,8 @@ func createScrapingSessionSkill(ctx context.Context, t *testing.T, storage *post
 
 	id := uuid.New()
 	_, err := storage.Pool.Exec(ctx, `
-		INSERT INTO scraping (id, scraped_at)
-		VALUES ($1, $2)
+		INSERT INTO scraping (id, scraped_at, status)
+		VALUES ($1, $2, 'complete')
 	`, id, scrapedAt)
 	require.NoError(t, err)
 
@@ -121,13 +126,13 @@ func TestSkillRepository(t *testing.T) {
 		}
 
 		// Тест
-		err := storage.SaveFormalSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Проверяем что навыки сохранились
-		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 		require.NoError(t, err)
 		require.Len(t, result, 3)
 
@@ -149,13 +154,13 @@ func TestSkillRepository(t *testing.T) {
 		skills := map[string]int{}
 
 		// Тест
-		err := storage.SaveFormalSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Дополнительно проверяем что в БД действительно ничего не добавилось
-		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 		require.NoError(t, err)
 		require.Empty(t, result)
 	})
@@ -175,13 +180,13 @@ func TestSkillRepository(t *testing.T) {
 		}
 
 		// Тест
-		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Проверяем что навыки сохранились
-		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 		require.NoError(t, err)
 		require.Len(t, result, 4)
 
@@ -205,13 +210,13 @@ func TestSkillRepository(t *testing.T) {
 		skills := map[string]int{}
 
 		// Тест
-		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Дополнительно проверяем что в БД действительно ничего не добавилось
-		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 		require.NoError(t, err)
 		require.Empty(t, result)
 	})
@@ -228,11 +233,11 @@ func TestSkillRepository(t *testing.T) {
 			"Hibernate": 10,
 		}
 
-		err := storage.SaveFormalSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 		require.NoError(t, err)
 
 		// Тест
-		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -252,7 +257,7 @@ func TestSkillRepository(t *testing.T) {
 		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
 
 		// Тест (навыки не добавлены)
-		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -270,11 +275,11 @@ func TestSkillRepository(t *testing.T) {
 			"Java": 20,
 		}
 
-		err := storage.SaveFormalSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 		require.NoError(t, err)
 
 		// Тест (запрашиваем для другой профессии)
-		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, wrongProfessionID, sessionID)
+		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, wrongProfessionID, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -292,11 +297,11 @@ func TestSkillRepository(t *testing.T) {
 			"Java": 20,
 		}
 
-		err := storage.SaveFormalSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 		require.NoError(t, err)
 
 		// Тест (запрашиваем для другой сессии)
-		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, wrongSessionID)
+		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, wrongSessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -316,11 +321,11 @@ func TestSkillRepository(t *testing.T) {
 			"HTML":       15,
 		}
 
-		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 		require.NoError(t, err)
 
 		// Тест
-		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -343,7 +348,7 @@ func TestSkillRepository(t *testing.T) {
 		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
 
 		// Тест (навыки не добавлены)
-		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -361,11 +366,11 @@ func TestSkillRepository(t *testing.T) {
 			"React": 25,
 		}
 
-		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 		require.NoError(t, err)
 
 		// Тест (запрашиваем для другой профессии)
-		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, wrongProfessionID, sessionID)
+		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, wrongProfessionID, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -383,11 +388,11 @@ func TestSkillRepository(t *testing.T) {
 			"React": 25,
 		}
 
-		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, skills)
+		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills, nil)
 		require.NoError(t, err)
 
 		// Тест (запрашиваем для другой сессии)
-		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, wrongSessionID)
+		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, wrongSessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -411,14 +416,14 @@ func TestSkillRepository(t *testing.T) {
 			"Terraform":  12,
 		}
 
-		err := storage.SaveFormalSkills(ctx, sessionID1, professionID, skills1)
+		err := storage.SaveFormalSkills(ctx, sessionID1, professionID, testArea, testExtractorVersion, skills1, nil)
 		require.NoError(t, err)
 
-		err = storage.SaveFormalSkills(ctx, sessionID2, professionID, skills2)
+		err = storage.SaveFormalSkills(ctx, sessionID2, professionID, testArea, testExtractorVersion, skills2, nil)
 		require.NoError(t, err)
 
 		// Тест - получаем навыки для первой сессии
-		result1, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID1)
+		result1, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID1, testArea)
 		require.NoError(t, err)
 		require.Len(t, result1, 2)
 		require.Equal(t, "Kubernetes", result1[0].Skill)
@@ -427,7 +432,7 @@ func TestSkillRepository(t *testing.T) {
 		require.Equal(t, int32(8), result1[1].Count)
 
 		// Тест - получаем навыки для второй сессии
-		result2, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID2)
+		result2, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID2, testArea)
 		require.NoError(t, err)
 		require.Len(t, result2, 2)
 		require.Equal(t, "Kubernetes", result2[0].Skill)
@@ -455,14 +460,14 @@ func TestSkillRepository(t *testing.T) {
 			"PyTorch":       14,
 		}
 
-		err := storage.SaveExtractedSkills(ctx, sessionID1, professionID, skills1)
+		err := storage.SaveExtractedSkills(ctx, sessionID1, professionID, testArea, testExtractorVersion, skills1, nil)
 		require.NoError(t, err)
 
-		err = storage.SaveExtractedSkills(ctx, sessionID2, professionID, skills2)
+		err = storage.SaveExtractedSkills(ctx, sessionID2, professionID, testArea, testExtractorVersion, skills2, nil)
 		require.NoError(t, err)
 
 		// Тест - получаем навыки для первой сессии
-		result1, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID1)
+		result1, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID1, testArea)
 		require.NoError(t, err)
 		require.Len(t, result1, 3)
 		require.Equal(t, "Python", result1[0].Skill)
@@ -473,7 +478,7 @@ func TestSkillRepository(t *testing.T) {
 		require.Equal(t, int32(10), result1[2].Count)
 
 		// Тест - получаем навыки для второй сессии
-		result2, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID2)
+		result2, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID2, testArea)
 		require.NoError(t, err)
 		require.Len(t, result2, 3)
 		require.Equal(t, "Python", result2[0].Skill)
@@ -495,7 +500,7 @@ func TestSkillRepository(t *testing.T) {
 			"Go": 10,
 		}
 
-		err := storage.SaveFormalSkills(ctx, sessionID, professionID, skills1)
+		err := storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills1, nil)
 		require.NoError(t, err)
 
 		// Затем обновляем count для того же навыка
@@ -503,11 +508,11 @@ func TestSkillRepository(t *testing.T) {
 			"Go": 20,
 		}
 
-		err = storage.SaveFormalSkills(ctx, sessionID, professionID, skills2)
+		err = storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills2, nil)
 		require.NoError(t, err)
 
 		// Тест
-		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 
 		// Assert - проверяем что навык обновился (или добавился дубликат, в зависимости от реализации)
 		require.NoError(t, err)
@@ -526,7 +531,7 @@ func TestSkillRepository(t *testing.T) {
 			"Python": 15,
 		}
 
-		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, skills1)
+		err := storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills1, nil)
 		require.NoError(t, err)
 
 		// Затем обновляем count для того же навыка
@@ -534,11 +539,11 @@ func TestSkillRepository(t *testing.T) {
 			"Python": 25,
 		}
 
-		err = storage.SaveExtractedSkills(ctx, sessionID, professionID, skills2)
+		err = storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, skills2, nil)
 		require.NoError(t, err)
 
 		// Тест
-		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID)
+		result, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
 
 		// Assert - проверяем что навык обновился (или добавился дубликат, в зависимости от реализации)
 		require.NoError(t, err)
@@ -554,7 +559,7 @@ func TestSkillRepository(t *testing.T) {
 		skills := map[string]int{"Go": 10}
 
 		// Тест - нарушение FK (профессия не существует)
-		err := storage.SaveFormalSkills(ctx, sessionID, fakeProfessionID, skills)
+		err := storage.SaveFormalSkills(ctx, sessionID, fakeProfessionID, testArea, testExtractorVersion, skills, nil)
 
 		// Assert - ожидаем ошибку из-за FK
 		require.Error(t, err)
@@ -568,7 +573,7 @@ func TestSkillRepository(t *testing.T) {
 		skills := map[string]int{"Python": 15}
 
 		// Тест - нарушение FK (профессия не существует)
-		err := storage.SaveExtractedSkills(ctx, sessionID, fakeProfessionID, skills)
+		err := storage.SaveExtractedSkills(ctx, sessionID, fakeProfessionID, testArea, testExtractorVersion, skills, nil)
 
 		// Assert - ожидаем ошибку из-за FK
 		require.Error(t, err)
@@ -582,7 +587,7 @@ func TestSkillRepository(t *testing.T) {
 		skills := map[string]int{"Go": 10}
 
 		// Тест - нарушение FK (сессия не существует)
-		err := storage.SaveFormalSkills(ctx, fakeSessionID, professionID, skills)
+		err := storage.SaveFormalSkills(ctx, fakeSessionID, professionID, testArea, testExtractorVersion, skills, nil)
 
 		// Assert - ожидаем ошибку из-за FK
 		require.Error(t, err)
@@ -596,9 +601,260 @@ func TestSkillRepository(t *testing.T) {
 		skills := map[string]int{"Python": 15}
 
 		// Тест - нарушение FK (сессия не существует)
-		err := storage.SaveExtractedSkills(ctx, fakeSessionID, professionID, skills)
+		err := storage.SaveExtractedSkills(ctx, fakeSessionID, professionID, testArea, testExtractorVersion, skills, nil)
 
 		// Assert - ожидаем ошибку из-за FK
 		require.Error(t, err)
 	})
+
+	t.Run("GetFormalSkillsWithDatesByProfessionAndDateRange_Success", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		jan := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+		feb := time.Date(2025, 2, 15, 3, 0, 0, 0, time.UTC)
+		mar := time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC)
+		session1 := createScrapingSessionSkill(ctx, t, storage, jan)
+		session2 := createScrapingSessionSkill(ctx, t, storage, feb)
+		session3 := createScrapingSessionSkill(ctx, t, storage, mar)
+
+		require.NoError(t, storage.SaveFormalSkills(ctx, session1, professionID, testArea, testExtractorVersion, map[string]int{"kubernetes": 10}, nil))
+		require.NoError(t, storage.SaveFormalSkills(ctx, session2, professionID, testArea, testExtractorVersion, map[string]int{"kubernetes": 15}, nil))
+		require.NoError(t, storage.SaveFormalSkills(ctx, session3, professionID, testArea, testExtractorVersion, map[string]int{"kubernetes": 20}, nil))
+
+		// Тест - март вне диапазона
+		result, err := storage.GetFormalSkillsWithDatesByProfessionAndDateRange(ctx, professionID, testArea,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC))
+
+		// Assert - ORDER BY scraped_at ASC
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Equal(t, "kubernetes", result[0].Skill)
+		require.Equal(t, int32(10), result[0].Count)
+		require.True(t, jan.Equal(result[0].ScrapedAt))
+		require.Equal(t, int32(15), result[1].Count)
+		require.True(t, feb.Equal(result[1].ScrapedAt))
+	})
+
+	t.Run("GetFormalSkillsWithDatesByProfessionAndDateRange_SkipsIncompleteSessions", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		jan := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, jan)
+		require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, map[string]int{"kubernetes": 10}, nil))
+
+		for i, status := range []string{domain.ScrapingStatusRunning, domain.ScrapingStatusPartial, domain.ScrapingStatusFailed} {
+			incompleteID := createScrapingSessionSkill(ctx, t, storage, jan.AddDate(0, 0, i+1))
+			require.NoError(t, storage.SetScrapingStatus(ctx, incompleteID, status))
+			require.NoError(t, storage.SaveFormalSkills(ctx, incompleteID, professionID, testArea, testExtractorVersion, map[string]int{"kubernetes": 1}, nil))
+		}
+
+		// Тест
+		result, err := storage.GetFormalSkillsWithDatesByProfessionAndDateRange(ctx, professionID, testArea,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+
+		// Assert - в истории только завершённые сессии
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Equal(t, int32(10), result[0].Count)
+		require.True(t, jan.Equal(result[0].ScrapedAt))
+	})
+
+	t.Run("GetExtractedSkillsWithDatesByProfessionAndDateRange_SkipsIncompleteSessions", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		jan := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, jan)
+		partialID := createScrapingSessionSkill(ctx, t, storage, jan.AddDate(0, 0, 1))
+		require.NoError(t, storage.SetScrapingStatus(ctx, partialID, domain.ScrapingStatusPartial))
+
+		require.NoError(t, storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, map[string]int{"grpc": 7}, nil))
+		require.NoError(t, storage.SaveExtractedSkills(ctx, partialID, professionID, testArea, testExtractorVersion, map[string]int{"grpc": 1}, nil))
+
+		// Тест
+		result, err := storage.GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx, professionID, testArea,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Equal(t, int32(7), result[0].Count)
+	})
+
+	t.Run("GetExtractedSkillsWithDatesByProfessionAndDateRange_Success", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		otherProfessionID := createProfession(ctx, t, storage, "Java Developer", "java developer", true)
+		jan := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, jan)
+
+		require.NoError(t, storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, map[string]int{"grpc": 7}, nil))
+		require.NoError(t, storage.SaveExtractedSkills(ctx, sessionID, otherProfessionID, testArea, testExtractorVersion, map[string]int{"spring": 30}, nil))
+
+		// Тест
+		result, err := storage.GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx, professionID, testArea,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+
+		// Assert - только навыки запрошенной профессии
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Equal(t, "grpc", result[0].Skill)
+		require.Equal(t, int32(7), result[0].Count)
+	})
+
+	t.Run("GetFormalSkillsWithDatesByProfessionAndDateRange_Empty", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+
+		// Тест
+		result, err := storage.GetFormalSkillsWithDatesByProfessionAndDateRange(ctx, professionID, testArea,
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+
+		// Assert
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("GetFormalSkillsByProfessionsAndSession_GroupedByProfession", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		goID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		pyID := createProfession(ctx, t, storage, "Python Developer", "python developer", true)
+		otherID := createProfession(ctx, t, storage, "Java Developer", "java developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC))
+		otherSessionID := createScrapingSessionSkill(ctx, t, storage, time.Date(2025, 1, 14, 3, 0, 0, 0, time.UTC))
+
+		require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, goID, testArea, testExtractorVersion, map[string]int{"golang": 100, "sql": 20}, nil))
+		require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, pyID, testArea, testExtractorVersion, map[string]int{"python": 80}, nil))
+		require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, otherID, testArea, testExtractorVersion, map[string]int{"java": 50}, nil))
+		require.NoError(t, storage.SaveFormalSkills(ctx, otherSessionID, pyID, testArea, testExtractorVersion, map[string]int{"django": 10}, nil))
+
+		// Тест
+		result, err := storage.GetFormalSkillsByProfessionsAndSession(ctx, []uuid.UUID{goID, pyID}, sessionID, testArea)
+
+		// Assert - профессия вне списка и другие сессии не попадают в результат
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Len(t, result[goID], 2)
+		require.Equal(t, "golang", result[goID][0].Skill)
+		require.Len(t, result[pyID], 1)
+		require.Equal(t, "python", result[pyID][0].Skill)
+	})
+
+	t.Run("ReplaceSkills_ReplacesSessionRows", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		otherProfessionID := createProfession(ctx, t, storage, "Java Developer", "java developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, map[string]int{"golang": 10, "docker": 3}, nil))
+		require.NoError(t, storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, map[string]int{"grpc": 7}, nil))
+		require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, otherProfessionID, testArea, testExtractorVersion, map[string]int{"java": 5}, nil))
+
+		// Тест
+		err := storage.ReplaceSkills(ctx, sessionID, professionID, testArea, "ngram-v2",
+			map[string]int{"golang": 12}, nil, map[string]int{"kafka": 4}, nil)
+
+		// Assert - старые строки профессии заменены, другие профессии не затронуты
+		require.NoError(t, err)
+
+		formal, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Len(t, formal, 1)
+		require.Equal(t, "golang", formal[0].Skill)
+		require.Equal(t, int32(12), formal[0].Count)
+
+		extracted, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Len(t, extracted, 1)
+		require.Equal(t, "kafka", extracted[0].Skill)
+
+		other, err := storage.GetFormalSkillsByProfessionAndDate(ctx, otherProfessionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Len(t, other, 1)
+
+		var version string
+		err = storage.Pool.QueryRow(ctx,
+			`SELECT DISTINCT extractor_version FROM skill_formal WHERE profession_id = $1`, professionID).Scan(&version)
+		require.NoError(t, err)
+		require.Equal(t, "ngram-v2", version)
+	})
+
+	t.Run("SaveSkills_LinksCatalogue", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		// Тест
+		require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, map[string]int{"go": 10, "grpc": 3}, nil))
+		require.NoError(t, storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion, map[string]int{"gin": 5}, nil))
+
+		// Assert - навыки из каталога связаны с ним, остальные остаются без ссылки
+		var linked, unlinked int
+		err := storage.Pool.QueryRow(ctx, `
+			SELECT count(*) FILTER (WHERE skill_id IS NOT NULL), count(*) FILTER (WHERE skill_id IS NULL)
+			FROM skill_formal WHERE profession_id = $1
+		`, professionID).Scan(&linked, &unlinked)
+		require.NoError(t, err)
+		require.Equal(t, 1, linked)
+		require.Equal(t, 1, unlinked)
+
+		var category string
+		err = storage.Pool.QueryRow(ctx, `
+			SELECT s.category FROM skill_extracted se JOIN skill s ON se.skill_id = s.id WHERE se.profession_id = $1
+		`, professionID).Scan(&category)
+		require.NoError(t, err)
+		require.Equal(t, "framework", category)
+	})
+
+	t.Run("GetCatalogSkillsByNames_Success", func(t *testing.T) {
+		// Тест
+		skills, err := storage.GetCatalogSkillsByNames(ctx, []string{"go", "gin", "grpc"})
+
+		// Assert - навыка grpc нет в каталоге
+		require.NoError(t, err)
+		require.Len(t, skills, 2)
+
+		byName := make(map[string]domain.CatalogSkill, len(skills))
+		for _, skill := range skills {
+			byName[skill.Name] = skill
+		}
+		require.Equal(t, domain.CatalogSkill{Name: "go", Category: "language"}, byName["go"])
+		require.Equal(t, domain.CatalogSkill{Name: "gin", Category: "framework", Parent: "go"}, byName["gin"])
+	})
+
+	t.Run("SaveSkills_Percentages", func(t *testing.T) {
+		cleanSkillTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		// Тест
+		require.NoError(t, storage.SaveFormalSkills(ctx, sessionID, professionID, testArea, testExtractorVersion,
+			map[string]int{"go": 10, "docker": 4}, map[string]float64{"go": 100, "docker": 40}))
+		require.NoError(t, storage.SaveExtractedSkills(ctx, sessionID, professionID, testArea, testExtractorVersion,
+			map[string]int{"kafka": 7}, nil))
+
+		// Assert - доля сохраняется рядом со счётчиком, без доли навык возвращается без неё
+		formal, err := storage.GetFormalSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Len(t, formal, 2)
+		require.Equal(t, "go", formal[0].Skill)
+		require.NotNil(t, formal[0].Percentage)
+		require.InDelta(t, 100, *formal[0].Percentage, 0.001)
+		require.NotNil(t, formal[1].Percentage)
+		require.InDelta(t, 40, *formal[1].Percentage, 0.001)
+
+		extracted, err := storage.GetExtractedSkillsByProfessionAndDate(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Len(t, extracted, 1)
+		require.Equal(t, int32(7), extracted[0].Count)
+		require.Nil(t, extracted[0].Percentage)
+	})
 }
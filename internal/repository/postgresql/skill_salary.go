@@ -0,0 +1,62 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+func (s *Storage) SaveSkillSalaries(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, skills []domain.SkillSalary) error {
+	const op = "repository.postgresql.skill_salary.SaveSkillSalaries"
+
+	params := make([]postgresql.InsertSkillSalariesParams, len(skills))
+	for i, skill := range skills {
+		params[i] = postgresql.InsertSkillSalariesParams{
+			ProfessionID:  professionID,
+			ScrapedAtID:   sessionID,
+			Skill:         skill.Skill,
+			WithCount:     skill.WithCount,
+			WithMedian:    skill.WithMedian,
+			WithoutCount:  skill.WithoutCount,
+			WithoutMedian: skill.WithoutMedian,
+			Area:          area,
+		}
+	}
+
+	if _, err := s.queries(ctx).InsertSkillSalaries(ctx, params); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetSkillSalariesByProfessionAndSession returns skills ordered by the salary difference, the best paid first.
+func (s *Storage) GetSkillSalariesByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) ([]domain.SkillSalary, error) {
+	const op = "repository.postgresql.skill_salary.GetSkillSalariesByProfessionAndSession"
+
+	rows, err := s.Queries.GetSkillSalariesByProfessionAndSession(ctx, postgresql.GetSkillSalariesByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	skills := make([]domain.SkillSalary, len(rows))
+	for i, row := range rows {
+		skills[i] = domain.SkillSalary{
+			Skill:         row.Skill,
+			WithCount:     row.WithCount,
+			WithMedian:    row.WithMedian,
+			WithoutCount:  row.WithoutCount,
+			WithoutMedian: row.WithoutMedian,
+		}
+	}
+
+	return skills, nil
+}
@@ -0,0 +1,63 @@
+//go:build integration
+
+// Интеграционные тесты для skill_salary репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func cleanSkillSalaryTables(ctx context.Context, t *testing.T, storage *postgresql.Storage) {
+	t.Helper()
+	_, err := storage.Pool.Exec(ctx, `TRUNCATE skill_salary, scraping, profession RESTART IDENTITY CASCADE`)
+	require.NoError(t, err)
+}
+
+func TestSkillSalaryRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	t.Run("SaveAndGetSkillSalaries_OrderedByDifference", func(t *testing.T) {
+		cleanSkillSalaryTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+		otherSessionID := createScrapingSessionSkill(ctx, t, storage, time.Now().Add(-time.Hour))
+
+		php := domain.SkillSalary{Skill: "php", WithCount: 5, WithMedian: 180000, WithoutCount: 95, WithoutMedian: 260000}
+		kubernetes := domain.SkillSalary{Skill: "kubernetes", WithCount: 30, WithMedian: 320000, WithoutCount: 70, WithoutMedian: 250000}
+
+		// Тест
+		err := storage.SaveSkillSalaries(ctx, sessionID, professionID, testArea, []domain.SkillSalary{php, kubernetes})
+		require.NoError(t, err)
+		require.NoError(t, storage.SaveSkillSalaries(ctx, otherSessionID, professionID, testArea, []domain.SkillSalary{
+			{Skill: "old", WithCount: 3, WithMedian: 1, WithoutCount: 3, WithoutMedian: 1},
+		}))
+
+		// Assert - сначала навыки с наибольшей разницей, другие сессии не попадают
+		result, err := storage.GetSkillSalariesByProfessionAndSession(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Equal(t, []domain.SkillSalary{kubernetes, php}, result)
+	})
+
+	t.Run("GetSkillSalariesByProfessionAndSession_Empty", func(t *testing.T) {
+		cleanSkillSalaryTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		// Тест
+		result, err := storage.GetSkillSalariesByProfessionAndSession(ctx, professionID, sessionID, testArea)
+
+		// Assert
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+}
@@ -0,0 +1,13 @@
+-- name: DeleteAreas :exec
+DELETE FROM area;
+
+-- name: InsertAreas :copyfrom
+INSERT INTO area (id, parent_id, name)
+VALUES ($1, $2, $3);
+
+-- name: SearchAreas :many
+SELECT id, parent_id, name
+FROM area
+WHERE strpos(lower(name), lower(sqlc.arg(query)::text)) > 0
+ORDER BY strpos(lower(name), lower(sqlc.arg(query)::text)), name
+LIMIT sqlc.arg(row_limit);
@@ -3,11 +3,50 @@ INSERT INTO scraping DEFAULT
 VALUES RETURNING id;
 
 -- name: GetLatestScraping :one
-SELECT id, scraped_at
+SELECT id, scraped_at, status
 FROM scraping
+WHERE status = 'complete'
 ORDER BY scraped_at DESC LIMIT 1;
 
+-- name: GetScrapingByID :one
+SELECT id, scraped_at, status
+FROM scraping
+WHERE id = $1;
+
 -- name: GetAllScrapingDates :many
-SELECT id, scraped_at
+SELECT id, scraped_at, status
 FROM scraping
-ORDER BY scraped_at DESC;
\ No newline at end of file
+ORDER BY scraped_at DESC;
+
+-- name: UpdateScrapingStatus :exec
+UPDATE scraping
+SET status = $2
+WHERE id = $1;
+
+-- name: FinishQueuedScraping :exec
+UPDATE scraping
+SET status = CASE
+                 WHEN EXISTS (SELECT 1
+                              FROM scraping_job
+                              WHERE session_id = $1
+                                AND run_id = $2
+                                AND status = 'cancelled') THEN 'failed'
+                 WHEN NOT EXISTS (SELECT 1
+                                  FROM scraping_job
+                                  WHERE session_id = $1
+                                    AND run_id = $2
+                                    AND status = 'done') THEN 'failed'
+                 WHEN EXISTS (SELECT 1
+                              FROM scraping_job
+                              WHERE session_id = $1
+                                AND run_id = $2
+                                AND status = 'failed') THEN 'partial'
+                 ELSE 'complete'
+    END
+WHERE id = $1
+  AND status = 'running'
+  AND NOT EXISTS (SELECT 1
+                  FROM scraping_job
+                  WHERE session_id = $1
+                    AND run_id = $2
+                    AND status IN ('pending', 'running'));
\ No newline at end of file
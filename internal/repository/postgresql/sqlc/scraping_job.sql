@@ -0,0 +1,76 @@
+-- name: InsertScrapingJobs :copyfrom
+INSERT INTO scraping_job (id, run_id, session_id, profession_id, area, save_to_db, replace, max_attempts)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ClaimScrapingJob :one
+UPDATE scraping_job
+SET status     = 'running',
+    attempts   = attempts + 1,
+    locked_at  = NOW(),
+    updated_at = NOW()
+WHERE id = (SELECT j.id
+            FROM scraping_job AS j
+            WHERE (j.status = 'pending' AND j.run_after <= NOW())
+               OR (j.status = 'running' AND j.locked_at < sqlc.arg(stale_before)::timestamptz
+                AND j.attempts < j.max_attempts)
+            ORDER BY j.run_after
+            LIMIT 1 FOR UPDATE SKIP LOCKED)
+RETURNING id, run_id, session_id, profession_id, area, save_to_db, replace, status, attempts, max_attempts, run_after, locked_at, last_error, created_at, updated_at;
+
+-- name: CompleteScrapingJob :exec
+UPDATE scraping_job
+SET status     = 'done',
+    locked_at  = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'running';
+
+-- name: RetryScrapingJob :exec
+UPDATE scraping_job
+SET status     = 'pending',
+    run_after  = $2,
+    last_error = $3,
+    locked_at  = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'running';
+
+-- name: ReleaseScrapingJob :exec
+UPDATE scraping_job
+SET status     = 'pending',
+    attempts   = attempts - 1,
+    run_after  = NOW(),
+    last_error = $2,
+    locked_at  = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'running';
+
+-- name: FailScrapingJob :exec
+UPDATE scraping_job
+SET status     = 'failed',
+    last_error = $2,
+    locked_at  = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'running';
+
+-- name: FailStaleScrapingJobs :many
+UPDATE scraping_job
+SET status     = 'failed',
+    last_error = 'worker lease expired',
+    locked_at  = NULL,
+    updated_at = NOW()
+WHERE status = 'running'
+  AND locked_at < sqlc.arg(stale_before)::timestamptz
+  AND attempts >= max_attempts
+RETURNING id, run_id, session_id, profession_id, area, save_to_db, replace, status, attempts, max_attempts, run_after, locked_at, last_error, created_at, updated_at;
+
+-- name: CancelScrapingJobs :many
+UPDATE scraping_job
+SET status     = 'cancelled',
+    locked_at  = NULL,
+    updated_at = NOW()
+WHERE run_id = $1
+  AND status IN ('pending', 'running')
+RETURNING id, run_id, session_id, profession_id, area, save_to_db, replace, status, attempts, max_attempts, run_after, locked_at, last_error, created_at, updated_at;
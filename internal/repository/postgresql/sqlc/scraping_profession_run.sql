@@ -0,0 +1,41 @@
+-- name: InsertProfessionRun :exec
+INSERT INTO scraping_profession_run (run_id, session_id, profession_id, area, status, error, total_found, ids_collected,
+                                     vacancies_fetched, pages_failed, vacancies_failed, started_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: GetProfessionRunByProfessionAndSession :one
+SELECT total_found, ids_collected, vacancies_fetched, pages_failed, vacancies_failed
+FROM scraping_profession_run
+WHERE profession_id = $1
+  AND session_id = $2
+  AND area = $3
+  AND status = 'success'
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: GetProfessionRunsByRun :many
+SELECT spr.profession_id,
+       p.name AS profession_name,
+       spr.area,
+       spr.session_id,
+       spr.status,
+       spr.error,
+       spr.total_found,
+       spr.ids_collected,
+       spr.vacancies_fetched,
+       spr.pages_failed,
+       spr.vacancies_failed,
+       spr.started_at,
+       spr.created_at
+FROM scraping_profession_run spr
+         JOIN profession p ON p.id = spr.profession_id
+WHERE spr.run_id = $1
+ORDER BY spr.started_at, p.name, spr.area;
+
+
+-- name: GetSucceededProfessionAreasBySession :many
+SELECT DISTINCT profession_id, area
+FROM scraping_profession_run
+WHERE session_id = $1
+  AND status = 'success'
+ORDER BY profession_id, area;
\ No newline at end of file
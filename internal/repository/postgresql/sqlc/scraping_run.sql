@@ -0,0 +1,26 @@
+-- name: InsertScrapingRun :exec
+INSERT INTO scraping_run (id, mode, trigger, triggered_by, status, started_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: FinishScrapingRun :exec
+UPDATE scraping_run
+SET status      = $2,
+    error       = $3,
+    finished_at = NOW()
+WHERE id = $1
+  AND status = 'running'
+  AND ($2 <> 'completed' OR NOT EXISTS (SELECT 1
+                                        FROM scraping_job
+                                        WHERE run_id = $1
+                                          AND status IN ('pending', 'running')));
+
+-- name: GetScrapingRuns :many
+SELECT id, mode, trigger, triggered_by, status, error, started_at, finished_at
+FROM scraping_run
+ORDER BY started_at DESC
+LIMIT $1;
+
+-- name: GetScrapingRunByID :one
+SELECT id, mode, trigger, triggered_by, status, error, started_at, finished_at
+FROM scraping_run
+WHERE id = $1;
@@ -0,0 +1,5 @@
+-- name: GetCatalogSkillsByNames :many
+SELECT s.name, s.category, p.name AS parent
+FROM skill s
+         LEFT JOIN skill p ON s.parent_id = p.id
+WHERE s.name = ANY (sqlc.arg(names)::text[]);
@@ -0,0 +1,22 @@
+-- name: GetSkillAliases :many
+SELECT id, alias, skill, created_at
+FROM skill_alias
+ORDER BY skill, alias;
+
+-- name: LockSkillAliases :exec
+SELECT pg_advisory_xact_lock(hashtext('skill_alias'));
+
+-- name: InsertSkillAlias :one
+INSERT INTO skill_alias (alias, skill)
+VALUES ($1, $2) RETURNING id, alias, skill, created_at;
+
+-- name: UpdateSkillAlias :one
+UPDATE skill_alias
+SET alias = $2,
+    skill = $3
+WHERE id = $1 RETURNING id, alias, skill, created_at;
+
+-- name: DeleteSkillAlias :execrows
+DELETE
+FROM skill_alias
+WHERE id = $1;
@@ -0,0 +1,18 @@
+-- name: InsertSkillSalaries :copyfrom
+INSERT INTO skill_salary (profession_id, scraped_at_id, skill, with_count, with_median, without_count, without_median, area)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetSkillSalariesByProfessionAndSession :many
+SELECT skill, with_count, with_median, without_count, without_median
+FROM skill_salary
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3
+ORDER BY with_median - without_median DESC, skill;
+
+-- name: DeleteSkillSalariesByProfessionAndSession :exec
+DELETE
+FROM skill_salary
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
@@ -1,12 +1,13 @@
 -- name: InsertExtractedSkills :copyfrom
-INSERT INTO skill_extracted (profession_id, skill, count, scraped_at_id)
-VALUES ($1, $2, $3, $4);
+INSERT INTO skill_extracted (profession_id, skill, count, scraped_at_id, extractor_version, area, percentage)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: GetExtractedSkillsByProfessionAndDate :many
-SELECT skill, count
+SELECT skill, count, percentage
 FROM skill_extracted
 WHERE profession_id = $1
   AND scraped_at_id = $2
+  AND area = $3
 ORDER BY count DESC;
 
 -- name: GetExtractedSkillsWithDatesByProfessionAndDateRange :many
@@ -15,6 +16,8 @@ FROM skill_extracted s
          JOIN scraping sc ON s.scraped_at_id = sc.id
 WHERE s.profession_id = $1
   AND sc.scraped_at BETWEEN $2 AND $3
+  AND s.area = $4
+  AND sc.status = 'complete'
 ORDER BY sc.scraped_at ASC;
 
 -- name: GetExtractedSkillsWithDatesByProfessionsAndDateRange :many
@@ -23,4 +26,22 @@ FROM skill_extracted s
          JOIN scraping sc ON s.scraped_at_id = sc.id
 WHERE s.profession_id = ANY ($1::uuid[])
   AND sc.scraped_at BETWEEN $2 AND $3
-ORDER BY s.profession_id, sc.scraped_at;
\ No newline at end of file
+  AND s.area = $4
+  AND sc.status = 'complete'
+ORDER BY s.profession_id, sc.scraped_at;
+
+-- name: DeleteExtractedSkillsByProfessionAndSession :exec
+DELETE
+FROM skill_extracted
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
+
+-- name: LinkExtractedSkills :exec
+UPDATE skill_extracted se
+SET skill_id = s.id
+FROM skill s
+WHERE se.skill = s.name
+  AND se.profession_id = $1
+  AND se.scraped_at_id = $2
+  AND se.area = $3;
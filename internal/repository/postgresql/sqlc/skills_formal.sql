@@ -1,20 +1,31 @@
 -- name: InsertFormalSkills :copyfrom
-INSERT INTO skill_formal (profession_id, skill, count, scraped_at_id)
-VALUES ($1, $2, $3, $4);
+INSERT INTO skill_formal (profession_id, skill, count, scraped_at_id, extractor_version, area, percentage)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: GetFormalSkillsByProfessionAndDate :many
-SELECT skill, count
+SELECT skill, count, percentage
 FROM skill_formal
 WHERE profession_id = $1
   AND scraped_at_id = $2
+  AND area = $3
 ORDER BY count DESC;
 
+-- name: GetFormalSkillsByProfessionsAndSession :many
+SELECT profession_id, skill, count, percentage
+FROM skill_formal
+WHERE profession_id = ANY ($1::uuid[])
+  AND scraped_at_id = $2
+  AND area = $3
+ORDER BY profession_id, count DESC;
+
 -- name: GetFormalSkillsWithDatesByProfessionAndDateRange :many
 SELECT s.skill, s.count, sc.scraped_at
 FROM skill_formal s
          JOIN scraping sc ON s.scraped_at_id = sc.id
 WHERE s.profession_id = $1
   AND sc.scraped_at BETWEEN $2 AND $3
+  AND s.area = $4
+  AND sc.status = 'complete'
 ORDER BY sc.scraped_at ASC;
 
 -- name: GetFormalSkillsWithDatesByProfessionsAndDateRange :many
@@ -23,4 +34,22 @@ FROM skill_formal s
          JOIN scraping sc ON s.scraped_at_id = sc.id
 WHERE s.profession_id = ANY ($1::uuid[])
   AND sc.scraped_at BETWEEN $2 AND $3
-ORDER BY s.profession_id, sc.scraped_at;
\ No newline at end of file
+  AND s.area = $4
+  AND sc.status = 'complete'
+ORDER BY s.profession_id, sc.scraped_at;
+
+-- name: DeleteFormalSkillsByProfessionAndSession :exec
+DELETE
+FROM skill_formal
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
+
+-- name: LinkFormalSkills :exec
+UPDATE skill_formal sf
+SET skill_id = s.id
+FROM skill s
+WHERE sf.skill = s.name
+  AND sf.profession_id = $1
+  AND sf.scraped_at_id = $2
+  AND sf.area = $3;
@@ -1,12 +1,21 @@
 -- name: InsertStat :one
-INSERT INTO stat (profession_id, vacancy_count, scraped_at_id)
-VALUES ($1, $2, $3) RETURNING id;
+INSERT INTO stat (profession_id, vacancy_count, scraped_at_id, area)
+VALUES ($1, $2, $3, $4) RETURNING id;
 
--- name: GetLatestStatByProfessionID :one
+-- name: GetStatByProfessionAndSession :one
 SELECT profession_id, vacancy_count, scraped_at_id
 FROM stat
 WHERE profession_id = $1
-ORDER BY scraped_at_id DESC LIMIT 1;
+  AND scraped_at_id = $2
+  AND area = $3;
+
+-- name: GetStatsByProfessionsAndSession :many
+SELECT profession_id, vacancy_count, scraped_at_id
+FROM stat
+WHERE profession_id = ANY ($1::uuid[])
+  AND scraped_at_id = $2
+  AND area = $3
+ORDER BY profession_id;
 
 -- name: GetStatsByProfessionsAndDateRange :many
 SELECT profession_id, vacancy_count, scraped_at_id
@@ -14,4 +23,13 @@ FROM stat
          JOIN scraping sc ON stat.scraped_at_id = sc.id
 WHERE profession_id = ANY ($1::uuid[])
   AND sc.scraped_at BETWEEN $2 AND $3
-ORDER BY profession_id, sc.scraped_at;
\ No newline at end of file
+  AND stat.area = $4
+  AND sc.status = 'complete'
+ORDER BY profession_id, sc.scraped_at;
+
+-- name: DeleteStatByProfessionAndSession :exec
+DELETE
+FROM stat
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
@@ -0,0 +1,18 @@
+-- name: InsertBreakdown :copyfrom
+INSERT INTO stat_breakdown (profession_id, scraped_at_id, dimension, value, count, area)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetBreakdownByProfessionAndSession :many
+SELECT dimension, value, count
+FROM stat_breakdown
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3
+ORDER BY dimension, count DESC, value;
+
+-- name: DeleteBreakdownByProfessionAndSession :exec
+DELETE
+FROM stat_breakdown
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
@@ -0,0 +1,26 @@
+-- name: InsertSalaryStat :exec
+INSERT INTO stat_salary (profession_id, scraped_at_id, sample_size, p25, median, p75, area)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetSalaryStatByProfessionAndSession :one
+SELECT sample_size, p25, median, p75
+FROM stat_salary
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
+
+-- name: GetSalaryStatsByProfessionID :many
+SELECT ss.sample_size, ss.p25, ss.median, ss.p75, sc.scraped_at
+FROM stat_salary ss
+         JOIN scraping sc ON ss.scraped_at_id = sc.id
+WHERE ss.profession_id = $1
+  AND ss.area = $2
+  AND sc.status = 'complete'
+ORDER BY sc.scraped_at;
+
+-- name: DeleteSalaryStatByProfessionAndSession :exec
+DELETE
+FROM stat_salary
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
@@ -0,0 +1,54 @@
+-- name: InsertVacancies :copyfrom
+INSERT INTO vacancy (external_id, profession_id, scraped_at_id, description, description_hash, key_skills, published_at,
+                     salary_from, salary_to, salary_currency, salary_gross, salary_rub, experience, employment, schedule,
+                     area, source)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17);
+
+-- name: GetVacanciesByProfessionAndSession :many
+SELECT external_id, source, description, description_hash, key_skills, published_at
+FROM vacancy
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3
+ORDER BY source, external_id
+LIMIT $4 OFFSET $5;
+
+-- name: CountVacanciesByProfessionAndSession :one
+SELECT COUNT(*)
+FROM vacancy
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
+
+-- name: GetAllVacanciesByProfessionAndSession :many
+SELECT external_id,
+       description,
+       key_skills,
+       published_at,
+       salary_from,
+       salary_to,
+       salary_currency,
+       salary_gross,
+       salary_rub,
+       experience,
+       employment,
+       schedule,
+       source
+FROM vacancy
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3
+ORDER BY source, external_id;
+
+-- name: GetVacancyProfessionAreasBySession :many
+SELECT DISTINCT profession_id, area
+FROM vacancy
+WHERE scraped_at_id = $1
+ORDER BY profession_id, area;
+
+-- name: DeleteVacanciesByProfessionAndSession :exec
+DELETE
+FROM vacancy
+WHERE profession_id = $1
+  AND scraped_at_id = $2
+  AND area = $3;
@@ -3,7 +3,6 @@ package postgresql
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 
@@ -11,20 +10,25 @@ import (
 	postgresql "psa/internal/repository/postgresql/generated"
 )
 
-func (s *Storage) SaveStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, vacancyCount int) error {
-	_, err := s.Queries.InsertStat(ctx, postgresql.InsertStatParams{
+func (s *Storage) SaveStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, vacancyCount int) error {
+	_, err := s.queries(ctx).InsertStat(ctx, postgresql.InsertStatParams{
 		ProfessionID: professionID,
 		VacancyCount: int32(vacancyCount),
 		ScrapedAtID:  sessionID,
+		Area:         area,
 	})
 
 	return err
 }
 
-func (s *Storage) GetLatestStatByProfessionID(ctx context.Context, professionID uuid.UUID) (domain.Stat, error) {
-	const op = "repository.postgresql.stat.GetLatestStatByProfessionID"
+func (s *Storage) GetStatByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.Stat, error) {
+	const op = "repository.postgresql.stat.GetStatByProfessionAndSession"
 
-	row, err := s.Queries.GetLatestStatByProfessionID(ctx, professionID)
+	row, err := s.Queries.GetStatByProfessionAndSession(ctx, postgresql.GetStatByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	})
 	if err != nil {
 		return domain.Stat{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -36,23 +40,14 @@ func (s *Storage) GetLatestStatByProfessionID(ctx context.Context, professionID
 	}, nil
 }
 
-func (s *Storage) GetStatsByProfessionsAndDateRange(ctx context.Context, professionIDs []uuid.UUID, startDate, endDate string) ([]domain.Stat, error) {
-	const op = "repository.postgresql.stat.GetStatsByProfessionAndDateRange"
-
-	start, err := time.Parse(time.RFC3339, startDate)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	end, err := time.Parse(time.RFC3339, endDate)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
+// GetStatsByProfessionsAndSession returns the vacancy counts of the professions collected in the session.
+func (s *Storage) GetStatsByProfessionsAndSession(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string) ([]domain.Stat, error) {
+	const op = "repository.postgresql.stat.GetStatsByProfessionsAndSession"
 
-	rows, err := s.Queries.GetStatsByProfessionsAndDateRange(ctx, postgresql.GetStatsByProfessionsAndDateRangeParams{
+	rows, err := s.Queries.GetStatsByProfessionsAndSession(ctx, postgresql.GetStatsByProfessionsAndSessionParams{
 		Column1:     professionIDs,
-		ScrapedAt:   start,
-		ScrapedAt_2: end,
+		ScrapedAtID: sessionID,
+		Area:        area,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -0,0 +1,72 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+func (s *Storage) SaveVacancyBreakdown(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, breakdown domain.VacancyBreakdown) error {
+	const op = "repository.postgresql.stat_breakdown.SaveVacancyBreakdown"
+
+	dimensions := map[string][]domain.BreakdownItem{
+		domain.BreakdownExperience: breakdown.Experience,
+		domain.BreakdownEmployment: breakdown.Employment,
+		domain.BreakdownSchedule:   breakdown.Schedule,
+		domain.BreakdownSource:     breakdown.Source,
+	}
+
+	params := make([]postgresql.InsertBreakdownParams, 0)
+	for dimension, items := range dimensions {
+		for _, item := range items {
+			params = append(params, postgresql.InsertBreakdownParams{
+				ProfessionID: professionID,
+				ScrapedAtID:  sessionID,
+				Dimension:    dimension,
+				Value:        item.Value,
+				Count:        item.Count,
+				Area:         area,
+			})
+		}
+	}
+
+	if _, err := s.queries(ctx).InsertBreakdown(ctx, params); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetVacancyBreakdownByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.VacancyBreakdown, error) {
+	const op = "repository.postgresql.stat_breakdown.GetVacancyBreakdownByProfessionAndSession"
+
+	rows, err := s.Queries.GetBreakdownByProfessionAndSession(ctx, postgresql.GetBreakdownByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	})
+	if err != nil {
+		return domain.VacancyBreakdown{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var breakdown domain.VacancyBreakdown
+	for _, row := range rows {
+		item := domain.BreakdownItem{Value: row.Value, Count: row.Count}
+		switch row.Dimension {
+		case domain.BreakdownExperience:
+			breakdown.Experience = append(breakdown.Experience, item)
+		case domain.BreakdownEmployment:
+			breakdown.Employment = append(breakdown.Employment, item)
+		case domain.BreakdownSchedule:
+			breakdown.Schedule = append(breakdown.Schedule, item)
+		case domain.BreakdownSource:
+			breakdown.Source = append(breakdown.Source, item)
+		}
+	}
+
+	return breakdown, nil
+}
@@ -0,0 +1,68 @@
+//go:build integration
+
+// Интеграционные тесты для stat_breakdown репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func cleanBreakdownTables(ctx context.Context, t *testing.T, storage *postgresql.Storage) {
+	t.Helper()
+	_, err := storage.Pool.Exec(ctx, `TRUNCATE stat_breakdown, scraping, profession RESTART IDENTITY CASCADE`)
+	require.NoError(t, err)
+}
+
+func TestBreakdownRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	t.Run("SaveAndGetVacancyBreakdown_Success", func(t *testing.T) {
+		cleanBreakdownTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+		otherSessionID := createScrapingSessionSkill(ctx, t, storage, time.Now().Add(-time.Hour))
+
+		breakdown := domain.VacancyBreakdown{
+			Experience: []domain.BreakdownItem{{Value: "between3And6", Count: 60}, {Value: "between1And3", Count: 40}},
+			Employment: []domain.BreakdownItem{{Value: "full", Count: 100}},
+			Schedule:   []domain.BreakdownItem{{Value: "fullDay", Count: 75}, {Value: "remote", Count: 25}},
+		}
+
+		// Тест
+		err := storage.SaveVacancyBreakdown(ctx, sessionID, professionID, testArea, breakdown)
+		require.NoError(t, err)
+		require.NoError(t, storage.SaveVacancyBreakdown(ctx, otherSessionID, professionID, testArea, domain.VacancyBreakdown{
+			Schedule: []domain.BreakdownItem{{Value: "remote", Count: 1}},
+		}))
+
+		// Assert - значения внутри измерения отсортированы по убыванию количества
+		result, err := storage.GetVacancyBreakdownByProfessionAndSession(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Equal(t, breakdown, result)
+	})
+
+	t.Run("GetVacancyBreakdownByProfessionAndSession_Empty", func(t *testing.T) {
+		cleanBreakdownTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		// Тест
+		result, err := storage.GetVacancyBreakdownByProfessionAndSession(ctx, professionID, sessionID, testArea)
+
+		// Assert
+		require.NoError(t, err)
+		require.Empty(t, result.Experience)
+		require.Empty(t, result.Employment)
+		require.Empty(t, result.Schedule)
+	})
+}
@@ -11,20 +11,24 @@ import (
 	postgresql "psa/internal/repository/postgresql/generated"
 )
 
-func (s *Storage) SaveStatDaily(ctx context.Context, professionID uuid.UUID, vacancyCount int, scrapedAt time.Time) error {
+func (s *Storage) SaveStatDaily(ctx context.Context, professionID uuid.UUID, area string, vacancyCount int, scrapedAt time.Time) error {
 	_, err := s.Queries.InsertStatDaily(ctx, postgresql.InsertStatDailyParams{
 		ProfessionID: professionID,
 		VacancyCount: int32(vacancyCount),
 		ScrapedAt:    scrapedAt,
+		Area:         area,
 	})
 
 	return err
 }
 
-func (s *Storage) GetStatDailyByProfessionID(ctx context.Context, professionID uuid.UUID) ([]domain.StatDailyPoint, error) {
+func (s *Storage) GetStatDailyByProfessionID(ctx context.Context, professionID uuid.UUID, area string) ([]domain.StatDailyPoint, error) {
 	const op = "repository.postgresql.stat_daily.GetStatDailyByProfessionID"
 
-	rows, err := s.Queries.GetStatDailyByProfessionID(ctx, professionID)
+	rows, err := s.Queries.GetStatDailyByProfessionID(ctx, postgresql.GetStatDailyByProfessionIDParams{
+		ProfessionID: professionID,
+		Area:         area,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -40,10 +44,13 @@ func (s *Storage) GetStatDailyByProfessionID(ctx context.Context, professionID u
 	return points, nil
 }
 
-func (s *Storage) GetStatDailyByProfessionIDs(ctx context.Context, professionIDs []uuid.UUID) (map[uuid.UUID][]domain.StatDailyPoint, error) {
+func (s *Storage) GetStatDailyByProfessionIDs(ctx context.Context, professionIDs []uuid.UUID, area string) (map[uuid.UUID][]domain.StatDailyPoint, error) {
 	const op = "repository.postgresql.stat_daily.GetStatDailyByProfessionIDs"
 
-	rows, err := s.Queries.GetStatDailyByProfessionIDs(ctx, professionIDs)
+	rows, err := s.Queries.GetStatDailyByProfessionIDs(ctx, postgresql.GetStatDailyByProfessionIDsParams{
+		Column1: professionIDs,
+		Area:    area,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -108,13 +108,13 @@ func TestStatDailyRepository(t *testing.T) {
 		vacancyCount := 150
 
 		// Тест
-		err := storage.SaveStatDaily(ctx, professionID, vacancyCount, scrapedAt)
+		err := storage.SaveStatDaily(ctx, professionID, testArea, vacancyCount, scrapedAt)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Проверяем что данные действительно сохранились
-		points, err := storage.GetStatDailyByProfessionID(ctx, professionID)
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Len(t, points, 1)
 		require.Equal(t, int32(vacancyCount), points[0].VacancyCount)
@@ -140,12 +140,12 @@ func TestStatDailyRepository(t *testing.T) {
 
 		// Тест - сохраняем несколько записей
 		for i, date := range dates {
-			err := storage.SaveStatDaily(ctx, professionID, counts[i], date)
+			err := storage.SaveStatDaily(ctx, professionID, testArea, counts[i], date)
 			require.NoError(t, err)
 		}
 
 		// Проверяем что данные действительно сохранились
-		points, err := storage.GetStatDailyByProfessionID(ctx, professionID)
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Len(t, points, 4)
 
@@ -169,7 +169,7 @@ func TestStatDailyRepository(t *testing.T) {
 		scrapedAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
 
 		// Тест - нарушение FK (профессия не существует)
-		err := storage.SaveStatDaily(ctx, fakeProfessionID, 100, scrapedAt)
+		err := storage.SaveStatDaily(ctx, fakeProfessionID, testArea, 100, scrapedAt)
 
 		// Assert
 		require.Error(t, err)
@@ -184,11 +184,11 @@ func TestStatDailyRepository(t *testing.T) {
 		scrapedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 
 		// Тест - сохраняем с нулевым количеством вакансий
-		err := storage.SaveStatDaily(ctx, professionID, 0, scrapedAt)
+		err := storage.SaveStatDaily(ctx, professionID, testArea, 0, scrapedAt)
 
 		// Assert - проверяем что данные действительно сохранились
 		require.NoError(t, err)
-		points, err := storage.GetStatDailyByProfessionID(ctx, professionID)
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Len(t, points, 1)
 		require.Equal(t, int32(0), points[0].VacancyCount)
@@ -204,11 +204,11 @@ func TestStatDailyRepository(t *testing.T) {
 		scrapedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 
 		// Тест - сохраняем с большим количеством вакансий
-		err := storage.SaveStatDaily(ctx, professionID, 1000000, scrapedAt)
+		err := storage.SaveStatDaily(ctx, professionID, testArea, 1000000, scrapedAt)
 
 		// Assert - проверяем что данные действительно сохранились
 		require.NoError(t, err)
-		points, err := storage.GetStatDailyByProfessionID(ctx, professionID)
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Len(t, points, 1)
 		require.Equal(t, int32(1000000), points[0].VacancyCount)
@@ -233,12 +233,12 @@ func TestStatDailyRepository(t *testing.T) {
 
 		// Сохраняем несколько записей
 		for i, date := range dates {
-			err := storage.SaveStatDaily(ctx, professionID, counts[i], date)
+			err := storage.SaveStatDaily(ctx, professionID, testArea, counts[i], date)
 			require.NoError(t, err)
 		}
 
 		// Тест
-		points, err := storage.GetStatDailyByProfessionID(ctx, professionID)
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -264,11 +264,11 @@ func TestStatDailyRepository(t *testing.T) {
 		professionID := createProfessionForStatDaily(ctx, t, storage, "Go Developer GetStatDailyByProfessionID_SingleStat", "go developer 4", true)
 
 		scrapedAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
-		err := storage.SaveStatDaily(ctx, professionID, 250, scrapedAt)
+		err := storage.SaveStatDaily(ctx, professionID, testArea, 250, scrapedAt)
 		require.NoError(t, err)
 
 		// Тест
-		points, err := storage.GetStatDailyByProfessionID(ctx, professionID)
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -285,13 +285,33 @@ func TestStatDailyRepository(t *testing.T) {
 		professionID := createProfessionForStatDaily(ctx, t, storage, "Go Developer GetStatDailyByProfessionID_Empty", "go developer 5", true)
 
 		// Тест (нет записей)
-		points, err := storage.GetStatDailyByProfessionID(ctx, professionID)
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
 		require.Empty(t, points)
 	})
 
+	t.Run("GetStatDailyByProfessionID_FiltersByArea", func(t *testing.T) {
+		t.Cleanup(func() {
+			cleanStatDailyAndRelatedTables(ctx, t, storage)
+		})
+
+		professionID := createProfessionForStatDaily(ctx, t, storage, "Go Developer GetStatDailyByProfessionID_FiltersByArea", "go developer area", true)
+		scrapedAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+		require.NoError(t, storage.SaveStatDaily(ctx, professionID, testArea, 1000, scrapedAt))
+		require.NoError(t, storage.SaveStatDaily(ctx, professionID, "2", 150, scrapedAt))
+
+		// Тест
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, "2")
+
+		// Assert - точки других регионов не попадают в тренд
+		require.NoError(t, err)
+		require.Len(t, points, 1)
+		require.Equal(t, int32(150), points[0].VacancyCount)
+	})
+
 	t.Run("GetStatDailyByProfessionID_MultipleEntriesPerDay", func(t *testing.T) {
 		t.Cleanup(func() {
 			cleanStatDailyAndRelatedTables(ctx, t, storage)
@@ -302,17 +322,17 @@ func TestStatDailyRepository(t *testing.T) {
 		// Используем фиксированную дату для детерминированности
 		today := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 		// Сохраняем несколько записей за один день
-		err := storage.SaveStatDaily(ctx, professionID, 100, today.Add(8*time.Hour))
+		err := storage.SaveStatDaily(ctx, professionID, testArea, 100, today.Add(8*time.Hour))
 		require.NoError(t, err)
 
-		err = storage.SaveStatDaily(ctx, professionID, 150, today.Add(12*time.Hour))
+		err = storage.SaveStatDaily(ctx, professionID, testArea, 150, today.Add(12*time.Hour))
 		require.NoError(t, err)
 
-		err = storage.SaveStatDaily(ctx, professionID, 200, today.Add(16*time.Hour))
+		err = storage.SaveStatDaily(ctx, professionID, testArea, 200, today.Add(16*time.Hour))
 		require.NoError(t, err)
 
 		// Тест - DISTINCT ON должен вернуть одну запись за день (последнюю)
-		points, err := storage.GetStatDailyByProfessionID(ctx, professionID)
+		points, err := storage.GetStatDailyByProfessionID(ctx, professionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -336,21 +356,21 @@ func TestStatDailyRepository(t *testing.T) {
 		baseDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 
 		// Сохраняем записи для первой профессии
-		err := storage.SaveStatDaily(ctx, professionID1, 100, baseDate.AddDate(0, 0, -1))
+		err := storage.SaveStatDaily(ctx, professionID1, testArea, 100, baseDate.AddDate(0, 0, -1))
 		require.NoError(t, err)
 
-		err = storage.SaveStatDaily(ctx, professionID1, 150, baseDate)
+		err = storage.SaveStatDaily(ctx, professionID1, testArea, 150, baseDate)
 		require.NoError(t, err)
 
 		// Сохраняем записи для второй профессии
-		err = storage.SaveStatDaily(ctx, professionID2, 200, baseDate.AddDate(0, 0, -1))
+		err = storage.SaveStatDaily(ctx, professionID2, testArea, 200, baseDate.AddDate(0, 0, -1))
 		require.NoError(t, err)
 
-		err = storage.SaveStatDaily(ctx, professionID2, 250, baseDate)
+		err = storage.SaveStatDaily(ctx, professionID2, testArea, 250, baseDate)
 		require.NoError(t, err)
 
 		// Тест
-		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{professionID1, professionID2})
+		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{professionID1, professionID2}, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -395,14 +415,14 @@ func TestStatDailyRepository(t *testing.T) {
 		professionID := createProfessionForStatDaily(ctx, t, storage, "Go Developer GetStatDailyByProfessionIDs_SingleProfession", "go developer 8", true)
 
 		baseDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
-		err := storage.SaveStatDaily(ctx, professionID, 100, baseDate.AddDate(0, 0, -1))
+		err := storage.SaveStatDaily(ctx, professionID, testArea, 100, baseDate.AddDate(0, 0, -1))
 		require.NoError(t, err)
 
-		err = storage.SaveStatDaily(ctx, professionID, 150, baseDate)
+		err = storage.SaveStatDaily(ctx, professionID, testArea, 150, baseDate)
 		require.NoError(t, err)
 
 		// Тест
-		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{professionID})
+		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{professionID}, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -419,7 +439,7 @@ func TestStatDailyRepository(t *testing.T) {
 		professionID := createProfessionForStatDaily(ctx, t, storage, "Go Developer GetStatDailyByProfessionIDs_Empty", "go developer 9", true)
 
 		// Тест (нет записей)
-		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{professionID})
+		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{professionID}, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -432,7 +452,7 @@ func TestStatDailyRepository(t *testing.T) {
 		})
 
 		// Тест - пустой список professionIDs
-		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{})
+		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{}, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -456,12 +476,12 @@ func TestStatDailyRepository(t *testing.T) {
 
 		// Сохраняем записи
 		for i, date := range dates {
-			err := storage.SaveStatDaily(ctx, professionID, counts[i], date)
+			err := storage.SaveStatDaily(ctx, professionID, testArea, counts[i], date)
 			require.NoError(t, err)
 		}
 
 		// Тест
-		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{professionID})
+		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{professionID}, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -489,11 +509,11 @@ func TestStatDailyRepository(t *testing.T) {
 		nonExistingProfessionID := uuid.New()
 
 		baseDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
-		err := storage.SaveStatDaily(ctx, existingProfessionID, 100, baseDate)
+		err := storage.SaveStatDaily(ctx, existingProfessionID, testArea, 100, baseDate)
 		require.NoError(t, err)
 
 		// Тест - передаём существующий и несуществующий ID
-		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{existingProfessionID, nonExistingProfessionID})
+		result, err := storage.GetStatDailyByProfessionIDs(ctx, []uuid.UUID{existingProfessionID, nonExistingProfessionID}, testArea)
 
 		// Assert - должны вернуться только данные для существующей профессии
 		require.NoError(t, err)
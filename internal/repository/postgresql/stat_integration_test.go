@@ -98,8 +98,8 @@ func createScrapingSessionForStat(ctx context.Context, t *testing.T, storage *po
 
 	id := uuid.New()
 	_, err := storage.Pool.Exec(ctx, `
-		INSERT INTO scraping (id, scraped_at)
-		VALUES ($1, $2)
+		INSERT INTO scraping (id, scraped_at, status)
+		VALUES ($1, $2, 'complete')
 	`, id, scrapedAt)
 	require.NoError(t, err)
 
@@ -119,7 +119,7 @@ func TestStatRepository(t *testing.T) {
 		vacancyCount := 150
 
 		// Тест
-		err := storage.SaveStat(ctx, sessionID, professionID, vacancyCount)
+		err := storage.SaveStat(ctx, sessionID, professionID, testArea, vacancyCount)
 
 		// Assert
 		require.NoError(t, err)
@@ -133,10 +133,10 @@ func TestStatRepository(t *testing.T) {
 		sessionID2 := createScrapingSessionForStat(ctx, t, storage, time.Now())
 
 		// Тест - сохраняем несколько записей
-		err := storage.SaveStat(ctx, sessionID1, professionID, 100)
+		err := storage.SaveStat(ctx, sessionID1, professionID, testArea, 100)
 		require.NoError(t, err)
 
-		err = storage.SaveStat(ctx, sessionID2, professionID, 150)
+		err = storage.SaveStat(ctx, sessionID2, professionID, testArea, 150)
 		require.NoError(t, err)
 	})
 
@@ -147,7 +147,7 @@ func TestStatRepository(t *testing.T) {
 		fakeProfessionID := uuid.New()
 
 		// Тест - нарушение FK (профессия не существует)
-		err := storage.SaveStat(ctx, sessionID, fakeProfessionID, 100)
+		err := storage.SaveStat(ctx, sessionID, fakeProfessionID, testArea, 100)
 
 		// Assert
 		require.Error(t, err)
@@ -160,24 +160,24 @@ func TestStatRepository(t *testing.T) {
 		fakeSessionID := uuid.New()
 
 		// Тест - нарушение FK (сессия не существует)
-		err := storage.SaveStat(ctx, fakeSessionID, professionID, 100)
+		err := storage.SaveStat(ctx, fakeSessionID, professionID, testArea, 100)
 
 		// Assert
 		require.Error(t, err)
 	})
 
-	t.Run("GetLatestStatByProfessionID_Success", func(t *testing.T) {
+	t.Run("GetStatByProfessionAndSession_Success", func(t *testing.T) {
 		cleanStatAndRelatedTables(ctx, t, storage)
 
 		professionID := createProfessionForStat(ctx, t, storage, "Go Developer #1", "go developer 1", true)
 		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
 
 		// Сохраняем запись
-		err := storage.SaveStat(ctx, sessionID, professionID, 150)
+		err := storage.SaveStat(ctx, sessionID, professionID, testArea, 150)
 		require.NoError(t, err)
 
-		// Тест - получаем последнюю запись
-		stat, err := storage.GetLatestStatByProfessionID(ctx, professionID)
+		// Тест
+		stat, err := storage.GetStatByProfessionAndSession(ctx, professionID, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -186,39 +186,42 @@ func TestStatRepository(t *testing.T) {
 		require.Equal(t, int32(150), stat.VacancyCount)
 	})
 
-	t.Run("GetLatestStatByProfessionID_SingleStat", func(t *testing.T) {
+	t.Run("GetStatByProfessionAndSession_OtherSessions", func(t *testing.T) {
 		cleanStatAndRelatedTables(ctx, t, storage)
 
 		professionID := createProfessionForStat(ctx, t, storage, "Go Developer #2", "go developer 2", true)
-		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
+		oldSessionID := createScrapingSessionForStat(ctx, t, storage, time.Now().Add(-48*time.Hour))
+		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now().Add(-24*time.Hour))
+		newSessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
 
-		err := storage.SaveStat(ctx, sessionID, professionID, 200)
-		require.NoError(t, err)
+		require.NoError(t, storage.SaveStat(ctx, oldSessionID, professionID, testArea, 100))
+		require.NoError(t, storage.SaveStat(ctx, sessionID, professionID, testArea, 200))
+		require.NoError(t, storage.SaveStat(ctx, newSessionID, professionID, testArea, 300))
 
-		// Тест
-		stat, err := storage.GetLatestStatByProfessionID(ctx, professionID)
+		// Тест - данные берутся из запрошенной сессии, а не из самой новой
+		stat, err := storage.GetStatByProfessionAndSession(ctx, professionID, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
-		require.Equal(t, professionID, stat.ProfessionID)
 		require.Equal(t, int32(200), stat.VacancyCount)
 		require.Equal(t, sessionID, stat.ScrapedAtID)
 	})
 
-	t.Run("GetLatestStatByProfessionID_NotFound", func(t *testing.T) {
+	t.Run("GetStatByProfessionAndSession_NotFound", func(t *testing.T) {
 		cleanStatAndRelatedTables(ctx, t, storage)
 
 		professionID := createProfessionForStat(ctx, t, storage, "Go Developer #3", "go developer 3", true)
+		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
 
 		// Тест (нет записей для профессии)
-		stat, err := storage.GetLatestStatByProfessionID(ctx, professionID)
+		stat, err := storage.GetStatByProfessionAndSession(ctx, professionID, sessionID, testArea)
 
 		// Assert
 		require.Error(t, err)
 		require.Empty(t, stat)
 	})
 
-	t.Run("GetLatestStatByProfessionID_MultipleProfessions", func(t *testing.T) {
+	t.Run("GetStatByProfessionAndSession_MultipleProfessions", func(t *testing.T) {
 		cleanStatAndRelatedTables(ctx, t, storage)
 
 		professionID1 := createProfessionForStat(ctx, t, storage, "Go Developer #4", "go developer 4", true)
@@ -226,14 +229,14 @@ func TestStatRepository(t *testing.T) {
 		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
 
 		// Сохраняем записи для двух профессий
-		err := storage.SaveStat(ctx, sessionID, professionID1, 100)
+		err := storage.SaveStat(ctx, sessionID, professionID1, testArea, 100)
 		require.NoError(t, err)
 
-		err = storage.SaveStat(ctx, sessionID, professionID2, 200)
+		err = storage.SaveStat(ctx, sessionID, professionID2, testArea, 200)
 		require.NoError(t, err)
 
-		// Тест - получаем последнюю запись для первой профессии
-		stat, err := storage.GetLatestStatByProfessionID(ctx, professionID1)
+		// Тест - получаем запись первой профессии
+		stat, err := storage.GetStatByProfessionAndSession(ctx, professionID1, sessionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -241,122 +244,53 @@ func TestStatRepository(t *testing.T) {
 		require.Equal(t, int32(100), stat.VacancyCount)
 	})
 
-	t.Run("GetStatsByProfessionsAndDateRange_Success", func(t *testing.T) {
-		cleanStatAndRelatedTables(ctx, t, storage)
-
-		professionID1 := createProfessionForStat(ctx, t, storage, "Go Developer #5", "go developer 5", true)
-		professionID2 := createProfessionForStat(ctx, t, storage, "Python Developer #5", "python developer 5", true)
-
-		now := time.Now()
-		sessionID1 := createScrapingSessionForStat(ctx, t, storage, now.Add(-48*time.Hour))
-		sessionID2 := createScrapingSessionForStat(ctx, t, storage, now.Add(-24*time.Hour))
-		sessionID3 := createScrapingSessionForStat(ctx, t, storage, now)
-
-		// Сохраняем записи
-		err := storage.SaveStat(ctx, sessionID1, professionID1, 100)
-		require.NoError(t, err)
-
-		err = storage.SaveStat(ctx, sessionID2, professionID1, 150)
-		require.NoError(t, err)
-
-		err = storage.SaveStat(ctx, sessionID3, professionID2, 200)
-		require.NoError(t, err)
-
-		// Тест - получаем записи за последние 3 дня с запасом
-		startDate := now.Add(-72 * time.Hour).Format(time.RFC3339)
-		endDate := now.Add(1 * time.Hour).Format(time.RFC3339)
-
-		stats, err := storage.GetStatsByProfessionsAndDateRange(ctx, []uuid.UUID{professionID1, professionID2}, startDate, endDate)
-
-		// Assert - ожидаем 3 записи (2 для professionID1 + 1 для professionID2)
-		require.NoError(t, err)
-		require.Len(t, stats, 3)
-
-		// Проверяем что все записи относятся к нашим профессиям
-		for _, stat := range stats {
-			require.Contains(t, []uuid.UUID{professionID1, professionID2}, stat.ProfessionID)
-		}
-	})
-
-	t.Run("GetStatsByProfessionsAndDateRange_SingleProfession", func(t *testing.T) {
+	t.Run("GetStatByProfessionAndSession_FiltersByArea", func(t *testing.T) {
 		cleanStatAndRelatedTables(ctx, t, storage)
 
-		professionID := createProfessionForStat(ctx, t, storage, "Go Developer #6", "go developer 6", true)
-
-		now := time.Now()
-		sessionID1 := createScrapingSessionForStat(ctx, t, storage, now.Add(-24*time.Hour))
-		sessionID2 := createScrapingSessionForStat(ctx, t, storage, now)
-
-		err := storage.SaveStat(ctx, sessionID1, professionID, 100)
-		require.NoError(t, err)
-
-		err = storage.SaveStat(ctx, sessionID2, professionID, 150)
-		require.NoError(t, err)
-
-		// Тест - расширяем диапазон чтобы точно попасть
-		startDate := now.Add(-48 * time.Hour).Format(time.RFC3339)
-		endDate := now.Add(1 * time.Hour).Format(time.RFC3339)
-
-		stats, err := storage.GetStatsByProfessionsAndDateRange(ctx, []uuid.UUID{professionID}, startDate, endDate)
-
-		// Assert
-		require.NoError(t, err)
-		require.GreaterOrEqual(t, len(stats), 1)
-		require.Equal(t, professionID, stats[0].ProfessionID)
-	})
-
-	t.Run("GetStatsByProfessionsAndDateRange_Empty", func(t *testing.T) {
-		cleanStatAndRelatedTables(ctx, t, storage)
-
-		professionID := createProfessionForStat(ctx, t, storage, "Go Developer #7", "go developer 7", true)
+		professionID := createProfessionForStat(ctx, t, storage, "Go Developer #area", "go developer area", true)
+		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
 
-		// Тест (нет записей)
-		startDate := time.Now().Add(-24 * time.Hour).Format(time.RFC3339)
-		endDate := time.Now().Format(time.RFC3339)
+		require.NoError(t, storage.SaveStat(ctx, sessionID, professionID, testArea, 1000))
+		require.NoError(t, storage.SaveStat(ctx, sessionID, professionID, "1", 400))
 
-		stats, err := storage.GetStatsByProfessionsAndDateRange(ctx, []uuid.UUID{professionID}, startDate, endDate)
+		// Тест - Москва (1) хранится отдельно от всей России (113)
+		stat, err := storage.GetStatByProfessionAndSession(ctx, professionID, sessionID, "1")
 
 		// Assert
 		require.NoError(t, err)
-		require.Empty(t, stats)
-	})
-
-	t.Run("GetStatsByProfessionsAndDateRange_InvalidDateRange", func(t *testing.T) {
-		cleanStatAndRelatedTables(ctx, t, storage)
+		require.Equal(t, int32(400), stat.VacancyCount)
 
-		professionID := createProfessionForStat(ctx, t, storage, "Go Developer #8", "go developer 8", true)
-		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
-
-		err := storage.SaveStat(ctx, sessionID, professionID, 100)
-		require.NoError(t, err)
-
-		// Тест - некорректный формат даты
-		stats, err := storage.GetStatsByProfessionsAndDateRange(ctx, []uuid.UUID{professionID}, "invalid-date", "2026-01-01")
-
-		// Assert
+		_, err = storage.GetStatByProfessionAndSession(ctx, professionID, sessionID, "2")
 		require.Error(t, err)
-		require.Empty(t, stats)
 	})
 
-	t.Run("GetStatsByProfessionsAndDateRange_EmptyProfessionIDs", func(t *testing.T) {
+	t.Run("GetStatsByProfessionsAndSession_Success", func(t *testing.T) {
 		cleanStatAndRelatedTables(ctx, t, storage)
 
+		professionID1 := createProfessionForStat(ctx, t, storage, "Go Developer #5", "go developer 5", true)
+		professionID2 := createProfessionForStat(ctx, t, storage, "Python Developer #5", "python developer 5", true)
+		otherProfessionID := createProfessionForStat(ctx, t, storage, "Java Developer #5", "java developer 5", true)
+
 		now := time.Now()
 		sessionID := createScrapingSessionForStat(ctx, t, storage, now)
-		professionID := createProfessionForStat(ctx, t, storage, "Go Developer #11", "go developer 11", true)
+		otherSessionID := createScrapingSessionForStat(ctx, t, storage, now.Add(-24*time.Hour))
 
-		err := storage.SaveStat(ctx, sessionID, professionID, 100)
-		require.NoError(t, err)
-
-		// Тест - пустой список professionIDs
-		startDate := now.Add(-24 * time.Hour).Format(time.RFC3339)
-		endDate := now.Add(1 * time.Hour).Format(time.RFC3339)
+		require.NoError(t, storage.SaveStat(ctx, sessionID, professionID1, testArea, 100))
+		require.NoError(t, storage.SaveStat(ctx, sessionID, professionID2, testArea, 200))
+		require.NoError(t, storage.SaveStat(ctx, sessionID, otherProfessionID, testArea, 300))
+		require.NoError(t, storage.SaveStat(ctx, otherSessionID, professionID1, testArea, 150))
+		require.NoError(t, storage.SaveStat(ctx, sessionID, professionID1, "2", 50))
 
-		stats, err := storage.GetStatsByProfessionsAndDateRange(ctx, []uuid.UUID{}, startDate, endDate)
+		// Тест
+		stats, err := storage.GetStatsByProfessionsAndSession(ctx, []uuid.UUID{professionID1, professionID2}, sessionID, testArea)
 
-		// Assert
+		// Assert - только записи запрошенных профессий, сессии и региона
 		require.NoError(t, err)
-		require.Empty(t, stats)
+		require.Len(t, stats, 2)
+		for _, stat := range stats {
+			require.Equal(t, sessionID, stat.ScrapedAtID)
+			require.Contains(t, []uuid.UUID{professionID1, professionID2}, stat.ProfessionID)
+		}
 	})
 
 	t.Run("SaveStat_ZeroVacancyCount", func(t *testing.T) {
@@ -366,7 +300,7 @@ func TestStatRepository(t *testing.T) {
 		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
 
 		// Тест - сохраняем с нулевым количеством вакансий
-		err := storage.SaveStat(ctx, sessionID, professionID, 0)
+		err := storage.SaveStat(ctx, sessionID, professionID, testArea, 0)
 
 		// Assert
 		require.NoError(t, err)
@@ -379,7 +313,7 @@ func TestStatRepository(t *testing.T) {
 		sessionID := createScrapingSessionForStat(ctx, t, storage, time.Now())
 
 		// Тест - сохраняем с большим количеством вакансий
-		err := storage.SaveStat(ctx, sessionID, professionID, 1000000)
+		err := storage.SaveStat(ctx, sessionID, professionID, testArea, 1000000)
 
 		// Assert
 		require.NoError(t, err)
@@ -0,0 +1,82 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+func (s *Storage) SaveSalaryStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, stat domain.SalaryStat) error {
+	const op = "repository.postgresql.stat_salary.SaveSalaryStat"
+
+	err := s.queries(ctx).InsertSalaryStat(ctx, postgresql.InsertSalaryStatParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		SampleSize:   stat.SampleSize,
+		P25:          stat.P25,
+		Median:       stat.Median,
+		P75:          stat.P75,
+		Area:         area,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetSalaryStatByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.SalaryStat, error) {
+	const op = "repository.postgresql.stat_salary.GetSalaryStatByProfessionAndSession"
+
+	row, err := s.Queries.GetSalaryStatByProfessionAndSession(ctx, postgresql.GetSalaryStatByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.SalaryStat{}, domain.ErrSalaryStatNotFound
+		}
+		return domain.SalaryStat{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return domain.SalaryStat{
+		SampleSize: row.SampleSize,
+		P25:        row.P25,
+		Median:     row.Median,
+		P75:        row.P75,
+	}, nil
+}
+
+func (s *Storage) GetSalaryStatsByProfessionID(ctx context.Context, professionID uuid.UUID, area string) ([]domain.SalaryTrendPoint, error) {
+	const op = "repository.postgresql.stat_salary.GetSalaryStatsByProfessionID"
+
+	rows, err := s.Queries.GetSalaryStatsByProfessionID(ctx, postgresql.GetSalaryStatsByProfessionIDParams{
+		ProfessionID: professionID,
+		Area:         area,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	points := make([]domain.SalaryTrendPoint, len(rows))
+	for i, row := range rows {
+		points[i] = domain.SalaryTrendPoint{
+			ScrapedAt: row.ScrapedAt,
+			SalaryStat: domain.SalaryStat{
+				SampleSize: row.SampleSize,
+				P25:        row.P25,
+				Median:     row.Median,
+				P75:        row.P75,
+			},
+		}
+	}
+
+	return points, nil
+}
@@ -0,0 +1,117 @@
+//go:build integration
+
+// Интеграционные тесты для stat_salary репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func cleanSalaryStatTables(ctx context.Context, t *testing.T, storage *postgresql.Storage) {
+	t.Helper()
+	_, err := storage.Pool.Exec(ctx, `TRUNCATE stat_salary, scraping, profession RESTART IDENTITY CASCADE`)
+	require.NoError(t, err)
+}
+
+func TestSalaryStatRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	t.Run("SaveAndGetSalaryStat_Success", func(t *testing.T) {
+		cleanSalaryStatTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+		stat := domain.SalaryStat{SampleSize: 40, P25: 150000, Median: 200000, P75: 260000}
+
+		// Тест
+		err := storage.SaveSalaryStat(ctx, sessionID, professionID, testArea, stat)
+
+		// Assert
+		require.NoError(t, err)
+
+		result, err := storage.GetSalaryStatByProfessionAndSession(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Equal(t, stat, result)
+	})
+
+	t.Run("GetSalaryStatByProfessionAndSession_NotFound", func(t *testing.T) {
+		cleanSalaryStatTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		// Тест
+		_, err := storage.GetSalaryStatByProfessionAndSession(ctx, professionID, sessionID, testArea)
+
+		// Assert
+		require.ErrorIs(t, err, domain.ErrSalaryStatNotFound)
+	})
+
+	t.Run("GetSalaryStatsByProfessionID_OrderedByScrapedAt", func(t *testing.T) {
+		cleanSalaryStatTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		otherProfessionID := createProfession(ctx, t, storage, "Java Developer", "java developer", true)
+
+		older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		newer := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+		newerSessionID := createScrapingSessionSkill(ctx, t, storage, newer)
+		olderSessionID := createScrapingSessionSkill(ctx, t, storage, older)
+
+		require.NoError(t, storage.SaveSalaryStat(ctx, newerSessionID, professionID, testArea, domain.SalaryStat{SampleSize: 2, Median: 220000}))
+		require.NoError(t, storage.SaveSalaryStat(ctx, olderSessionID, professionID, testArea, domain.SalaryStat{SampleSize: 1, Median: 200000}))
+		require.NoError(t, storage.SaveSalaryStat(ctx, newerSessionID, otherProfessionID, testArea, domain.SalaryStat{SampleSize: 3, Median: 1}))
+
+		// Тест
+		points, err := storage.GetSalaryStatsByProfessionID(ctx, professionID, testArea)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, points, 2)
+		require.True(t, older.Equal(points[0].ScrapedAt))
+		require.Equal(t, int32(200000), points[0].Median)
+		require.True(t, newer.Equal(points[1].ScrapedAt))
+		require.Equal(t, int32(220000), points[1].Median)
+	})
+
+	t.Run("GetSalaryStatsByProfessionID_SkipsIncompleteSessions", func(t *testing.T) {
+		cleanSalaryStatTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		completeAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, completeAt)
+		runningID := createScrapingSessionSkill(ctx, t, storage, completeAt.AddDate(0, 1, 0))
+		require.NoError(t, storage.SetScrapingStatus(ctx, runningID, domain.ScrapingStatusRunning))
+
+		require.NoError(t, storage.SaveSalaryStat(ctx, sessionID, professionID, testArea, domain.SalaryStat{SampleSize: 2, Median: 220000}))
+		require.NoError(t, storage.SaveSalaryStat(ctx, runningID, professionID, testArea, domain.SalaryStat{SampleSize: 1, Median: 1}))
+
+		// Тест
+		points, err := storage.GetSalaryStatsByProfessionID(ctx, professionID, testArea)
+
+		// Assert - незавершённая сессия не даёт точки тренда
+		require.NoError(t, err)
+		require.Len(t, points, 1)
+		require.True(t, completeAt.Equal(points[0].ScrapedAt))
+	})
+
+	t.Run("GetSalaryStatsByProfessionID_Empty", func(t *testing.T) {
+		cleanSalaryStatTables(ctx, t, storage)
+
+		// Тест
+		points, err := storage.GetSalaryStatsByProfessionID(ctx, uuid.New(), testArea)
+
+		// Assert
+		require.NoError(t, err)
+		require.Empty(t, points)
+	})
+}
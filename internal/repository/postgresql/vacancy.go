@@ -0,0 +1,199 @@
+package postgresql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"psa/internal/domain"
+	postgresql "psa/internal/repository/postgresql/generated"
+)
+
+// SaveVacancies stores raw vacancies of the session. Vacancies without ID and repeated IDs of the same source are skipped.
+// Vacancies without a source are stored as hh.ru ones.
+func (s *Storage) SaveVacancies(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, vacancies []domain.VacancyData) error {
+	const op = "repository.postgresql.vacancy.SaveVacancies"
+
+	type vacancyKey struct{ source, id string }
+
+	seen := make(map[vacancyKey]struct{}, len(vacancies))
+	params := make([]postgresql.InsertVacanciesParams, 0, len(vacancies))
+	for _, v := range vacancies {
+		if v.ID == "" {
+			continue
+		}
+		source := v.Source
+		if source == "" {
+			source = domain.SourceHH
+		}
+
+		key := vacancyKey{source: source, id: v.ID}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		skills := v.Skills
+		if skills == nil {
+			skills = []string{}
+		}
+
+		param := postgresql.InsertVacanciesParams{
+			ExternalID:      v.ID,
+			ProfessionID:    professionID,
+			ScrapedAtID:     sessionID,
+			Description:     v.Description,
+			DescriptionHash: descriptionHash(v.Description),
+			KeySkills:       skills,
+			PublishedAt:     pgtype.Timestamptz{Time: v.PublishedAt, Valid: !v.PublishedAt.IsZero()},
+			Experience:      optionalText(v.Experience),
+			Employment:      optionalText(v.Employment),
+			Schedule:        optionalText(v.Schedule),
+			Area:            area,
+			Source:          source,
+		}
+		if v.Salary != nil {
+			param.SalaryFrom = optionalInt4(v.Salary.From)
+			param.SalaryTo = optionalInt4(v.Salary.To)
+			param.SalaryCurrency = optionalText(v.Salary.Currency)
+			param.SalaryGross = pgtype.Bool{Bool: v.Salary.Gross, Valid: true}
+			param.SalaryRub = optionalInt4(v.Salary.RUB)
+		}
+
+		params = append(params, param)
+	}
+
+	if _, err := s.queries(ctx).InsertVacancies(ctx, params); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetVacanciesByProfessionAndSession(
+	ctx context.Context,
+	professionID uuid.UUID,
+	sessionID uuid.UUID,
+	area string,
+	limit, offset int,
+) ([]domain.Vacancy, error) {
+	const op = "repository.postgresql.vacancy.GetVacanciesByProfessionAndSession"
+
+	rows, err := s.Queries.GetVacanciesByProfessionAndSession(ctx, postgresql.GetVacanciesByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+		Limit:        int32(limit),
+		Offset:       int32(offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vacancies := make([]domain.Vacancy, len(rows))
+	for i, row := range rows {
+		vacancies[i] = domain.Vacancy{
+			ID:              row.ExternalID,
+			Source:          row.Source,
+			Description:     row.Description,
+			DescriptionHash: row.DescriptionHash,
+			KeySkills:       row.KeySkills,
+			PublishedAt:     row.PublishedAt.Time,
+		}
+	}
+
+	return vacancies, nil
+}
+
+func (s *Storage) CountVacanciesByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (int64, error) {
+	const op = "repository.postgresql.vacancy.CountVacanciesByProfessionAndSession"
+
+	count, err := s.Queries.CountVacanciesByProfessionAndSession(ctx, postgresql.CountVacanciesByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// GetAllVacanciesByProfessionAndSession returns all stored vacancies of the profession in the session and area
+// in the form produced by vacancy sources.
+func (s *Storage) GetAllVacanciesByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) ([]domain.VacancyData, error) {
+	const op = "repository.postgresql.vacancy.GetAllVacanciesByProfessionAndSession"
+
+	rows, err := s.Queries.GetAllVacanciesByProfessionAndSession(ctx, postgresql.GetAllVacanciesByProfessionAndSessionParams{
+		ProfessionID: professionID,
+		ScrapedAtID:  sessionID,
+		Area:         area,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vacancies := make([]domain.VacancyData, len(rows))
+	for i, row := range rows {
+		vacancies[i] = domain.VacancyData{
+			ID:          row.ExternalID,
+			Skills:      row.KeySkills,
+			Description: row.Description,
+			PublishedAt: row.PublishedAt.Time,
+			Experience:  row.Experience.String,
+			Employment:  row.Employment.String,
+			Schedule:    row.Schedule.String,
+			Source:      row.Source,
+		}
+		if row.SalaryGross.Valid {
+			vacancies[i].Salary = &domain.Salary{
+				From:     int(row.SalaryFrom.Int32),
+				To:       int(row.SalaryTo.Int32),
+				Currency: row.SalaryCurrency.String,
+				Gross:    row.SalaryGross.Bool,
+				RUB:      int(row.SalaryRub.Int32),
+			}
+		}
+	}
+
+	return vacancies, nil
+}
+
+// GetVacancyProfessionAreasBySession returns every profession and area that has stored vacancies in the session.
+func (s *Storage) GetVacancyProfessionAreasBySession(ctx context.Context, sessionID uuid.UUID) ([]domain.ProfessionArea, error) {
+	const op = "repository.postgresql.vacancy.GetVacancyProfessionAreasBySession"
+
+	rows, err := s.Queries.GetVacancyProfessionAreasBySession(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]domain.ProfessionArea, len(rows))
+	for i, row := range rows {
+		result[i] = domain.ProfessionArea{
+			ProfessionID: row.ProfessionID,
+			Area:         row.Area,
+		}
+	}
+
+	return result, nil
+}
+
+// optionalInt4 stores zero as NULL, matching the "not set" meaning of zero in domain.Salary.
+func optionalInt4(v int) pgtype.Int4 {
+	return pgtype.Int4{Int32: int32(v), Valid: v != 0}
+}
+
+func optionalText(v string) pgtype.Text {
+	return pgtype.Text{String: v, Valid: v != ""}
+}
+
+func descriptionHash(description string) string {
+	sum := sha256.Sum256([]byte(description))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,209 @@
+//go:build integration
+
+// Интеграционные тесты для vacancy репозитория.
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/repository/postgresql"
+)
+
+func cleanVacancyTables(ctx context.Context, t *testing.T, storage *postgresql.Storage) {
+	t.Helper()
+	_, err := storage.Pool.Exec(ctx, `
+		TRUNCATE vacancy, scraping, profession RESTART IDENTITY CASCADE
+	`)
+	require.NoError(t, err)
+}
+
+func TestVacancyRepository(t *testing.T) {
+	storage := setupTestDBSkill(t)
+	ctx := context.Background()
+
+	t.Run("SaveVacancies_Success", func(t *testing.T) {
+		cleanVacancyTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+		publishedAt := time.Date(2025, 1, 15, 7, 30, 0, 0, time.UTC)
+
+		vacancies := []domain.VacancyData{
+			{ID: "102", Skills: []string{"golang", "sql"}, Description: "Go developer", PublishedAt: publishedAt},
+			{ID: "101", Skills: nil, Description: "Another"},
+		}
+
+		// Тест
+		err := storage.SaveVacancies(ctx, sessionID, professionID, testArea, vacancies)
+
+		// Assert - сортировка по ID вакансии
+		require.NoError(t, err)
+
+		result, err := storage.GetVacanciesByProfessionAndSession(ctx, professionID, sessionID, testArea, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Equal(t, "101", result[0].ID)
+		require.Empty(t, result[0].KeySkills)
+		require.True(t, result[0].PublishedAt.IsZero())
+		require.Equal(t, "102", result[1].ID)
+		require.Equal(t, []string{"golang", "sql"}, result[1].KeySkills)
+		require.True(t, publishedAt.Equal(result[1].PublishedAt))
+		require.Len(t, result[1].DescriptionHash, 64)
+	})
+
+	t.Run("SaveVacancies_SkipsDuplicatesAndEmptyIDs", func(t *testing.T) {
+		cleanVacancyTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		vacancies := []domain.VacancyData{
+			{ID: "101", Description: "First"},
+			{ID: "101", Description: "Duplicate"},
+			{ID: "", Description: "No id"},
+		}
+
+		// Тест
+		err := storage.SaveVacancies(ctx, sessionID, professionID, testArea, vacancies)
+
+		// Assert
+		require.NoError(t, err)
+
+		count, err := storage.CountVacanciesByProfessionAndSession(ctx, professionID, sessionID, testArea)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	})
+
+	t.Run("SaveVacancies_SameIDFromDifferentSourcesAndAreas", func(t *testing.T) {
+		cleanVacancyTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		vacancies := []domain.VacancyData{
+			{ID: "101", Description: "From hh"},
+			{ID: "101", Description: "From dump", Source: domain.SourceFile},
+		}
+
+		// Тест
+		err := storage.SaveVacancies(ctx, sessionID, professionID, testArea, vacancies)
+		require.NoError(t, err)
+		err = storage.SaveVacancies(ctx, sessionID, professionID, "1", vacancies[:1])
+		require.NoError(t, err)
+
+		// Assert - вакансия без источника сохраняется как hh, сортировка по источнику
+		result, err := storage.GetVacanciesByProfessionAndSession(ctx, professionID, sessionID, testArea, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Equal(t, domain.SourceFile, result[0].Source)
+		require.Equal(t, domain.SourceHH, result[1].Source)
+
+		all, err := storage.GetAllVacanciesByProfessionAndSession(ctx, professionID, sessionID, "1")
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		require.Equal(t, domain.SourceHH, all[0].Source)
+	})
+
+	t.Run("GetVacanciesByProfessionAndSession_Pagination", func(t *testing.T) {
+		cleanVacancyTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		require.NoError(t, storage.SaveVacancies(ctx, sessionID, professionID, testArea, []domain.VacancyData{
+			{ID: "1", Description: "a"},
+			{ID: "2", Description: "b"},
+			{ID: "3", Description: "c"},
+		}))
+
+		// Тест
+		result, err := storage.GetVacanciesByProfessionAndSession(ctx, professionID, sessionID, testArea, 2, 1)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Equal(t, "2", result[0].ID)
+		require.Equal(t, "3", result[1].ID)
+	})
+
+	t.Run("GetVacanciesByProfessionAndSession_OtherSession", func(t *testing.T) {
+		cleanVacancyTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+		otherSessionID := createScrapingSessionSkill(ctx, t, storage, time.Now().Add(-time.Hour))
+
+		require.NoError(t, storage.SaveVacancies(ctx, sessionID, professionID, testArea, []domain.VacancyData{
+			{ID: "1", Description: "a"},
+		}))
+
+		// Тест
+		result, err := storage.GetVacanciesByProfessionAndSession(ctx, professionID, otherSessionID, testArea, 10, 0)
+
+		// Assert
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("SaveVacancies_InvalidSession", func(t *testing.T) {
+		cleanVacancyTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+
+		// Тест
+		err := storage.SaveVacancies(ctx, professionID, professionID, testArea, []domain.VacancyData{
+			{ID: "1", Description: "a"},
+		})
+
+		// Assert - ожидаем ошибку из-за FK
+		require.Error(t, err)
+	})
+
+	t.Run("GetAllVacanciesByProfessionAndSession_Success", func(t *testing.T) {
+		cleanVacancyTables(ctx, t, storage)
+
+		professionID := createProfession(ctx, t, storage, "Go Developer", "go developer", true)
+		otherProfessionID := createProfession(ctx, t, storage, "Java Developer", "java developer", true)
+		sessionID := createScrapingSessionSkill(ctx, t, storage, time.Now())
+
+		salary := &domain.Salary{From: 1000, To: 3000, Currency: "USD", Gross: true, RUB: 200000}
+		require.NoError(t, storage.SaveVacancies(ctx, sessionID, professionID, testArea, []domain.VacancyData{
+			{ID: "2", Skills: []string{"golang"}, Description: "b", Salary: salary, Experience: "between3And6", Schedule: "remote"},
+			{ID: "1", Skills: []string{"sql"}, Description: "a"},
+		}))
+		require.NoError(t, storage.SaveVacancies(ctx, sessionID, otherProfessionID, testArea, []domain.VacancyData{
+			{ID: "3", Description: "c"},
+		}))
+		require.NoError(t, storage.SaveVacancies(ctx, sessionID, professionID, "1", []domain.VacancyData{
+			{ID: "1", Description: "a"},
+		}))
+
+		// Тест
+		result, err := storage.GetAllVacanciesByProfessionAndSession(ctx, professionID, sessionID, testArea)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Equal(t, "1", result[0].ID)
+		require.Equal(t, []string{"sql"}, result[0].Skills)
+		require.Equal(t, "a", result[0].Description)
+		require.Nil(t, result[0].Salary)
+		require.Equal(t, salary, result[1].Salary)
+		require.Equal(t, "between3And6", result[1].Experience)
+		require.Empty(t, result[1].Employment)
+		require.Equal(t, "remote", result[1].Schedule)
+
+		areas, err := storage.GetVacancyProfessionAreasBySession(ctx, sessionID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []domain.ProfessionArea{
+			{ProfessionID: professionID, Area: testArea},
+			{ProfessionID: professionID, Area: "1"},
+			{ProfessionID: otherProfessionID, Area: testArea},
+		}, areas)
+	})
+}
@@ -12,9 +12,14 @@ import (
 )
 
 const (
-	ProfessionSkillsKeyPrefix = "profession:%s:skills"
-	ProfessionTrendKeyPrefix  = "profession:%s:trend"
+	ProfessionSkillsKeyPrefix = "profession:%s:area:%s:skills"
+	ProfessionTrendKeyPrefix  = "profession:%s:area:%s:trend"
 	ProfessionListKey         = "profession:list"
+	VacancyCursorKeyPrefix    = "hh:cursor:%s"
+	VacancyBodyKeyPrefix      = "hh:vacancy:%s"
+
+	// VacancyTTL outlives an hh.ru vacancy, which stays published for 30 days unless it is republished
+	VacancyTTL = 31 * 24 * time.Hour
 )
 
 type Cache struct {
@@ -15,7 +15,7 @@ import (
 func (c *Cache) SaveProfessionData(ctx context.Context, data *domain.ProfessionDetail) error {
 	const op = "internal.repository.redis.skills.SaveProfessionData"
 
-	key := fmt.Sprintf(ProfessionSkillsKeyPrefix, data.ProfessionID.String())
+	key := fmt.Sprintf(ProfessionSkillsKeyPrefix, data.ProfessionID.String(), data.Area)
 
 	jsonData, err := json.Marshal(data)
 	if err != nil {
@@ -25,10 +25,10 @@ func (c *Cache) SaveProfessionData(ctx context.Context, data *domain.ProfessionD
 	return c.client.Set(ctx, key, jsonData, c.ttl).Err()
 }
 
-func (c *Cache) GetProfessionData(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionDetail, error) {
+func (c *Cache) GetProfessionData(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionDetail, error) {
 	const op = "internal.repository.redis.skills.GetProfessionData"
 
-	key := fmt.Sprintf(ProfessionSkillsKeyPrefix, professionID.String())
+	key := fmt.Sprintf(ProfessionSkillsKeyPrefix, professionID.String(), area)
 
 	data, err := c.client.Get(ctx, key).Bytes()
 	if err != nil {
@@ -19,6 +19,8 @@ import (
 	"psa/tests/containers"
 )
 
+const testArea = domain.DefaultArea
+
 // clientTestSkills returns the underlying redis client for testing purposes.
 // This method is only available in integration tests.
 func (c *Cache) clientTestSkills() *redis.Client {
@@ -61,7 +63,7 @@ func setupTestRedisSkills(t *testing.T) *Cache {
 
 func cleanSkillsCache(ctx context.Context, t *testing.T, cache *Cache, professionID uuid.UUID) {
 	t.Helper()
-	key := fmt.Sprintf(ProfessionSkillsKeyPrefix, professionID.String())
+	key := fmt.Sprintf(ProfessionSkillsKeyPrefix, professionID.String(), testArea)
 	err := cache.clientTestSkills().Del(ctx, key).Err()
 	require.NoError(t, err)
 }
@@ -70,6 +72,7 @@ func createProfessionDetail(professionID uuid.UUID, name string) *domain.Profess
 	return &domain.ProfessionDetail{
 		ProfessionID:   professionID,
 		ProfessionName: name,
+		Area:           testArea,
 		ScrapedAt:      "2023-01-01T10:00:00Z",
 		VacancyCount:   150,
 		FormalSkills: []domain.SkillResponse{
@@ -102,7 +105,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем что данные действительно сохранились
-		result, err := cache.GetProfessionData(ctx, professionID)
+		result, err := cache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -126,6 +129,23 @@ func TestSkillsCache(t *testing.T) {
 		require.Equal(t, int32(40), result.ExtractedSkills[1].Count)
 	})
 
+	t.Run("GetProfessionData_OtherArea", func(t *testing.T) {
+		professionID := uuid.New()
+		t.Cleanup(func() {
+			cleanSkillsCache(ctx, t, cache, professionID)
+		})
+
+		err := cache.SaveProfessionData(ctx, createProfessionDetail(professionID, "Go Developer"))
+		require.NoError(t, err)
+
+		// Тест - данные другого региона хранятся под своим ключом
+		result, err := cache.GetProfessionData(ctx, professionID, "1")
+
+		// Assert
+		require.NoError(t, err)
+		require.Nil(t, result)
+	})
+
 	t.Run("SaveProfessionData_EmptySkills", func(t *testing.T) {
 		professionID := uuid.New()
 		t.Cleanup(func() {
@@ -135,6 +155,7 @@ func TestSkillsCache(t *testing.T) {
 		data := &domain.ProfessionDetail{
 			ProfessionID:    professionID,
 			ProfessionName:  "Empty Skills Developer",
+			Area:            testArea,
 			ScrapedAt:       "2023-01-01T10:00:00Z",
 			VacancyCount:    50,
 			FormalSkills:    []domain.SkillResponse{},
@@ -148,7 +169,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем что данные сохранились с пустыми списками
-		result, err := cache.GetProfessionData(ctx, professionID)
+		result, err := cache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Empty(t, result.FormalSkills)
@@ -167,7 +188,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем
-		result1, err := cache.GetProfessionData(ctx, professionID)
+		result1, err := cache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Equal(t, "Go Developer v1", result1.ProfessionName)
 
@@ -180,7 +201,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем что данные перезаписались
-		result2, err := cache.GetProfessionData(ctx, professionID)
+		result2, err := cache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Equal(t, "Go Developer v2", result2.ProfessionName)
 		require.Equal(t, int32(300), result2.VacancyCount)
@@ -194,7 +215,7 @@ func TestSkillsCache(t *testing.T) {
 		})
 
 		// Тест (ключ не существует)
-		result, err := cache.GetProfessionData(ctx, professionID)
+		result, err := cache.GetProfessionData(ctx, professionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -214,7 +235,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем TTL ключа
-		key := fmt.Sprintf(ProfessionSkillsKeyPrefix, professionID.String())
+		key := fmt.Sprintf(ProfessionSkillsKeyPrefix, professionID.String(), testArea)
 		ttl, err := cache.clientTestSkills().TTL(ctx, key).Result()
 		require.NoError(t, err)
 
@@ -249,7 +270,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем что данные есть
-		result1, err := shortTTLCache.GetProfessionData(ctx, professionID)
+		result1, err := shortTTLCache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result1)
 
@@ -257,7 +278,7 @@ func TestSkillsCache(t *testing.T) {
 		time.Sleep(3 * time.Second)
 
 		// Проверяем что данные исчезли
-		result2, err := shortTTLCache.GetProfessionData(ctx, professionID)
+		result2, err := shortTTLCache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Nil(t, result2)
 	})
@@ -269,12 +290,12 @@ func TestSkillsCache(t *testing.T) {
 		})
 
 		// Записываем невалидный JSON напрямую в Redis
-		key := fmt.Sprintf(ProfessionSkillsKeyPrefix, professionID.String())
+		key := fmt.Sprintf(ProfessionSkillsKeyPrefix, professionID.String(), testArea)
 		err := cache.clientTestSkills().Set(ctx, key, "invalid-json", time.Hour).Err()
 		require.NoError(t, err)
 
 		// Тест
-		result, err := cache.GetProfessionData(ctx, professionID)
+		result, err := cache.GetProfessionData(ctx, professionID, testArea)
 
 		// Assert - должна быть ошибка парсинга
 		require.Error(t, err)
@@ -290,6 +311,7 @@ func TestSkillsCache(t *testing.T) {
 		data := &domain.ProfessionDetail{
 			ProfessionID:    professionID,
 			ProfessionName:  "Single Skill Developer",
+			Area:            testArea,
 			ScrapedAt:       "2023-01-01T10:00:00Z",
 			VacancyCount:    25,
 			FormalSkills:    []domain.SkillResponse{{Skill: "Go", Count: 1}},
@@ -303,7 +325,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем что данные действительно сохранились
-		result, err := cache.GetProfessionData(ctx, professionID)
+		result, err := cache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Len(t, result.FormalSkills, 1)
@@ -334,6 +356,7 @@ func TestSkillsCache(t *testing.T) {
 		data := &domain.ProfessionDetail{
 			ProfessionID:    professionID,
 			ProfessionName:  "Many Skills Developer",
+			Area:            testArea,
 			ScrapedAt:       "2023-01-01T10:00:00Z",
 			VacancyCount:    500,
 			FormalSkills:    formalSkills,
@@ -347,7 +370,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем что данные действительно сохранились
-		result, err := cache.GetProfessionData(ctx, professionID)
+		result, err := cache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Len(t, result.FormalSkills, 50)
@@ -364,6 +387,7 @@ func TestSkillsCache(t *testing.T) {
 		data := &domain.ProfessionDetail{
 			ProfessionID:   professionID,
 			ProfessionName: "Разработчик 🐍 (Python) <Senior>",
+			Area:           testArea,
 			ScrapedAt:      "2023-01-01T10:00:00Z",
 			VacancyCount:   100,
 			FormalSkills: []domain.SkillResponse{
@@ -384,7 +408,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем что данные сохранились корректно
-		result, err := cache.GetProfessionData(ctx, professionID)
+		result, err := cache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Equal(t, "Разработчик 🐍 (Python) <Senior>", result.ProfessionName)
@@ -405,6 +429,7 @@ func TestSkillsCache(t *testing.T) {
 		data := &domain.ProfessionDetail{
 			ProfessionID:   professionID,
 			ProfessionName: name,
+			Area:           testArea,
 			ScrapedAt:      scrapedAt,
 			VacancyCount:   vacancyCount,
 			FormalSkills: []domain.SkillResponse{
@@ -421,7 +446,7 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем все поля
-		result, err := cache.GetProfessionData(ctx, professionID)
+		result, err := cache.GetProfessionData(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -453,12 +478,12 @@ func TestSkillsCache(t *testing.T) {
 		require.NoError(t, err)
 
 		// Проверяем что данные не пересеклись
-		result1, err := cache.GetProfessionData(ctx, professionID1)
+		result1, err := cache.GetProfessionData(ctx, professionID1, testArea)
 		require.NoError(t, err)
 		require.Equal(t, "Go Developer", result1.ProfessionName)
 		require.Equal(t, int32(150), result1.VacancyCount)
 
-		result2, err := cache.GetProfessionData(ctx, professionID2)
+		result2, err := cache.GetProfessionData(ctx, professionID2, testArea)
 		require.NoError(t, err)
 		require.Equal(t, "Python Developer", result2.ProfessionName)
 		require.Equal(t, int32(200), result2.VacancyCount)
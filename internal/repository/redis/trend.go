@@ -12,10 +12,10 @@ import (
 	"psa/internal/domain"
 )
 
-func (c *Cache) SaveProfessionTrend(ctx context.Context, professionID uuid.UUID, trend *domain.ProfessionTrend) error {
+func (c *Cache) SaveProfessionTrend(ctx context.Context, professionID uuid.UUID, area string, trend *domain.ProfessionTrend) error {
 	const op = "internal.repository.redis.trend.SaveProfessionTrend"
 
-	key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String())
+	key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String(), area)
 
 	jsonData, err := json.Marshal(trend)
 	if err != nil {
@@ -25,10 +25,10 @@ func (c *Cache) SaveProfessionTrend(ctx context.Context, professionID uuid.UUID,
 	return c.client.Set(ctx, key, jsonData, c.ttl/6).Err()
 }
 
-func (c *Cache) GetProfessionTrend(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error) {
+func (c *Cache) GetProfessionTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error) {
 	const op = "internal.repository.redis.trend.GetProfessionTrend"
 
-	key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String())
+	key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String(), area)
 
 	data, err := c.client.Get(ctx, key).Bytes()
 	if err != nil {
@@ -61,7 +61,7 @@ func setupTestRedisTrend(t *testing.T) *Cache {
 
 func cleanTrendCache(ctx context.Context, t *testing.T, cache *Cache, professionID uuid.UUID) {
 	t.Helper()
-	key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String())
+	key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String(), testArea)
 	err := cache.clientTestTrend().Del(ctx, key).Err()
 	require.NoError(t, err)
 }
@@ -71,6 +71,7 @@ func createProfessionTrend(professionID uuid.UUID, name string) *domain.Professi
 	return &domain.ProfessionTrend{
 		ProfessionID:   professionID,
 		ProfessionName: name,
+		Area:           testArea,
 		Data: []domain.StatDailyPoint{
 			{Date: baseDate.AddDate(0, 0, -2), VacancyCount: 100},
 			{Date: baseDate.AddDate(0, 0, -1), VacancyCount: 120},
@@ -92,13 +93,13 @@ func TestTrendCache(t *testing.T) {
 		trend := createProfessionTrend(professionID, "Go Developer")
 
 		// Тест
-		err := cache.SaveProfessionTrend(ctx, professionID, trend)
+		err := cache.SaveProfessionTrend(ctx, professionID, testArea, trend)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Проверяем что данные действительно сохранились
-		result, err := cache.GetProfessionTrend(ctx, professionID)
+		result, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -122,17 +123,18 @@ func TestTrendCache(t *testing.T) {
 		trend := &domain.ProfessionTrend{
 			ProfessionID:   professionID,
 			ProfessionName: "Empty Trend Developer",
+			Area:           testArea,
 			Data:           []domain.StatDailyPoint{},
 		}
 
 		// Тест
-		err := cache.SaveProfessionTrend(ctx, professionID, trend)
+		err := cache.SaveProfessionTrend(ctx, professionID, testArea, trend)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Проверяем что данные сохранились с пустым списком
-		result, err := cache.GetProfessionTrend(ctx, professionID)
+		result, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Empty(t, result.Data)
@@ -146,11 +148,11 @@ func TestTrendCache(t *testing.T) {
 
 		// Сохраняем первые данные
 		trend1 := createProfessionTrend(professionID, "Go Developer v1")
-		err := cache.SaveProfessionTrend(ctx, professionID, trend1)
+		err := cache.SaveProfessionTrend(ctx, professionID, testArea, trend1)
 		require.NoError(t, err)
 
 		// Проверяем
-		result1, err := cache.GetProfessionTrend(ctx, professionID)
+		result1, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Equal(t, "Go Developer v1", result1.ProfessionName)
 
@@ -161,11 +163,11 @@ func TestTrendCache(t *testing.T) {
 			VacancyCount: 200,
 		})
 
-		err = cache.SaveProfessionTrend(ctx, professionID, trend2)
+		err = cache.SaveProfessionTrend(ctx, professionID, testArea, trend2)
 		require.NoError(t, err)
 
 		// Проверяем что данные перезаписались
-		result2, err := cache.GetProfessionTrend(ctx, professionID)
+		result2, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Equal(t, "Go Developer v2", result2.ProfessionName)
 		require.Len(t, result2.Data, 4)
@@ -178,7 +180,7 @@ func TestTrendCache(t *testing.T) {
 		})
 
 		// Тест (ключ не существует)
-		result, err := cache.GetProfessionTrend(ctx, professionID)
+		result, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 
 		// Assert
 		require.NoError(t, err)
@@ -194,11 +196,11 @@ func TestTrendCache(t *testing.T) {
 		trend := createProfessionTrend(professionID, "TTL Test Developer")
 
 		// Тест
-		err := cache.SaveProfessionTrend(ctx, professionID, trend)
+		err := cache.SaveProfessionTrend(ctx, professionID, testArea, trend)
 		require.NoError(t, err)
 
 		// Проверяем TTL ключа
-		key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String())
+		key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String(), testArea)
 		ttl, err := cache.clientTestTrend().TTL(ctx, key).Result()
 		require.NoError(t, err)
 
@@ -231,11 +233,11 @@ func TestTrendCache(t *testing.T) {
 		trend := createProfessionTrend(professionID, "Expiring Developer")
 
 		// Сохраняем
-		err = shortTTLCache.SaveProfessionTrend(ctx, professionID, trend)
+		err = shortTTLCache.SaveProfessionTrend(ctx, professionID, testArea, trend)
 		require.NoError(t, err)
 
 		// Проверяем что данные есть
-		result1, err := shortTTLCache.GetProfessionTrend(ctx, professionID)
+		result1, err := shortTTLCache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result1)
 
@@ -243,7 +245,7 @@ func TestTrendCache(t *testing.T) {
 		time.Sleep(2 * time.Second)
 
 		// Проверяем что данные исчезли
-		result2, err := shortTTLCache.GetProfessionTrend(ctx, professionID)
+		result2, err := shortTTLCache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.Nil(t, result2)
 	})
@@ -255,12 +257,12 @@ func TestTrendCache(t *testing.T) {
 		})
 
 		// Записываем невалидный JSON напрямую в Redis
-		key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String())
+		key := fmt.Sprintf(ProfessionTrendKeyPrefix, professionID.String(), testArea)
 		err := cache.clientTestTrend().Set(ctx, key, "invalid-json", time.Hour).Err()
 		require.NoError(t, err)
 
 		// Тест
-		result, err := cache.GetProfessionTrend(ctx, professionID)
+		result, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 
 		// Assert - должна быть ошибка парсинга
 		require.Error(t, err)
@@ -276,19 +278,20 @@ func TestTrendCache(t *testing.T) {
 		trend := &domain.ProfessionTrend{
 			ProfessionID:   professionID,
 			ProfessionName: "Single Point Developer",
+			Area:           testArea,
 			Data: []domain.StatDailyPoint{
 				{Date: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), VacancyCount: 50},
 			},
 		}
 
 		// Тест
-		err := cache.SaveProfessionTrend(ctx, professionID, trend)
+		err := cache.SaveProfessionTrend(ctx, professionID, testArea, trend)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Проверяем что данные действительно сохранились
-		result, err := cache.GetProfessionTrend(ctx, professionID)
+		result, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Len(t, result.Data, 1)
@@ -314,17 +317,18 @@ func TestTrendCache(t *testing.T) {
 		trend := &domain.ProfessionTrend{
 			ProfessionID:   professionID,
 			ProfessionName: "Many Data Points Developer",
+			Area:           testArea,
 			Data:           dataPoints,
 		}
 
 		// Тест
-		err := cache.SaveProfessionTrend(ctx, professionID, trend)
+		err := cache.SaveProfessionTrend(ctx, professionID, testArea, trend)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Проверяем что данные действительно сохранились
-		result, err := cache.GetProfessionTrend(ctx, professionID)
+		result, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Len(t, result.Data, 100)
@@ -345,6 +349,7 @@ func TestTrendCache(t *testing.T) {
 		trend := &domain.ProfessionTrend{
 			ProfessionID:   professionID,
 			ProfessionName: "Разработчик 🐍 (Python) <Senior>",
+			Area:           testArea,
 			Data: []domain.StatDailyPoint{
 				{Date: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), VacancyCount: 100},
 				{Date: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), VacancyCount: 120},
@@ -352,13 +357,13 @@ func TestTrendCache(t *testing.T) {
 		}
 
 		// Тест
-		err := cache.SaveProfessionTrend(ctx, professionID, trend)
+		err := cache.SaveProfessionTrend(ctx, professionID, testArea, trend)
 
 		// Assert
 		require.NoError(t, err)
 
 		// Проверяем что данные сохранились корректно
-		result, err := cache.GetProfessionTrend(ctx, professionID)
+		result, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Equal(t, "Разработчик 🐍 (Python) <Senior>", result.ProfessionName)
@@ -382,15 +387,16 @@ func TestTrendCache(t *testing.T) {
 		trend := &domain.ProfessionTrend{
 			ProfessionID:   professionID,
 			ProfessionName: name,
+			Area:           testArea,
 			Data:           dataPoints,
 		}
 
 		// Тест
-		err := cache.SaveProfessionTrend(ctx, professionID, trend)
+		err := cache.SaveProfessionTrend(ctx, professionID, testArea, trend)
 		require.NoError(t, err)
 
 		// Проверяем все поля
-		result, err := cache.GetProfessionTrend(ctx, professionID)
+		result, err := cache.GetProfessionTrend(ctx, professionID, testArea)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -421,19 +427,19 @@ func TestTrendCache(t *testing.T) {
 			VacancyCount: 200,
 		})
 
-		err := cache.SaveProfessionTrend(ctx, professionID1, trend1)
+		err := cache.SaveProfessionTrend(ctx, professionID1, testArea, trend1)
 		require.NoError(t, err)
 
-		err = cache.SaveProfessionTrend(ctx, professionID2, trend2)
+		err = cache.SaveProfessionTrend(ctx, professionID2, testArea, trend2)
 		require.NoError(t, err)
 
 		// Проверяем что данные не пересеклись
-		result1, err := cache.GetProfessionTrend(ctx, professionID1)
+		result1, err := cache.GetProfessionTrend(ctx, professionID1, testArea)
 		require.NoError(t, err)
 		require.Equal(t, "Go Developer", result1.ProfessionName)
 		require.Len(t, result1.Data, 3)
 
-		result2, err := cache.GetProfessionTrend(ctx, professionID2)
+		result2, err := cache.GetProfessionTrend(ctx, professionID2, testArea)
 		require.NoError(t, err)
 		require.Equal(t, "Python Developer", result2.ProfessionName)
 		require.Len(t, result2.Data, 4)
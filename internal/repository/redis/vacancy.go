@@ -0,0 +1,95 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"psa/internal/domain"
+)
+
+func (c *Cache) SaveVacancyCursor(ctx context.Context, key string, cursor domain.VacancyCursor) error {
+	const op = "internal.repository.redis.vacancy.SaveVacancyCursor"
+
+	jsonData, err := json.Marshal(cursor)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := c.client.Set(ctx, fmt.Sprintf(VacancyCursorKeyPrefix, key), jsonData, VacancyTTL).Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Cache) GetVacancyCursor(ctx context.Context, key string) (*domain.VacancyCursor, error) {
+	const op = "internal.repository.redis.vacancy.GetVacancyCursor"
+
+	data, err := c.client.Get(ctx, fmt.Sprintf(VacancyCursorKeyPrefix, key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var cursor domain.VacancyCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &cursor, nil
+}
+
+// SaveVacancyBodies stores raw vacancy bodies keyed by vacancy ID in a single round trip.
+func (c *Cache) SaveVacancyBodies(ctx context.Context, bodies map[string][]byte) error {
+	const op = "internal.repository.redis.vacancy.SaveVacancyBodies"
+
+	if len(bodies) == 0 {
+		return nil
+	}
+
+	pipe := c.client.Pipeline()
+	for id, body := range bodies {
+		pipe.Set(ctx, fmt.Sprintf(VacancyBodyKeyPrefix, id), body, VacancyTTL)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetVacancyBodies returns cached bodies of the vacancies; vacancies without a cached body are absent.
+func (c *Cache) GetVacancyBodies(ctx context.Context, ids []string) (map[string][]byte, error) {
+	const op = "internal.repository.redis.vacancy.GetVacancyBodies"
+
+	result := make(map[string][]byte, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = fmt.Sprintf(VacancyBodyKeyPrefix, id)
+	}
+
+	values, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i, value := range values {
+		if body, ok := value.(string); ok {
+			result[ids[i]] = []byte(body)
+		}
+	}
+
+	return result, nil
+}
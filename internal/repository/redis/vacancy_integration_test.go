@@ -0,0 +1,81 @@
+//go:build integration
+
+// Интеграционные тесты для redis кэша вакансий hh.
+// Каждый тест поднимает свой контейнер для полной изоляции.
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+)
+
+func TestVacancyCache(t *testing.T) {
+	ctx := context.Background()
+	cache := setupTestRedisTrend(t)
+
+	t.Run("GetVacancyCursor_NotFound", func(t *testing.T) {
+		// Тест
+		result, err := cache.GetVacancyCursor(ctx, "1:missing")
+
+		// Assert
+		require.NoError(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("SaveVacancyCursor_Success", func(t *testing.T) {
+		fetchedAt := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+		cursor := domain.VacancyCursor{
+			FetchedAt: fetchedAt,
+			Vacancies: map[string]time.Time{"1": fetchedAt.Add(-time.Hour)},
+		}
+
+		// Тест
+		err := cache.SaveVacancyCursor(ctx, "1:cursor", cursor)
+
+		// Assert
+		require.NoError(t, err)
+
+		result, err := cache.GetVacancyCursor(ctx, "1:cursor")
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.True(t, fetchedAt.Equal(result.FetchedAt))
+		require.Len(t, result.Vacancies, 1)
+		require.True(t, fetchedAt.Add(-time.Hour).Equal(result.Vacancies["1"]))
+
+		ttl, err := cache.clientTestTrend().TTL(ctx, "hh:cursor:1:cursor").Result()
+		require.NoError(t, err)
+		require.Greater(t, ttl, 30*24*time.Hour)
+	})
+
+	t.Run("SaveVacancyBodies_Success", func(t *testing.T) {
+		// Тест
+		err := cache.SaveVacancyBodies(ctx, map[string][]byte{
+			"10": []byte(`{"id":"10"}`),
+			"11": []byte(`{"id":"11"}`),
+		})
+
+		// Assert
+		require.NoError(t, err)
+
+		result, err := cache.GetVacancyBodies(ctx, []string{"10", "11", "12"})
+		require.NoError(t, err)
+		require.Equal(t, map[string][]byte{
+			"10": []byte(`{"id":"10"}`),
+			"11": []byte(`{"id":"11"}`),
+		}, result)
+	})
+
+	t.Run("GetVacancyBodies_Empty", func(t *testing.T) {
+		// Тест
+		result, err := cache.GetVacancyBodies(ctx, nil)
+
+		// Assert
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+}
@@ -0,0 +1,241 @@
+// Package alias maps variant spellings of skills to their canonical names, e.g. "golang" to "go".
+package alias
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+// cacheTTL bounds how long a process, e.g. the worker, keeps using aliases changed through the API of another one.
+const cacheTTL = 5 * time.Minute
+
+// AliasProvider stores the aliases. InTx runs fn in a transaction and LockSkillAliases serializes the changes
+// within it, so that an alias is validated against the stored ones and written atomically.
+type AliasProvider interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	LockSkillAliases(ctx context.Context) error
+	GetSkillAliases(ctx context.Context) ([]domain.SkillAlias, error)
+	AddSkillAlias(ctx context.Context, alias, skill string) (domain.SkillAlias, error)
+	UpdateSkillAlias(ctx context.Context, id uuid.UUID, alias, skill string) (domain.SkillAlias, error)
+	DeleteSkillAlias(ctx context.Context, id uuid.UUID) error
+}
+
+// Dictionary keeps the skill aliases in memory. The cache is reloaded after every change made through
+// the dictionary and once it is older than cacheTTL.
+type Dictionary struct {
+	aliasProvider AliasProvider
+
+	mu       sync.RWMutex
+	aliases  map[string]string
+	loadedAt time.Time
+}
+
+func New(aliasProvider AliasProvider) *Dictionary {
+	return &Dictionary{
+		aliasProvider: aliasProvider,
+	}
+}
+
+// Aliases returns the canonical skill of every alias. The map is shared and must not be modified.
+// When reloading fails the previously loaded aliases are returned, if any.
+func (d *Dictionary) Aliases(ctx context.Context) (map[string]string, error) {
+	const op = "service.alias.Aliases"
+
+	d.mu.RLock()
+	aliases, loadedAt := d.aliases, d.loadedAt
+	d.mu.RUnlock()
+
+	if aliases != nil && time.Since(loadedAt) < cacheTTL {
+		return aliases, nil
+	}
+
+	rows, err := d.aliasProvider.GetSkillAliases(ctx)
+	if err != nil {
+		if aliases != nil {
+			loggerctx.FromContext(ctx).Warn("skill_aliases_reload_failed", slogx.Err(err))
+			return aliases, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	aliases = make(map[string]string, len(rows))
+	for _, row := range rows {
+		aliases[row.Alias] = row.Skill
+	}
+
+	d.mu.Lock()
+	d.aliases = aliases
+	d.loadedAt = time.Now()
+	d.mu.Unlock()
+
+	loggerctx.FromContext(ctx).Debug("skill_aliases_loaded", "count", len(aliases))
+
+	return aliases, nil
+}
+
+// invalidate makes the next Aliases call reload the aliases.
+func (d *Dictionary) invalidate() {
+	d.mu.Lock()
+	d.loadedAt = time.Time{}
+	d.mu.Unlock()
+}
+
+// List returns all aliases ordered by canonical skill, bypassing the cache.
+func (d *Dictionary) List(ctx context.Context) ([]domain.SkillAlias, error) {
+	const op = "service.alias.List"
+
+	aliases, err := d.aliasProvider.GetSkillAliases(ctx)
+	if err != nil {
+		loggerctx.FromContext(ctx).Error("get_skill_aliases_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return aliases, nil
+}
+
+func (d *Dictionary) Create(ctx context.Context, alias, skill string) (domain.SkillAlias, error) {
+	const op = "service.alias.Create"
+	log := loggerctx.FromContext(ctx).With("op", op)
+
+	alias, skill = normalize(alias), normalize(skill)
+	if err := validate(alias, skill); err != nil {
+		return domain.SkillAlias{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var created domain.SkillAlias
+	err := d.aliasProvider.InTx(ctx, func(ctx context.Context) error {
+		if err := d.checkChain(ctx, uuid.Nil, alias, skill); err != nil {
+			return err
+		}
+
+		var err error
+		created, err = d.aliasProvider.AddSkillAlias(ctx, alias, skill)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrSkillAliasChain) {
+			return domain.SkillAlias{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if errors.Is(err, domain.ErrSkillAliasAlreadyExists) {
+			log.Warn("skill_alias_already_exists", "alias", alias)
+			return domain.SkillAlias{}, domain.ErrSkillAliasAlreadyExists
+		}
+		log.Error("create_skill_alias_failed", "alias", alias, slogx.Err(err))
+		return domain.SkillAlias{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	d.invalidate()
+	log.Info("skill_alias_created", "alias_id", created.ID, "alias", alias, "skill", skill)
+
+	return created, nil
+}
+
+func (d *Dictionary) Change(ctx context.Context, id uuid.UUID, alias, skill string) (domain.SkillAlias, error) {
+	const op = "service.alias.Change"
+	log := loggerctx.FromContext(ctx).With("op", op, "alias_id", id)
+
+	alias, skill = normalize(alias), normalize(skill)
+	if err := validate(alias, skill); err != nil {
+		return domain.SkillAlias{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var changed domain.SkillAlias
+	err := d.aliasProvider.InTx(ctx, func(ctx context.Context) error {
+		if err := d.checkChain(ctx, id, alias, skill); err != nil {
+			return err
+		}
+
+		var err error
+		changed, err = d.aliasProvider.UpdateSkillAlias(ctx, id, alias, skill)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrSkillAliasChain) {
+			return domain.SkillAlias{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if errors.Is(err, domain.ErrSkillAliasNotFound) {
+			return domain.SkillAlias{}, domain.ErrSkillAliasNotFound
+		}
+		if errors.Is(err, domain.ErrSkillAliasAlreadyExists) {
+			log.Warn("skill_alias_already_exists", "alias", alias)
+			return domain.SkillAlias{}, domain.ErrSkillAliasAlreadyExists
+		}
+		log.Error("update_skill_alias_failed", slogx.Err(err))
+		return domain.SkillAlias{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	d.invalidate()
+	log.Info("skill_alias_updated", "alias", alias, "skill", skill)
+
+	return changed, nil
+}
+
+func (d *Dictionary) Delete(ctx context.Context, id uuid.UUID) error {
+	const op = "service.alias.Delete"
+	log := loggerctx.FromContext(ctx).With("op", op, "alias_id", id)
+
+	if err := d.aliasProvider.DeleteSkillAlias(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrSkillAliasNotFound) {
+			return domain.ErrSkillAliasNotFound
+		}
+		log.Error("delete_skill_alias_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	d.invalidate()
+	log.Info("skill_alias_deleted")
+
+	return nil
+}
+
+// validate rejects empty and self-referencing aliases.
+func validate(alias, skill string) error {
+	if alias == "" || skill == "" || alias == skill {
+		return domain.ErrInvalidSkillAlias
+	}
+
+	return nil
+}
+
+// checkChain rejects aliases that would form a chain with the stored ones: the canonical skill must not be
+// an alias itself, and the alias must not be a canonical skill of another alias. The alias with the given id
+// is left out, as it is the one being changed. checkChain must run in the transaction writing the alias: it locks
+// the aliases, so that no other change can form a chain before this one is written.
+func (d *Dictionary) checkChain(ctx context.Context, id uuid.UUID, alias, skill string) error {
+	const op = "service.alias.checkChain"
+
+	if err := d.aliasProvider.LockSkillAliases(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	stored, err := d.aliasProvider.GetSkillAliases(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, a := range stored {
+		if a.ID == id {
+			continue
+		}
+		if a.Alias == skill || a.Skill == alias {
+			return domain.ErrSkillAliasChain
+		}
+	}
+
+	return nil
+}
+
+// normalize brings a skill name to the form skills are counted in: lower case with single spaces.
+func normalize(skill string) string {
+	return strings.Join(strings.Fields(strings.ToLower(skill)), " ")
+}
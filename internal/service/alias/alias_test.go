@@ -0,0 +1,385 @@
+package alias
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/service/alias/mocks"
+)
+
+// testDeps содержит зависимости для тестирования Dictionary
+type testDeps struct {
+	aliasProvider *mocks.MockAliasProvider
+}
+
+func newDeps(t *testing.T) testDeps {
+	t.Helper()
+	return testDeps{
+		aliasProvider: mocks.NewMockAliasProvider(t),
+	}
+}
+
+func (d testDeps) dictionary() *Dictionary {
+	return New(d.aliasProvider)
+}
+
+// expectTx ожидает транзакцию, в которой синонимы блокируются перед проверкой
+func (d testDeps) expectTx(ctx context.Context) {
+	d.aliasProvider.EXPECT().InTx(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	d.aliasProvider.EXPECT().LockSkillAliases(ctx).Return(nil)
+}
+
+var storedAliases = []domain.SkillAlias{
+	{ID: uuid.New(), Alias: "golang", Skill: "go"},
+	{ID: uuid.New(), Alias: "postgres", Skill: "postgresql"},
+}
+
+// ==================== Aliases ====================
+
+func TestDictionary_Aliases_Cached(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(storedAliases, nil).Once()
+
+	dictionary := deps.dictionary()
+
+	// Act
+	first, err := dictionary.Aliases(ctx)
+	require.NoError(t, err)
+	second, err := dictionary.Aliases(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"golang": "go", "postgres": "postgresql"}, first)
+	assert.Equal(t, first, second)
+}
+
+func TestDictionary_Aliases_Error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(nil, errors.New("db unavailable"))
+
+	// Act
+	aliases, err := deps.dictionary().Aliases(ctx)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, aliases)
+}
+
+func TestDictionary_Aliases_ReloadErrorKeepsCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(storedAliases, nil).Once()
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(nil, errors.New("db unavailable"))
+
+	dictionary := deps.dictionary()
+	_, err := dictionary.Aliases(ctx)
+	require.NoError(t, err)
+	dictionary.invalidate()
+
+	// Act
+	aliases, err := dictionary.Aliases(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "go", aliases["golang"])
+}
+
+// ==================== Create ====================
+
+func TestDictionary_Create_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	created := domain.SkillAlias{ID: uuid.New(), Alias: "k8s", Skill: "kubernetes"}
+	deps.expectTx(ctx)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(storedAliases, nil)
+	deps.aliasProvider.EXPECT().AddSkillAlias(ctx, "k8s", "kubernetes").Return(created, nil)
+
+	// Act
+	result, err := deps.dictionary().Create(ctx, "  K8S ", "Kubernetes")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, created, result)
+}
+
+func TestDictionary_Create_InvalidatesCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	withK8s := append([]domain.SkillAlias{{ID: uuid.New(), Alias: "k8s", Skill: "kubernetes"}}, storedAliases...)
+	deps.expectTx(ctx)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(storedAliases, nil).Times(2)
+	deps.aliasProvider.EXPECT().AddSkillAlias(ctx, "k8s", "kubernetes").Return(withK8s[0], nil)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(withK8s, nil).Once()
+
+	dictionary := deps.dictionary()
+	_, err := dictionary.Aliases(ctx)
+	require.NoError(t, err)
+
+	// Act
+	_, err = dictionary.Create(ctx, "k8s", "kubernetes")
+	require.NoError(t, err)
+	aliases, err := dictionary.Aliases(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "kubernetes", aliases["k8s"])
+}
+
+func TestDictionary_Create_Invalid(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		alias string
+		skill string
+	}{
+		{name: "пустой синоним", alias: " ", skill: "go"},
+		{name: "пустой навык", alias: "golang", skill: ""},
+		{name: "синоним совпадает с навыком", alias: "Go", skill: "go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newDeps(t)
+
+			// Act
+			_, err := deps.dictionary().Create(ctx, tt.alias, tt.skill)
+
+			// Assert
+			require.ErrorIs(t, err, domain.ErrInvalidSkillAlias)
+		})
+	}
+}
+
+func TestDictionary_Create_Chain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		alias string
+		skill string
+	}{
+		{name: "навык уже является синонимом", alias: "go lang", skill: "golang"},
+		{name: "синоним уже является навыком", alias: "postgresql", skill: "pgsql"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := newDeps(t)
+			deps.expectTx(ctx)
+			deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(storedAliases, nil)
+
+			// Act
+			_, err := deps.dictionary().Create(ctx, tt.alias, tt.skill)
+
+			// Assert
+			require.ErrorIs(t, err, domain.ErrSkillAliasChain)
+		})
+	}
+}
+
+func TestDictionary_Create_ValidatesInTx(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	// блокировка, проверка цепочки и запись выполняются в одной транзакции
+	type txKey struct{}
+	txCtx := context.WithValue(ctx, txKey{}, "tx")
+	created := domain.SkillAlias{ID: uuid.New(), Alias: "k8s", Skill: "kubernetes"}
+	deps.aliasProvider.EXPECT().InTx(ctx, mock.Anything).RunAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+		return fn(txCtx)
+	})
+	lock := deps.aliasProvider.EXPECT().LockSkillAliases(txCtx).Return(nil).Call
+	deps.aliasProvider.EXPECT().GetSkillAliases(txCtx).Return(storedAliases, nil).NotBefore(lock)
+	deps.aliasProvider.EXPECT().AddSkillAlias(txCtx, "k8s", "kubernetes").Return(created, nil)
+
+	// Act
+	result, err := deps.dictionary().Create(ctx, "k8s", "kubernetes")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, created, result)
+}
+
+func TestDictionary_Create_StorageError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	lockErr := errors.New("lock timeout")
+	getErr := errors.New("db unavailable")
+
+	tests := []struct {
+		name    string
+		lockErr error
+		getErr  error
+		wantErr error
+	}{
+		{name: "ошибка блокировки", lockErr: lockErr, wantErr: lockErr},
+		{name: "ошибка чтения синонимов", getErr: getErr, wantErr: getErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			deps := newDeps(t)
+			deps.aliasProvider.EXPECT().InTx(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+			deps.aliasProvider.EXPECT().LockSkillAliases(ctx).Return(tt.lockErr)
+			if tt.lockErr == nil {
+				deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(nil, tt.getErr)
+			}
+
+			// Act
+			_, err := deps.dictionary().Create(ctx, "k8s", "kubernetes")
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Contains(t, err.Error(), "service.alias.checkChain")
+		})
+	}
+}
+
+func TestDictionary_Create_AlreadyExists(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	deps.expectTx(ctx)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(storedAliases, nil)
+	deps.aliasProvider.EXPECT().AddSkillAlias(ctx, "golang", "go").Return(domain.SkillAlias{}, domain.ErrSkillAliasAlreadyExists)
+
+	// Act
+	_, err := deps.dictionary().Create(ctx, "golang", "go")
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrSkillAliasAlreadyExists)
+}
+
+// ==================== Change ====================
+
+func TestDictionary_Change_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	// синоним golang можно перенаправить, он сам не проверяется на цепочку
+	id := storedAliases[0].ID
+	changed := domain.SkillAlias{ID: id, Alias: "golang", Skill: "go language"}
+	deps.expectTx(ctx)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(storedAliases, nil)
+	deps.aliasProvider.EXPECT().UpdateSkillAlias(ctx, id, "golang", "go language").Return(changed, nil)
+
+	// Act
+	result, err := deps.dictionary().Change(ctx, id, "golang", "go language")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, changed, result)
+}
+
+func TestDictionary_Change_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	id := uuid.New()
+	deps.expectTx(ctx)
+	deps.aliasProvider.EXPECT().GetSkillAliases(ctx).Return(storedAliases, nil)
+	deps.aliasProvider.EXPECT().UpdateSkillAlias(ctx, id, "k8s", "kubernetes").Return(domain.SkillAlias{}, domain.ErrSkillAliasNotFound)
+
+	// Act
+	_, err := deps.dictionary().Change(ctx, id, "k8s", "kubernetes")
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrSkillAliasNotFound)
+}
+
+// ==================== Delete ====================
+
+func TestDictionary_Delete_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	id := uuid.New()
+	deps.aliasProvider.EXPECT().DeleteSkillAlias(ctx, id).Return(nil)
+
+	// Act
+	err := deps.dictionary().Delete(ctx, id)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestDictionary_Delete_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	id := uuid.New()
+	deps.aliasProvider.EXPECT().DeleteSkillAlias(ctx, id).Return(domain.ErrSkillAliasNotFound)
+
+	// Act
+	err := deps.dictionary().Delete(ctx, id)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrSkillAliasNotFound)
+}
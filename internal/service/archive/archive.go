@@ -0,0 +1,104 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+type ProfessionProvider interface {
+	GetProfessionByID(ctx context.Context, id uuid.UUID) (domain.Profession, error)
+}
+
+type SessionProvider interface {
+	GetScrapingByID(ctx context.Context, id uuid.UUID) (domain.Scraping, error)
+}
+
+type VacancyProvider interface {
+	GetVacanciesByProfessionAndSession(
+		ctx context.Context,
+		professionID uuid.UUID,
+		sessionID uuid.UUID,
+		area string,
+		limit, offset int,
+	) ([]domain.Vacancy, error)
+	CountVacanciesByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (int64, error)
+}
+
+// Archive gives access to raw vacancies stored by archive scraping sessions.
+type Archive struct {
+	professionProvider ProfessionProvider
+	sessionProvider    SessionProvider
+	vacancyProvider    VacancyProvider
+}
+
+func New(
+	professionProvider ProfessionProvider,
+	sessionProvider SessionProvider,
+	vacancyProvider VacancyProvider,
+) *Archive {
+	return &Archive{
+		professionProvider: professionProvider,
+		sessionProvider:    sessionProvider,
+		vacancyProvider:    vacancyProvider,
+	}
+}
+
+func (a *Archive) SessionVacancies(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	professionID uuid.UUID,
+	area string,
+	limit, offset int,
+) (*domain.VacancyPage, error) {
+	const op = "service.archive.SessionVacancies"
+	log := loggerctx.FromContext(ctx).With("op", op, "session_id", sessionID, "profession_id", professionID, "area", area)
+
+	if _, err := a.sessionProvider.GetScrapingByID(ctx, sessionID); err != nil {
+		if errors.Is(err, domain.ErrScrapingNotFound) {
+			log.Warn("session_not_found")
+			return nil, domain.ErrScrapingNotFound
+		}
+		log.Error("get_session_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := a.professionProvider.GetProfessionByID(ctx, professionID); err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			log.Warn("profession_not_found")
+			return nil, domain.ErrProfessionNotFound
+		}
+		log.Error("get_profession_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	total, err := a.vacancyProvider.CountVacanciesByProfessionAndSession(ctx, professionID, sessionID, area)
+	if err != nil {
+		log.Error("count_vacancies_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vacancies, err := a.vacancyProvider.GetVacanciesByProfessionAndSession(ctx, professionID, sessionID, area, limit, offset)
+	if err != nil {
+		log.Error("get_vacancies_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Debug("session_vacancies_loaded", "total", total, "count", len(vacancies))
+
+	return &domain.VacancyPage{
+		SessionID:    sessionID,
+		ProfessionID: professionID,
+		Area:         area,
+		Total:        total,
+		Limit:        limit,
+		Offset:       offset,
+		Vacancies:    vacancies,
+	}, nil
+}
@@ -0,0 +1,147 @@
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/service/archive/mocks"
+)
+
+// testDeps содержит зависимости для тестирования Archive
+type testDeps struct {
+	professionProvider *mocks.MockProfessionProvider
+	sessionProvider    *mocks.MockSessionProvider
+	vacancyProvider    *mocks.MockVacancyProvider
+}
+
+func newDeps(t *testing.T) testDeps {
+	t.Helper()
+	return testDeps{
+		professionProvider: mocks.NewMockProfessionProvider(t),
+		sessionProvider:    mocks.NewMockSessionProvider(t),
+		vacancyProvider:    mocks.NewMockVacancyProvider(t),
+	}
+}
+
+func (d testDeps) archive() *Archive {
+	return New(d.professionProvider, d.sessionProvider, d.vacancyProvider)
+}
+
+// ==================== SessionVacancies ====================
+
+func TestArchive_SessionVacancies_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	professionID := uuid.New()
+	vacancies := []domain.Vacancy{
+		{
+			ID:              "101",
+			Description:     "Go developer needed",
+			DescriptionHash: "hash",
+			KeySkills:       []string{"golang"},
+			PublishedAt:     time.Date(2025, 1, 15, 7, 30, 0, 0, time.UTC),
+		},
+	}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{ID: sessionID}, nil)
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).
+		Return(domain.Profession{ID: professionID}, nil)
+	deps.vacancyProvider.EXPECT().CountVacanciesByProfessionAndSession(ctx, professionID, sessionID, "113").
+		Return(int64(42), nil)
+	deps.vacancyProvider.EXPECT().GetVacanciesByProfessionAndSession(ctx, professionID, sessionID, "113", 10, 20).
+		Return(vacancies, nil)
+
+	// Act
+	page, err := deps.archive().SessionVacancies(ctx, sessionID, professionID, "113", 10, 20)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), page.Total)
+	assert.Equal(t, 10, page.Limit)
+	assert.Equal(t, 20, page.Offset)
+	assert.Equal(t, vacancies, page.Vacancies)
+}
+
+func TestArchive_SessionVacancies_SessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{}, domain.ErrScrapingNotFound)
+
+	// Act
+	page, err := deps.archive().SessionVacancies(ctx, sessionID, uuid.New(), "113", 10, 0)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingNotFound)
+	assert.Nil(t, page)
+}
+
+func TestArchive_SessionVacancies_ProfessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	professionID := uuid.New()
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{ID: sessionID}, nil)
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).
+		Return(domain.Profession{}, domain.ErrProfessionNotFound)
+
+	// Act
+	page, err := deps.archive().SessionVacancies(ctx, sessionID, professionID, "113", 10, 0)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrProfessionNotFound)
+	assert.Nil(t, page)
+}
+
+func TestArchive_SessionVacancies_RepositoryError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	professionID := uuid.New()
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{ID: sessionID}, nil)
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).
+		Return(domain.Profession{ID: professionID}, nil)
+	deps.vacancyProvider.EXPECT().CountVacanciesByProfessionAndSession(ctx, professionID, sessionID, "113").
+		Return(int64(0), assert.AnError)
+
+	// Act
+	page, err := deps.archive().SessionVacancies(ctx, sessionID, professionID, "113", 10, 0)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, page)
+}
@@ -0,0 +1,72 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"psa/internal/domain"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+type AreaSupplier interface {
+	FetchAreas(ctx context.Context) ([]domain.Area, error)
+}
+
+type AreaProvider interface {
+	ReplaceAreas(ctx context.Context, areas []domain.Area) error
+	SearchAreas(ctx context.Context, query string, limit int) ([]domain.Area, error)
+}
+
+// Dictionary keeps a local copy of the hh.ru areas dictionary and searches it by name.
+type Dictionary struct {
+	supplier     AreaSupplier
+	areaProvider AreaProvider
+}
+
+func New(supplier AreaSupplier, areaProvider AreaProvider) *Dictionary {
+	return &Dictionary{
+		supplier:     supplier,
+		areaProvider: areaProvider,
+	}
+}
+
+// Sync downloads the areas tree from hh.ru and replaces the stored dictionary with it.
+func (d *Dictionary) Sync(ctx context.Context) error {
+	const op = "service.area.Sync"
+	log := loggerctx.FromContext(ctx).With("op", op)
+
+	areas, err := d.supplier.FetchAreas(ctx)
+	if err != nil {
+		log.Error("fetch_areas_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := d.areaProvider.ReplaceAreas(ctx, areas); err != nil {
+		log.Error("replace_areas_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("areas_synced", "count", len(areas))
+
+	return nil
+}
+
+// Search returns at most limit areas whose name contains query. An empty query matches every area.
+func (d *Dictionary) Search(ctx context.Context, query string, limit int) ([]domain.Area, error) {
+	const op = "service.area.Search"
+	log := loggerctx.FromContext(ctx).With("op", op)
+
+	query = strings.TrimSpace(query)
+
+	areas, err := d.areaProvider.SearchAreas(ctx, query, limit)
+	if err != nil {
+		log.Error("search_areas_failed", "query", query, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Debug("areas_found", "query", query, "count", len(areas))
+
+	return areas, nil
+}
@@ -0,0 +1,130 @@
+package area
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/service/area/mocks"
+)
+
+// testDeps содержит зависимости для тестирования Dictionary
+type testDeps struct {
+	supplier     *mocks.MockAreaSupplier
+	areaProvider *mocks.MockAreaProvider
+}
+
+func newDeps(t *testing.T) testDeps {
+	t.Helper()
+	return testDeps{
+		supplier:     mocks.NewMockAreaSupplier(t),
+		areaProvider: mocks.NewMockAreaProvider(t),
+	}
+}
+
+func (d testDeps) dictionary() *Dictionary {
+	return New(d.supplier, d.areaProvider)
+}
+
+// ==================== Sync ====================
+
+func TestDictionary_Sync_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	areas := []domain.Area{
+		{ID: "113", Name: "Россия"},
+		{ID: "1", ParentID: "113", Name: "Москва"},
+	}
+
+	deps.supplier.EXPECT().FetchAreas(ctx).Return(areas, nil)
+	deps.areaProvider.EXPECT().ReplaceAreas(ctx, areas).Return(nil)
+
+	// Act
+	err := deps.dictionary().Sync(ctx)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestDictionary_Sync_FetchError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	deps.supplier.EXPECT().FetchAreas(ctx).Return(nil, assert.AnError)
+
+	// Act
+	err := deps.dictionary().Sync(ctx)
+
+	// Assert - при ошибке hh справочник не трогаем
+	require.ErrorIs(t, err, assert.AnError)
+	deps.areaProvider.AssertNotCalled(t, "ReplaceAreas")
+}
+
+func TestDictionary_Sync_ReplaceError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	deps.supplier.EXPECT().FetchAreas(ctx).Return([]domain.Area{{ID: "113", Name: "Россия"}}, nil)
+	deps.areaProvider.EXPECT().ReplaceAreas(ctx, []domain.Area{{ID: "113", Name: "Россия"}}).Return(assert.AnError)
+
+	// Act
+	err := deps.dictionary().Sync(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+// ==================== Search ====================
+
+func TestDictionary_Search_TrimsQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	expected := []domain.Area{{ID: "1", ParentID: "113", Name: "Москва"}}
+	deps.areaProvider.EXPECT().SearchAreas(ctx, "моск", 20).Return(expected, nil)
+
+	// Act
+	result, err := deps.dictionary().Search(ctx, "  моск ", 20)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestDictionary_Search_Error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	deps.areaProvider.EXPECT().SearchAreas(ctx, "моск", 20).Return(nil, assert.AnError)
+
+	// Act
+	result, err := deps.dictionary().Search(ctx, "моск", 20)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
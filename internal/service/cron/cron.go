@@ -9,24 +9,38 @@ import (
 	"github.com/go-co-op/gocron/v2"
 	"github.com/google/uuid"
 
+	"psa/internal/domain"
 	"psa/pkg/logger/loggerctx"
 	"psa/pkg/logger/slogx"
 )
 
-const jobTimeout = 1 * time.Hour
+const areaSyncTimeout = 5 * time.Minute
 
 type ScrapingProvider interface {
-	ProcessActiveProfessionsArchive(ctx context.Context) error
-	ProcessActiveProfessionsDaily(ctx context.Context) error
+	ProcessActiveProfessionsArchive(ctx context.Context, runID uuid.UUID) error
+	ProcessActiveProfessionsDaily(ctx context.Context, runID uuid.UUID) error
+}
+
+// RunRecorder persists every scraping job as a run.
+type RunRecorder interface {
+	Run(ctx context.Context, run domain.ScrapingRun, task func(ctx context.Context, runID uuid.UUID) error) error
+}
+
+type AreaProvider interface {
+	Sync(ctx context.Context) error
 }
 
 type Cron struct {
-	log       *slog.Logger
-	scraper   ScrapingProvider
-	scheduler gocron.Scheduler
+	log        *slog.Logger
+	scraper    ScrapingProvider
+	runs       RunRecorder
+	areas      AreaProvider
+	runTimeout time.Duration
+	scheduler  gocron.Scheduler
 }
 
-func New(log *slog.Logger, scraper ScrapingProvider) (*Cron, error) {
+// New builds the scheduler. runTimeout bounds a scheduled scraping run, which scrapes every profession and area.
+func New(log *slog.Logger, scraper ScrapingProvider, runs RunRecorder, areas AreaProvider, runTimeout time.Duration) (*Cron, error) {
 	const op = "service.cron.New"
 	log = log.With("op", op)
 
@@ -48,9 +62,12 @@ func New(log *slog.Logger, scraper ScrapingProvider) (*Cron, error) {
 	}
 
 	return &Cron{
-		log:       log,
-		scraper:   scraper,
-		scheduler: scheduler,
+		log:        log,
+		scraper:    scraper,
+		runs:       runs,
+		areas:      areas,
+		runTimeout: runTimeout,
+		scheduler:  scheduler,
 	}, nil
 }
 
@@ -88,6 +105,20 @@ func (c *Cron) Start(ctx context.Context) error {
 	}
 	log.Debug("daily_scheduled", "schedule", "0 3 1-14,16-31 * *")
 
+	// Areas dictionary job
+	_, err = c.scheduler.NewJob(
+		gocron.CronJob("0 2 * * *", false),
+		gocron.NewTask(func() {
+			c.runAreaSyncJob(ctx)
+		}),
+		gocron.WithName("areas_sync"),
+	)
+	if err != nil {
+		log.Error("areas_sync_schedule_failed", slogx.Err(err))
+		return fmt.Errorf("%s: areas sync job: %w", op, err)
+	}
+	log.Debug("areas_sync_scheduled", "schedule", "0 2 * * *")
+
 	c.scheduler.Start()
 	log.Info("scheduler_started")
 
@@ -134,16 +165,17 @@ func (c *Cron) Stop(ctx context.Context) error {
 
 func (c *Cron) runScrapingJobArchive(ctx context.Context, jobType string) {
 	const op = "service.cron.runScrapingJobArchive"
-	runID := uuid.New().String()
+	runID := uuid.New()
 	log := c.log.With("op", op, "job", jobType, "run_id", runID)
 
 	log.Info("job_started")
 
 	ctxWithLogger := loggerctx.WithLogger(ctx, log)
-	ctxJob, cancel := context.WithTimeout(ctxWithLogger, jobTimeout)
+	ctxJob, cancel := context.WithTimeout(ctxWithLogger, c.runTimeout)
 	defer cancel()
 
-	if err := c.scraper.ProcessActiveProfessionsArchive(ctxJob); err != nil {
+	run := domain.ScrapingRun{ID: runID, Mode: domain.ScrapingModeArchive, Trigger: domain.ScrapingTriggerCron}
+	if err := c.runs.Run(ctxJob, run, c.scraper.ProcessActiveProfessionsArchive); err != nil {
 		log.Error("job_failed", slogx.Err(err))
 		return
 	}
@@ -153,16 +185,36 @@ func (c *Cron) runScrapingJobArchive(ctx context.Context, jobType string) {
 
 func (c *Cron) runScrapingJobDaily(ctx context.Context, jobType string) {
 	const op = "service.cron.runScrapingJobDaily"
-	runID := uuid.New().String()
+	runID := uuid.New()
 	log := c.log.With("op", op, "job", jobType, "run_id", runID)
 
 	log.Info("job_started")
 
 	ctxWithLogger := loggerctx.WithLogger(ctx, log)
-	ctxJob, cancel := context.WithTimeout(ctxWithLogger, jobTimeout)
+	ctxJob, cancel := context.WithTimeout(ctxWithLogger, c.runTimeout)
+	defer cancel()
+
+	run := domain.ScrapingRun{ID: runID, Mode: domain.ScrapingModeCache, Trigger: domain.ScrapingTriggerCron}
+	if err := c.runs.Run(ctxJob, run, c.scraper.ProcessActiveProfessionsDaily); err != nil {
+		log.Error("job_failed", slogx.Err(err))
+		return
+	}
+
+	log.Info("job_completed")
+}
+
+func (c *Cron) runAreaSyncJob(ctx context.Context) {
+	const op = "service.cron.runAreaSyncJob"
+	runID := uuid.New().String()
+	log := c.log.With("op", op, "job", "areas_sync", "run_id", runID)
+
+	log.Info("job_started")
+
+	ctxWithLogger := loggerctx.WithLogger(ctx, log)
+	ctxJob, cancel := context.WithTimeout(ctxWithLogger, areaSyncTimeout)
 	defer cancel()
 
-	if err := c.scraper.ProcessActiveProfessionsDaily(ctxJob); err != nil {
+	if err := c.areas.Sync(ctxJob); err != nil {
 		log.Error("job_failed", slogx.Err(err))
 		return
 	}
@@ -7,23 +7,32 @@ import (
 	"testing"
 	"time"
 
+	"psa/internal/domain"
 	"psa/internal/service/cron/mocks"
 	"psa/pkg/logger/loggerctx"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// testRunTimeout — ограничение запуска по расписанию, переданное в New
+const testRunTimeout = 3 * time.Hour
+
 // testDeps содержит зависимости для тестирования Cron
 type testDeps struct {
 	scraper *mocks.MockScrapingProvider
+	runs    *mocks.MockRunRecorder
+	areas   *mocks.MockAreaProvider
 }
 
 func newDeps(t *testing.T) testDeps {
 	t.Helper()
 	return testDeps{
 		scraper: mocks.NewMockScrapingProvider(t),
+		runs:    mocks.NewMockRunRecorder(t),
+		areas:   mocks.NewMockAreaProvider(t),
 	}
 }
 
@@ -33,7 +42,7 @@ func newTestLogger() *slog.Logger {
 
 func (d testDeps) cron() *Cron {
 	log := newTestLogger()
-	cron, err := New(log, d.scraper)
+	cron, err := New(log, d.scraper, d.runs, d.areas, testRunTimeout)
 	if err != nil {
 		panic(err)
 	}
@@ -56,6 +65,8 @@ func TestCron_New(t *testing.T) {
 	assert.NotNil(t, cron.scheduler)
 	assert.NotNil(t, cron.log)
 	assert.NotNil(t, cron.scraper)
+	assert.NotNil(t, cron.runs)
+	assert.NotNil(t, cron.areas)
 }
 
 // ==================== Start & Stop ====================
@@ -74,7 +85,7 @@ func TestCron_Start_JobsRegistered(t *testing.T) {
 
 	// Assert - проверяем что jobs действительно зарегистрированы
 	jobs := cron.scheduler.Jobs()
-	require.Len(t, jobs, 2)
+	require.Len(t, jobs, 3)
 
 	// Проверяем имена jobs
 	jobNames := make(map[string]bool)
@@ -83,6 +94,7 @@ func TestCron_Start_JobsRegistered(t *testing.T) {
 	}
 	require.True(t, jobNames["monthly_scraping"], "monthly_scraping job should be registered")
 	require.True(t, jobNames["daily_scraping"], "daily_scraping job should be registered")
+	require.True(t, jobNames["areas_sync"], "areas_sync job should be registered")
 
 	// Clean up
 	err = cron.Stop(ctx)
@@ -129,6 +141,16 @@ func TestCron_Stop_Timeout(t *testing.T) {
 	assert.ErrorIs(t, err, context.DeadlineExceeded)
 }
 
+// executeTask — RunRecorder, который выполняет задачу запуска как настоящий runner
+func executeTask(ctx context.Context, run domain.ScrapingRun, task func(ctx context.Context, runID uuid.UUID) error) error {
+	return task(ctx, run.ID)
+}
+
+func withJobDeadline(ctx context.Context) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && time.Until(deadline) <= testRunTimeout && time.Until(deadline) > 0
+}
+
 // ==================== runScrapingJobArchive ====================
 
 func TestCron_RunScrapingJobArchive_ContextAndLogger(t *testing.T) {
@@ -138,12 +160,20 @@ func TestCron_RunScrapingJobArchive_ContextAndLogger(t *testing.T) {
 	ctx := context.Background()
 	deps := newDeps(t)
 
+	var capturedRun domain.ScrapingRun
+	deps.runs.EXPECT().Run(mock.MatchedBy(withJobDeadline), mock.MatchedBy(func(run domain.ScrapingRun) bool {
+		capturedRun = run
+		return run.Mode == domain.ScrapingModeArchive && run.Trigger == domain.ScrapingTriggerCron && run.ID != uuid.Nil
+	}), mock.Anything).RunAndReturn(executeTask).Once()
+
 	var capturedCtx context.Context
 	deps.scraper.EXPECT().ProcessActiveProfessionsArchive(mock.MatchedBy(func(ctx context.Context) bool {
 		capturedCtx = ctx
-		deadline, ok := ctx.Deadline()
-		return ok && time.Until(deadline) <= jobTimeout && time.Until(deadline) > 0
-	})).Return(nil).Once()
+		return withJobDeadline(ctx)
+	}), mock.Anything).RunAndReturn(func(ctx context.Context, runID uuid.UUID) error {
+		assert.Equal(t, capturedRun.ID, runID, "scraper should record professions in the run")
+		return nil
+	}).Once()
 
 	cron := deps.cron()
 
@@ -154,7 +184,7 @@ func TestCron_RunScrapingJobArchive_ContextAndLogger(t *testing.T) {
 	require.NotNil(t, capturedCtx, "context should be passed to scraper")
 	deadline, ok := capturedCtx.Deadline()
 	require.True(t, ok, "context should have deadline")
-	assert.InDelta(t, jobTimeout, time.Until(deadline), float64(100*time.Millisecond))
+	assert.InDelta(t, testRunTimeout, time.Until(deadline), float64(100*time.Millisecond))
 
 	// Assert - проверяем что логгер был прокинут в контекст
 	logger := loggerctx.FromContext(capturedCtx)
@@ -168,10 +198,8 @@ func TestCron_RunScrapingJobArchive_Error(t *testing.T) {
 	ctx := context.Background()
 	deps := newDeps(t)
 
-	deps.scraper.EXPECT().ProcessActiveProfessionsArchive(mock.MatchedBy(func(ctx context.Context) bool {
-		deadline, ok := ctx.Deadline()
-		return ok && time.Until(deadline) <= jobTimeout
-	})).Return(assert.AnError).Once()
+	deps.runs.EXPECT().Run(mock.MatchedBy(withJobDeadline), mock.Anything, mock.Anything).RunAndReturn(executeTask).Once()
+	deps.scraper.EXPECT().ProcessActiveProfessionsArchive(mock.Anything, mock.Anything).Return(assert.AnError).Once()
 
 	cron := deps.cron()
 
@@ -190,12 +218,20 @@ func TestCron_RunScrapingJobDaily_ContextAndLogger(t *testing.T) {
 	ctx := context.Background()
 	deps := newDeps(t)
 
+	var capturedRun domain.ScrapingRun
+	deps.runs.EXPECT().Run(mock.MatchedBy(withJobDeadline), mock.MatchedBy(func(run domain.ScrapingRun) bool {
+		capturedRun = run
+		return run.Mode == domain.ScrapingModeCache && run.Trigger == domain.ScrapingTriggerCron && run.ID != uuid.Nil
+	}), mock.Anything).RunAndReturn(executeTask).Once()
+
 	var capturedCtx context.Context
 	deps.scraper.EXPECT().ProcessActiveProfessionsDaily(mock.MatchedBy(func(ctx context.Context) bool {
 		capturedCtx = ctx
-		deadline, ok := ctx.Deadline()
-		return ok && time.Until(deadline) <= jobTimeout && time.Until(deadline) > 0
-	})).Return(nil).Once()
+		return withJobDeadline(ctx)
+	}), mock.Anything).RunAndReturn(func(ctx context.Context, runID uuid.UUID) error {
+		assert.Equal(t, capturedRun.ID, runID, "scraper should record professions in the run")
+		return nil
+	}).Once()
 
 	cron := deps.cron()
 
@@ -206,7 +242,7 @@ func TestCron_RunScrapingJobDaily_ContextAndLogger(t *testing.T) {
 	require.NotNil(t, capturedCtx, "context should be passed to scraper")
 	deadline, ok := capturedCtx.Deadline()
 	require.True(t, ok, "context should have deadline")
-	assert.InDelta(t, jobTimeout, time.Until(deadline), float64(100*time.Millisecond))
+	assert.InDelta(t, testRunTimeout, time.Until(deadline), float64(100*time.Millisecond))
 
 	// Assert - проверяем что логгер был прокинут в контекст
 	logger := loggerctx.FromContext(capturedCtx)
@@ -220,10 +256,8 @@ func TestCron_RunScrapingJobDaily_Error(t *testing.T) {
 	ctx := context.Background()
 	deps := newDeps(t)
 
-	deps.scraper.EXPECT().ProcessActiveProfessionsDaily(mock.MatchedBy(func(ctx context.Context) bool {
-		deadline, ok := ctx.Deadline()
-		return ok && time.Until(deadline) <= jobTimeout
-	})).Return(assert.AnError).Once()
+	deps.runs.EXPECT().Run(mock.MatchedBy(withJobDeadline), mock.Anything, mock.Anything).RunAndReturn(executeTask).Once()
+	deps.scraper.EXPECT().ProcessActiveProfessionsDaily(mock.Anything, mock.Anything).Return(assert.AnError).Once()
 
 	cron := deps.cron()
 
@@ -232,3 +266,46 @@ func TestCron_RunScrapingJobDaily_Error(t *testing.T) {
 		cron.runScrapingJobDaily(ctx, "test")
 	})
 }
+
+// ==================== runAreaSyncJob ====================
+
+func TestCron_RunAreaSyncJob_ContextAndLogger(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	var capturedCtx context.Context
+	deps.areas.EXPECT().Sync(mock.MatchedBy(func(ctx context.Context) bool {
+		capturedCtx = ctx
+		deadline, ok := ctx.Deadline()
+		return ok && time.Until(deadline) <= areaSyncTimeout && time.Until(deadline) > 0
+	})).Return(nil).Once()
+
+	cron := deps.cron()
+
+	// Act
+	cron.runAreaSyncJob(ctx)
+
+	// Assert
+	require.NotNil(t, capturedCtx, "context should be passed to area sync")
+	require.NotNil(t, loggerctx.FromContext(capturedCtx), "logger should be propagated to context")
+}
+
+func TestCron_RunAreaSyncJob_Error(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	deps.areas.EXPECT().Sync(mock.Anything).Return(assert.AnError).Once()
+
+	cron := deps.cron()
+
+	// Act & Assert
+	assert.NotPanics(t, func() {
+		cron.runAreaSyncJob(ctx)
+	})
+}
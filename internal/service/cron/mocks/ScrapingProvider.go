@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,16 +39,16 @@ func (_m *MockScrapingProvider) EXPECT() *MockScrapingProvider_Expecter {
 }
 
 // ProcessActiveProfessionsArchive provides a mock function for the type MockScrapingProvider
-func (_mock *MockScrapingProvider) ProcessActiveProfessionsArchive(ctx context.Context) error {
-	ret := _mock.Called(ctx)
+func (_mock *MockScrapingProvider) ProcessActiveProfessionsArchive(ctx context.Context, runID uuid.UUID) error {
+	ret := _mock.Called(ctx, runID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProcessActiveProfessionsArchive")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, runID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -61,18 +62,24 @@ type MockScrapingProvider_ProcessActiveProfessionsArchive_Call struct {
 
 // ProcessActiveProfessionsArchive is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockScrapingProvider_Expecter) ProcessActiveProfessionsArchive(ctx interface{}) *MockScrapingProvider_ProcessActiveProfessionsArchive_Call {
-	return &MockScrapingProvider_ProcessActiveProfessionsArchive_Call{Call: _e.mock.On("ProcessActiveProfessionsArchive", ctx)}
+//   - runID uuid.UUID
+func (_e *MockScrapingProvider_Expecter) ProcessActiveProfessionsArchive(ctx interface{}, runID interface{}) *MockScrapingProvider_ProcessActiveProfessionsArchive_Call {
+	return &MockScrapingProvider_ProcessActiveProfessionsArchive_Call{Call: _e.mock.On("ProcessActiveProfessionsArchive", ctx, runID)}
 }
 
-func (_c *MockScrapingProvider_ProcessActiveProfessionsArchive_Call) Run(run func(ctx context.Context)) *MockScrapingProvider_ProcessActiveProfessionsArchive_Call {
+func (_c *MockScrapingProvider_ProcessActiveProfessionsArchive_Call) Run(run func(ctx context.Context, runID uuid.UUID)) *MockScrapingProvider_ProcessActiveProfessionsArchive_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -83,22 +90,22 @@ func (_c *MockScrapingProvider_ProcessActiveProfessionsArchive_Call) Return(err
 	return _c
 }
 
-func (_c *MockScrapingProvider_ProcessActiveProfessionsArchive_Call) RunAndReturn(run func(ctx context.Context) error) *MockScrapingProvider_ProcessActiveProfessionsArchive_Call {
+func (_c *MockScrapingProvider_ProcessActiveProfessionsArchive_Call) RunAndReturn(run func(ctx context.Context, runID uuid.UUID) error) *MockScrapingProvider_ProcessActiveProfessionsArchive_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ProcessActiveProfessionsDaily provides a mock function for the type MockScrapingProvider
-func (_mock *MockScrapingProvider) ProcessActiveProfessionsDaily(ctx context.Context) error {
-	ret := _mock.Called(ctx)
+func (_mock *MockScrapingProvider) ProcessActiveProfessionsDaily(ctx context.Context, runID uuid.UUID) error {
+	ret := _mock.Called(ctx, runID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProcessActiveProfessionsDaily")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, runID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -112,18 +119,24 @@ type MockScrapingProvider_ProcessActiveProfessionsDaily_Call struct {
 
 // ProcessActiveProfessionsDaily is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockScrapingProvider_Expecter) ProcessActiveProfessionsDaily(ctx interface{}) *MockScrapingProvider_ProcessActiveProfessionsDaily_Call {
-	return &MockScrapingProvider_ProcessActiveProfessionsDaily_Call{Call: _e.mock.On("ProcessActiveProfessionsDaily", ctx)}
+//   - runID uuid.UUID
+func (_e *MockScrapingProvider_Expecter) ProcessActiveProfessionsDaily(ctx interface{}, runID interface{}) *MockScrapingProvider_ProcessActiveProfessionsDaily_Call {
+	return &MockScrapingProvider_ProcessActiveProfessionsDaily_Call{Call: _e.mock.On("ProcessActiveProfessionsDaily", ctx, runID)}
 }
 
-func (_c *MockScrapingProvider_ProcessActiveProfessionsDaily_Call) Run(run func(ctx context.Context)) *MockScrapingProvider_ProcessActiveProfessionsDaily_Call {
+func (_c *MockScrapingProvider_ProcessActiveProfessionsDaily_Call) Run(run func(ctx context.Context, runID uuid.UUID)) *MockScrapingProvider_ProcessActiveProfessionsDaily_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -134,7 +147,7 @@ func (_c *MockScrapingProvider_ProcessActiveProfessionsDaily_Call) Return(err er
 	return _c
 }
 
-func (_c *MockScrapingProvider_ProcessActiveProfessionsDaily_Call) RunAndReturn(run func(ctx context.Context) error) *MockScrapingProvider_ProcessActiveProfessionsDaily_Call {
+func (_c *MockScrapingProvider_ProcessActiveProfessionsDaily_Call) RunAndReturn(run func(ctx context.Context, runID uuid.UUID) error) *MockScrapingProvider_ProcessActiveProfessionsDaily_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,8 +1,9 @@
-// Package extractor based on algorithm N-gram
+// Package extractor finds white-listed skills in vacancy descriptions.
 package extractor
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
 	"strings"
 )
@@ -12,13 +13,61 @@ var (
 	handlingSpacesRegex                = regexp.MustCompile(`\s+`)
 )
 
-type Extractor struct{}
+// Versions identify the extraction algorithm in each mode. Bump them whenever extraction results can change,
+// so that skill counts of different versions are never mixed within one session.
+const (
+	Version          = "aho-corasick-v1"
+	DocumentsVersion = "aho-corasick-df-v1"
+)
+
+// Mode defines what a skill count means.
+type Mode string
+
+const (
+	// ModeMentions counts every mention of a skill in a text.
+	ModeMentions Mode = "mentions"
+	// ModeDocuments counts a skill once per text however often it is mentioned (document frequency),
+	// so that summed over vacancies it is the number of vacancies mentioning the skill.
+	ModeDocuments Mode = "documents"
+)
+
+// ParseMode returns the extraction mode by its name.
+func ParseMode(name string) (Mode, error) {
+	switch mode := Mode(strings.TrimSpace(name)); mode {
+	case ModeMentions, ModeDocuments:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown extractor mode: %q", name)
+	}
+}
+
+type Extractor struct {
+	mode Mode
+}
+
+func New(mode Mode) *Extractor {
+	return &Extractor{mode: mode}
+}
+
+// Version returns the version of the extraction algorithm of Match.
+func (e *Extractor) Version() string {
+	if e.CountsDocuments() {
+		return DocumentsVersion
+	}
+	return Version
+}
 
-func New() *Extractor {
-	return &Extractor{}
+// CountsDocuments reports whether a skill is counted once per text rather than per mention.
+func (e *Extractor) CountsDocuments() bool {
+	return e.mode == ModeDocuments
 }
 
 // ExtractSkills returns a dictionary of found skills with the number of mentions, using N-gram algorithm.
+// In ModeDocuments every found skill is counted once. The text may be HTML; N-grams do not cross sentences,
+// list items and other segments of the text, see Segments.
+//
+// ExtractSkills builds the N-grams of every text anew and finds skills of at most maxNgram words. It is kept
+// as the reference implementation for Match, which finds the same skills with a Matcher built once.
 //
 // text - source text for analysis.
 // whiteList - dictionary of allowed skills (the key is the skill, the value is ignored).
@@ -34,16 +83,27 @@ func (e *Extractor) ExtractSkills(text string, whiteList map[string]int, maxNgra
 		return nil, errors.New("maxNgram must be positive")
 	}
 
+	result := make(map[string]int)
+	for _, segment := range Segments(text) {
+		e.countNgrams(prepareWords(segment), whiteList, maxNgram, result)
+	}
+
+	return result, nil
+}
+
+// prepareWords lowercases the text and splits it into words, dropping characters that cannot be part of a skill.
+func prepareWords(text string) []string {
 	text = strings.ToLower(text)
 
 	preparedText := handlingUnnecessaryCharactersRegex.ReplaceAllString(text, " ")
 	preparedText = handlingSpacesRegex.ReplaceAllString(preparedText, " ")
 	preparedText = strings.TrimSpace(preparedText)
 
-	words := strings.Fields(preparedText)
-
-	result := make(map[string]int)
+	return strings.Fields(preparedText)
+}
 
+// countNgrams adds the white-listed N-grams of the words to result.
+func (e *Extractor) countNgrams(words []string, whiteList map[string]int, maxNgram int, result map[string]int) {
 	var ngramBuilder strings.Builder
 	n := len(words)
 	for i := 0; i < n; i++ {
@@ -58,10 +118,12 @@ func (e *Extractor) ExtractSkills(text string, whiteList map[string]int, maxNgra
 
 			check := strings.TrimSuffix(ngramBuilder.String(), ".")
 			if _, ok := whiteList[check]; ok {
-				result[check]++
+				if e.CountsDocuments() {
+					result[check] = 1
+				} else {
+					result[check]++
+				}
 			}
 		}
 	}
-
-	return result, nil
 }
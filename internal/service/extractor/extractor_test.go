@@ -7,7 +7,7 @@ import (
 )
 
 func TestExtractSkills(t *testing.T) {
-	ext := extractor.New()
+	ext := extractor.New(extractor.ModeMentions)
 
 	tests := []struct {
 		name      string
@@ -129,3 +129,64 @@ func TestExtractSkills(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractSkills_DocumentsMode(t *testing.T) {
+	ext := extractor.New(extractor.ModeDocuments)
+
+	result, err := ext.ExtractSkills("Kafka, kafka и ещё раз Kafka. Go и Kafka Streams", map[string]int{
+		"kafka":         1,
+		"go":            1,
+		"kafka streams": 1,
+	}, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]int{"kafka": 1, "go": 1, "kafka streams": 1}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %d skills, got %d", len(expected), len(result))
+	}
+
+	for k, v := range expected {
+		if result[k] != v {
+			t.Errorf("For skill %s, expected count %d, got %d", k, v, result[k])
+		}
+	}
+}
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		mode            extractor.Mode
+		version         string
+		countsDocuments bool
+	}{
+		{mode: extractor.ModeMentions, version: extractor.Version},
+		{mode: extractor.ModeDocuments, version: extractor.DocumentsVersion, countsDocuments: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			ext := extractor.New(tt.mode)
+
+			if ext.Version() != tt.version {
+				t.Errorf("Expected version %s, got %s", tt.version, ext.Version())
+			}
+
+			if ext.CountsDocuments() != tt.countsDocuments {
+				t.Errorf("Expected CountsDocuments %t, got %t", tt.countsDocuments, ext.CountsDocuments())
+			}
+		})
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	for _, name := range []string{"mentions", "documents", " documents "} {
+		if _, err := extractor.ParseMode(name); err != nil {
+			t.Errorf("Unexpected error for %q: %v", name, err)
+		}
+	}
+
+	if _, err := extractor.ParseMode("tfidf"); err == nil {
+		t.Errorf("Expected error for unknown mode, got nil")
+	}
+}
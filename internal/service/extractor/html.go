@@ -0,0 +1,185 @@
+package extractor
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// blockTags break the text: skills are not looked for across paragraphs, list items, table cells and line breaks.
+// Other tags, such as strong or a, are inline and are dropped without breaking the text.
+var blockTags = map[string]struct{}{
+	"address": {}, "article": {}, "aside": {}, "blockquote": {}, "br": {}, "dd": {}, "div": {}, "dl": {}, "dt": {},
+	"footer": {}, "h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {}, "header": {}, "hr": {}, "li": {},
+	"ol": {}, "p": {}, "pre": {}, "section": {}, "table": {}, "td": {}, "th": {}, "tr": {}, "ul": {},
+}
+
+// skippedTags hold content that is not text of the description.
+var skippedTags = map[string]struct{}{
+	"script": {},
+	"style":  {},
+}
+
+// sentenceTerminators end a sentence when followed by a space or the end of the text. A dot inside a word,
+// as in node.js or .net, does not.
+const sentenceTerminators = ".!?;…"
+
+// Segments converts an HTML vacancy description to plain text segments - sentences, list items, paragraphs -
+// that skills are looked for within. Tags are dropped, entities decoded and whitespace collapsed. Plain text
+// is split into sentences the same way.
+func Segments(description string) []string {
+	return splitSegments(htmlToText(description))
+}
+
+// htmlToText drops tags and decodes entities of the text between them. Block tags and skipped elements are
+// replaced by line breaks.
+func htmlToText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i := 0; i < len(s); {
+		lt := strings.IndexByte(s[i:], '<')
+		if lt < 0 {
+			b.WriteString(html.UnescapeString(s[i:]))
+			break
+		}
+		b.WriteString(html.UnescapeString(s[i : i+lt]))
+		i += lt
+
+		name, closing, end, ok := scanTag(s, i)
+		if !ok {
+			// not a tag, e.g. "a < b"
+			b.WriteByte('<')
+			i++
+			continue
+		}
+		i = end
+
+		if _, skip := skippedTags[name]; skip && !closing {
+			// the text around a skipped element is not joined into one word
+			i = skipElement(s, i, name)
+			b.WriteByte('\n')
+			continue
+		}
+		if _, block := blockTags[name]; block {
+			b.WriteByte('\n')
+		}
+	}
+
+	return b.String()
+}
+
+// scanTag reads the tag, comment or declaration starting at s[start] == '<'. It returns the lowercased tag name
+// (empty for comments and declarations), whether the tag is a closing one and the index right after it.
+func scanTag(s string, start int) (name string, closing bool, end int, ok bool) {
+	i := start + 1
+	if i >= len(s) {
+		return "", false, 0, false
+	}
+
+	switch {
+	case strings.HasPrefix(s[i:], "!--"):
+		if e := strings.Index(s[i+3:], "-->"); e >= 0 {
+			return "", false, i + 3 + e + 3, true
+		}
+		return "", false, len(s), true
+	case s[i] == '!' || s[i] == '?':
+		if e := strings.IndexByte(s[i:], '>'); e >= 0 {
+			return "", false, i + e + 1, true
+		}
+		return "", false, 0, false
+	case s[i] == '/':
+		closing = true
+		i++
+	}
+
+	nameStart := i
+	for i < len(s) && isTagNameByte(s[i]) {
+		i++
+	}
+	if i == nameStart || !isASCIILetter(s[nameStart]) {
+		return "", false, 0, false
+	}
+	name = strings.ToLower(s[nameStart:i])
+
+	// attributes may hold '>' inside quotes
+	var quote byte
+	for ; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return name, closing, i + 1, true
+		}
+	}
+
+	return "", false, 0, false
+}
+
+// skipElement returns the index right after the closing tag of the element, or the end of s if it is not closed.
+func skipElement(s string, from int, name string) int {
+	closingTag := "</" + name
+	lower := strings.ToLower(s[from:])
+
+	e := strings.Index(lower, closingTag)
+	if e < 0 {
+		return len(s)
+	}
+	if gt := strings.IndexByte(lower[e:], '>'); gt >= 0 {
+		return from + e + gt + 1
+	}
+	return len(s)
+}
+
+func isTagNameByte(c byte) bool {
+	return isASCIILetter(c) || (c >= '0' && c <= '9')
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// splitSegments splits the text at line breaks and sentence ends, dropping the terminators and empty segments.
+func splitSegments(text string) []string {
+	var segments []string
+	start := 0
+
+	flush := func(end int) {
+		segment := strings.TrimRight(strings.Join(strings.Fields(text[start:end]), " "), sentenceTerminators)
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		next := i + size
+
+		switch {
+		case r == '\n':
+			flush(i)
+			start = next
+		case strings.ContainsRune(sentenceTerminators, r) && endsSentence(text, next):
+			flush(next)
+			start = next
+		}
+
+		i = next
+	}
+	flush(len(text))
+
+	return segments
+}
+
+func endsSentence(text string, next int) bool {
+	if next >= len(text) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(text[next:])
+	return unicode.IsSpace(r)
+}
@@ -0,0 +1,189 @@
+package extractor_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"psa/internal/service/extractor"
+)
+
+// go test ./internal/service/extractor -run TestSegments_Golden -update перезаписывает эталоны
+var update = flag.Bool("update", false, "update golden files")
+
+// TestSegments_Golden сверяет сегменты описаний вакансий из testdata с эталонами *.golden, по одному сегменту
+// в строке. Описания hh_*.html написаны вручную по разметке поля description вакансий hh.ru, а не скачаны;
+// hh_devops.html повторяет её особенности: <p> внутри <li>, пробелы между тегами, &nbsp; и &quot; вперемешку,
+// <br /> внутри <strong>.
+// Настоящее описание сохраняется так:
+//
+//	curl -s https://api.hh.ru/vacancies/<id> | jq -r .description > testdata/hh_<name>.html
+//
+// затем эталон пересоздаётся с -update и сверяется вручную, как и навыки в TestExtractSkills_HHDescriptions.
+func TestSegments_Golden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil || len(fixtures) == 0 {
+		t.Fatalf("No fixtures found: %v", err)
+	}
+
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".html")
+
+		t.Run(name, func(t *testing.T) {
+			description, err := os.ReadFile(fixture)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			got := strings.Join(extractor.Segments(string(description)), "\n") + "\n"
+
+			golden := strings.TrimSuffix(fixture, ".html") + ".golden"
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("Segments mismatch\nexpected:\n%s\ngot:\n%s", want, got)
+			}
+		})
+	}
+}
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		expected    []string
+	}{
+		{
+			name:        "list items",
+			description: "<ul><li>Python</li><li>Django</li></ul>",
+			expected:    []string{"Python", "Django"},
+		},
+		{
+			name:        "inline tags keep text together",
+			description: "<p>опыт с <strong>Go</strong>lang и <a href=\"https://go.dev\">gRPC</a></p>",
+			expected:    []string{"опыт с Golang и gRPC"},
+		},
+		{
+			name:        "entities",
+			description: "R&amp;D&nbsp;отдел, &laquo;C#&raquo; &#8212; &lt;b&gt;",
+			expected:    []string{"R&D отдел, «C#» — <b>"},
+		},
+		{
+			name:        "line breaks",
+			description: "Go<br>Python<br/>Java<BR />Kotlin",
+			expected:    []string{"Go", "Python", "Java", "Kotlin"},
+		},
+		{
+			name:        "sentences",
+			description: "Пишем на Go. Используем Node.js и .NET! Нужен опыт с C++; знание SQL?",
+			expected:    []string{"Пишем на Go", "Используем Node.js и .NET", "Нужен опыт с C++", "знание SQL"},
+		},
+		{
+			name:        "script, style and comments",
+			description: "<style>li { color: red }</style>Go<script>var kafka = 1;</script><!-- <li>kafka</li> -->Python",
+			expected:    []string{"Go", "Python"},
+		},
+		{
+			name:        "attribute with closing bracket",
+			description: "<span title=\"a > b\">Go</span>",
+			expected:    []string{"Go"},
+		},
+		{
+			name:        "not a tag",
+			description: "3 < 5 лет опыта",
+			expected:    []string{"3 < 5 лет опыта"},
+		},
+		{
+			name:        "empty",
+			description: "<p> </p><ul><li>&nbsp;</li></ul>",
+			expected:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractor.Segments(tt.description); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestExtractSkills_HHDescriptions проверяет, что в N-граммы не попадают теги, сущности и фразы,
+// склеенные через границы пунктов списка.
+func TestExtractSkills_HHDescriptions(t *testing.T) {
+	ext := extractor.New(extractor.ModeMentions)
+
+	whiteList := map[string]int{
+		"go": 1, "grpc": 1, "postgresql": 1, "redis": 1, "kafka": 1, "rabbitmq": 1, "docker": 1, "kubernetes": 1,
+		"ci/cd": 1, "clickhouse": 1, "prometheus": 1, "grafana": 1, "python": 1, "fastapi": 1, "django": 1,
+		"apache airflow": 1, "pandas": 1, "sql": 1, "pytest": 1, "react": 1, ".net": 1, "node.js": 1, "typescript": 1,
+		"asp.net core": 1, "c#": 1, "c++": 1, "javascript/typescript": 1, "git": 1, "jira": 1, "gitlab": 1,
+		"terraform": 1, "ansible": 1,
+		// фразы на границах пунктов списка и имена тегов не должны находиться
+		"kubernetes clickhouse": 1, "django построение": 1, "li": 1, "nbsp": 1, "strong": 1, "vacancy-branded": 1,
+	}
+
+	tests := []struct {
+		fixture  string
+		expected map[string]int
+	}{
+		{
+			fixture: "hh_go_backend.html",
+			expected: map[string]int{
+				"go": 2, "grpc": 1, "postgresql": 2, "redis": 1, "kafka": 1, "rabbitmq": 1, "docker": 1,
+				"kubernetes": 1, "ci/cd": 1, "clickhouse": 1, "prometheus": 1, "grafana": 1,
+			},
+		},
+		{
+			fixture: "hh_python_data.html",
+			expected: map[string]int{
+				"python": 3, "fastapi": 1, "django": 1, "apache airflow": 1, "pandas": 1, "sql": 2,
+				"postgresql": 1, "clickhouse": 1, "pytest": 1,
+			},
+		},
+		{
+			fixture: "hh_fullstack.html",
+			expected: map[string]int{
+				"react": 2, ".net": 1, "node.js": 2, "typescript": 1, "asp.net core": 1, "c#": 2, "c++": 1,
+				"rabbitmq": 1, "javascript/typescript": 1, "git": 1, "jira": 1,
+			},
+		},
+		{
+			fixture: "hh_devops.html",
+			// "Docker-окружений" остаётся одним токеном и docker не даёт
+			expected: map[string]int{
+				"kubernetes": 1, "ci/cd": 1, "gitlab": 1, "prometheus": 1, "grafana": 1, "python": 1,
+				"go": 1, "postgresql": 1, "redis": 1, "terraform": 1, "ansible": 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			description, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			result, err := ext.ExtractSkills(string(description), whiteList, 3)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
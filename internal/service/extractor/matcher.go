@@ -0,0 +1,193 @@
+package extractor
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Matcher is an Aho–Corasick automaton over the skills of a white list. It finds skills of any number of words
+// in a single pass over a text. A skill is matched only as whole words: the characters + # . - / belong to a word,
+// so c does not match c++, net does not match .net and node does not match node.js. A dot ending a word is
+// dropped, as it usually ends a sentence.
+//
+// A Matcher is built once per white list and is safe for concurrent use.
+type Matcher struct {
+	nodes []matcherNode
+}
+
+type matcherNode struct {
+	next map[byte]int32
+	// fail is the node of the longest proper suffix of this node's string that is a prefix of some skill.
+	fail int32
+	// output is the nearest node on the fail chain, this one included, that ends a skill, or -1.
+	output int32
+	// depth is the length of this node's string in bytes.
+	depth int32
+	// skills end at this node; several white-listed spellings may normalize to one string.
+	skills []string
+}
+
+// NewMatcher builds the matcher of the white-listed skills (the key is the skill, the value is ignored).
+// Skills are matched case-insensitively; characters that cannot be part of a skill act as spaces.
+func (e *Extractor) NewMatcher(whiteList map[string]int) (*Matcher, error) {
+	if len(whiteList) == 0 {
+		return nil, errors.New("whiteList cannot be empty")
+	}
+
+	m := &Matcher{nodes: []matcherNode{{output: -1}}}
+	var buf []byte
+	for skill := range whiteList {
+		buf = appendNormalized(buf[:0], skill)
+		if len(buf) > 0 {
+			m.insert(buf, skill)
+		}
+	}
+	m.link()
+
+	return m, nil
+}
+
+func (m *Matcher) insert(pattern []byte, skill string) {
+	state := int32(0)
+	for _, c := range pattern {
+		next, ok := m.nodes[state].next[c]
+		if !ok {
+			next = int32(len(m.nodes))
+			m.nodes = append(m.nodes, matcherNode{output: -1, depth: m.nodes[state].depth + 1})
+			if m.nodes[state].next == nil {
+				m.nodes[state].next = make(map[byte]int32)
+			}
+			m.nodes[state].next[c] = next
+		}
+		state = next
+	}
+	m.nodes[state].skills = append(m.nodes[state].skills, skill)
+}
+
+// link sets the fail and output links breadth-first, so that the links of shorter strings are ready first.
+func (m *Matcher) link() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		node := &m.nodes[state]
+		if len(node.skills) > 0 {
+			node.output = state
+		} else {
+			node.output = m.nodes[node.fail].output
+		}
+
+		for c, child := range node.next {
+			m.nodes[child].fail = m.step(node.fail, c)
+			queue = append(queue, child)
+		}
+	}
+}
+
+// step returns the state after reading c in state, following fail links while there is no transition.
+func (m *Matcher) step(state int32, c byte) int32 {
+	for {
+		if next, ok := m.nodes[state].next[c]; ok {
+			return next
+		}
+		if state == 0 {
+			return 0
+		}
+		state = m.nodes[state].fail
+	}
+}
+
+// find calls found for every whole-word occurrence of a skill in the normalized text.
+func (m *Matcher) find(text []byte, found func(skill string)) {
+	state := int32(0)
+	for i, c := range text {
+		state = m.step(state, c)
+
+		for out := m.nodes[state].output; out >= 0; out = m.nodes[m.nodes[out].fail].output {
+			node := &m.nodes[out]
+			if wordStart(text, i+1-int(node.depth)) && wordEnd(text, i+1) {
+				for _, skill := range node.skills {
+					found(skill)
+				}
+			}
+		}
+	}
+}
+
+// Match returns a dictionary of skills of the matcher found in the text with the number of mentions.
+// In ModeDocuments every found skill is counted once. The text may be HTML; skills are not matched across
+// sentences, list items and other segments of the text, see Segments.
+func (e *Extractor) Match(text string, matcher *Matcher) (map[string]int, error) {
+	if text == "" {
+		return nil, errors.New("text cannot be empty")
+	}
+	if matcher == nil {
+		return nil, errors.New("matcher cannot be nil")
+	}
+
+	var normalized []byte
+	for _, segment := range Segments(text) {
+		// a line break never occurs in a skill, so the automaton does not match across segments
+		if len(normalized) > 0 {
+			normalized = append(normalized, '\n')
+		}
+		normalized = appendNormalized(normalized, segment)
+	}
+
+	result := make(map[string]int)
+	matcher.find(normalized, func(skill string) {
+		if e.CountsDocuments() {
+			result[skill] = 1
+		} else {
+			result[skill]++
+		}
+	})
+
+	return result, nil
+}
+
+// appendNormalized appends the lowercased words of s to dst separated by single spaces. Like prepareWords,
+// it treats every character but letters, digits and . + # - / as a space.
+func appendNormalized(dst []byte, s string) []byte {
+	start := len(dst)
+	space := false
+	for _, r := range s {
+		if !isSkillRune(r) {
+			space = true
+			continue
+		}
+		if space && len(dst) > start {
+			dst = append(dst, ' ')
+		}
+		space = false
+		dst = utf8.AppendRune(dst, unicode.ToLower(r))
+	}
+	return dst
+}
+
+func isSkillRune(r rune) bool {
+	return unicode.IsLetter(r) || (r >= '0' && r <= '9') || strings.ContainsRune(".+#-/", r)
+}
+
+func isWordSeparator(c byte) bool {
+	return c == ' ' || c == '\n'
+}
+
+func wordStart(text []byte, i int) bool {
+	return i == 0 || isWordSeparator(text[i-1])
+}
+
+// wordEnd reports whether a word ends at i, allowing a single dot before the end.
+func wordEnd(text []byte, i int) bool {
+	if i < len(text) && text[i] == '.' {
+		i++
+	}
+	return i == len(text) || isWordSeparator(text[i])
+}
@@ -0,0 +1,238 @@
+package extractor_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"psa/internal/service/extractor"
+)
+
+func TestMatch(t *testing.T) {
+	ext := extractor.New(extractor.ModeMentions)
+
+	tests := []struct {
+		name      string
+		text      string
+		whiteList map[string]int
+		expected  map[string]int
+	}{
+		{
+			name:      "special characters belong to words",
+			text:      "C++, C# и .NET; немного C. Node.js, а не node",
+			whiteList: map[string]int{"c": 1, "c++": 1, "c#": 1, ".net": 1, "net": 1, "node.js": 1, "js": 1},
+			expected:  map[string]int{"c++": 1, "c#": 1, ".net": 1, "c": 1, "node.js": 1},
+		},
+		{
+			name:      "word prefix is not a skill",
+			text:      "Golang, gopher, go-to-market и Go",
+			whiteList: map[string]int{"go": 1},
+			expected:  map[string]int{"go": 1},
+		},
+		{
+			name:      "trailing dot",
+			text:      "Пишем на Go.",
+			whiteList: map[string]int{"go": 1},
+			expected:  map[string]int{"go": 1},
+		},
+		{
+			name:      "multi-word skill longer than three words",
+			text:      "Опыт с Amazon Web Services Elastic Kubernetes Service обязателен",
+			whiteList: map[string]int{"amazon web services elastic kubernetes service": 1, "kubernetes": 1},
+			expected:  map[string]int{"amazon web services elastic kubernetes service": 1, "kubernetes": 1},
+		},
+		{
+			name:      "overlapping skills",
+			text:      "ASP.NET Core и Spring Boot Actuator",
+			whiteList: map[string]int{"asp.net": 1, "asp.net core": 1, "spring boot": 1, "boot actuator": 1},
+			expected:  map[string]int{"asp.net": 1, "asp.net core": 1, "spring boot": 1, "boot actuator": 1},
+		},
+		{
+			name:      "skills are not matched across segments",
+			text:      "<ul><li>Python</li><li>Django</li></ul>Python. Django",
+			whiteList: map[string]int{"python django": 1, "python": 1},
+			expected:  map[string]int{"python": 2},
+		},
+		{
+			name:      "case and punctuation of the white list",
+			text:      "machine learning, Apache  Kafka",
+			whiteList: map[string]int{"Machine Learning": 1, "apache kafka": 1},
+			expected:  map[string]int{"Machine Learning": 1, "apache kafka": 1},
+		},
+		{
+			name:      "repeated mentions",
+			text:      "Kafka, kafka и ещё раз Kafka",
+			whiteList: map[string]int{"kafka": 1},
+			expected:  map[string]int{"kafka": 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := ext.NewMatcher(tt.whiteList)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			result, err := ext.Match(tt.text, matcher)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMatch_DocumentsMode(t *testing.T) {
+	ext := extractor.New(extractor.ModeDocuments)
+
+	matcher, err := ext.NewMatcher(map[string]int{"kafka": 1, "go": 1, "kafka streams": 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result, err := ext.Match("Kafka, kafka и ещё раз Kafka. Go и Kafka Streams", matcher)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]int{"kafka": 1, "go": 1, "kafka streams": 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestMatch_Errors(t *testing.T) {
+	ext := extractor.New(extractor.ModeMentions)
+
+	if _, err := ext.NewMatcher(map[string]int{}); err == nil {
+		t.Errorf("Expected error for empty whitelist, got nil")
+	}
+
+	matcher, err := ext.NewMatcher(map[string]int{"go": 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := ext.Match("", matcher); err == nil {
+		t.Errorf("Expected error for empty text, got nil")
+	}
+	if _, err := ext.Match("Go", nil); err == nil {
+		t.Errorf("Expected error for nil matcher, got nil")
+	}
+}
+
+// TestMatch_SameAsExtractSkills сверяет автомат с N-граммами на описаниях из testdata: для навыков
+// не длиннее maxNgram слов результаты совпадают.
+func TestMatch_SameAsExtractSkills(t *testing.T) {
+	ext := extractor.New(extractor.ModeMentions)
+	whiteList := benchmarkWhiteList()
+
+	matcher, err := ext.NewMatcher(whiteList)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, description := range readFixtures(t) {
+		expected, err := ext.ExtractSkills(description, whiteList, 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		result, err := ext.Match(description, matcher)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	}
+}
+
+// Сравнение с N-граммами: go test ./internal/service/extractor -run '^$' -bench . -benchmem
+
+func BenchmarkExtractSkills(b *testing.B) {
+	ext := extractor.New(extractor.ModeMentions)
+	whiteList := benchmarkWhiteList()
+	descriptions := readFixtures(b)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, description := range descriptions {
+			if _, err := ext.ExtractSkills(description, whiteList, 3); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkMatch(b *testing.B) {
+	ext := extractor.New(extractor.ModeMentions)
+
+	matcher, err := ext.NewMatcher(benchmarkWhiteList())
+	if err != nil {
+		b.Fatal(err)
+	}
+	descriptions := readFixtures(b)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, description := range descriptions {
+			if _, err := ext.Match(description, matcher); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkNewMatcher(b *testing.B) {
+	ext := extractor.New(extractor.ModeMentions)
+	whiteList := benchmarkWhiteList()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := ext.NewMatcher(whiteList); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchmarkWhiteList возвращает белый список размером с белый список профессии: навыки из описаний testdata
+// и сгенерированные.
+func benchmarkWhiteList() map[string]int {
+	whiteList := map[string]int{
+		"go": 1, "grpc": 1, "postgresql": 1, "redis": 1, "kafka": 1, "rabbitmq": 1, "docker": 1, "kubernetes": 1,
+		"ci/cd": 1, "clickhouse": 1, "prometheus": 1, "grafana": 1, "python": 1, "fastapi": 1, "django": 1,
+		"apache airflow": 1, "pandas": 1, "sql": 1, "pytest": 1, "react": 1, ".net": 1, "node.js": 1, "typescript": 1,
+		"asp.net core": 1, "c#": 1, "c++": 1, "javascript/typescript": 1, "git": 1, "jira": 1, "rest": 1, "api": 1,
+		"code review": 1, "redux toolkit": 1, "agile/scrum": 1, "etl": 1, "data science": 1, "ооп": 1,
+	}
+	for i := range 300 {
+		whiteList[fmt.Sprintf("skill%d", i)] = 1
+		whiteList[fmt.Sprintf("framework %d", i)] = 1
+	}
+	return whiteList
+}
+
+func readFixtures(tb testing.TB) []string {
+	tb.Helper()
+
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil || len(fixtures) == 0 {
+		tb.Fatalf("No fixtures found: %v", err)
+	}
+
+	descriptions := make([]string, 0, len(fixtures))
+	for _, fixture := range fixtures {
+		description, err := os.ReadFile(fixture)
+		if err != nil {
+			tb.Fatalf("Unexpected error: %v", err)
+		}
+		descriptions = append(descriptions, string(description))
+	}
+	return descriptions
+}
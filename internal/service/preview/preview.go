@@ -0,0 +1,48 @@
+package preview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"psa/internal/domain"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+type QuerySupplier interface {
+	PreviewQuery(ctx context.Context, query, area string) (domain.QueryPreview, error)
+}
+
+// Previewer shows what a candidate vacancy query finds before it is saved to a profession,
+// so that the query can be tuned without a scrape.
+type Previewer struct {
+	supplier QuerySupplier
+}
+
+func New(supplier QuerySupplier) *Previewer {
+	return &Previewer{
+		supplier: supplier,
+	}
+}
+
+// PreviewQuery returns the total found by the query in the area and a sample of the vacancies.
+func (p *Previewer) PreviewQuery(ctx context.Context, query, area string) (domain.QueryPreview, error) {
+	const op = "service.preview.PreviewQuery"
+	log := loggerctx.FromContext(ctx).With("op", op, "area", area)
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return domain.QueryPreview{}, domain.ErrInvalidProfessionQuery
+	}
+
+	preview, err := p.supplier.PreviewQuery(ctx, query, area)
+	if err != nil {
+		log.Error("query_preview_failed", "query", query, slogx.Err(err))
+		return domain.QueryPreview{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("query_previewed", "query", query, "total_found", preview.TotalFound, "sample_size", len(preview.Vacancies))
+
+	return preview, nil
+}
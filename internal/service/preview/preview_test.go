@@ -0,0 +1,67 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/service/preview/mocks"
+)
+
+func TestPreviewer_PreviewQuery_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	supplier := mocks.NewMockQuerySupplier(t)
+
+	expected := domain.QueryPreview{
+		TotalFound: 1234,
+		Vacancies:  []domain.VacancyPreview{{ID: "101", Name: "Go Developer"}},
+	}
+	// запрос передаётся без пробелов по краям
+	supplier.EXPECT().PreviewQuery(ctx, "golang NOT 1С", "1").Return(expected, nil)
+
+	// Act
+	preview, err := New(supplier).PreviewQuery(ctx, "  golang NOT 1С ", "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expected, preview)
+}
+
+func TestPreviewer_PreviewQuery_EmptyQuery(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	supplier := mocks.NewMockQuerySupplier(t)
+
+	// Act
+	_, err := New(supplier).PreviewQuery(context.Background(), "   ", "113")
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrInvalidProfessionQuery)
+}
+
+func TestPreviewer_PreviewQuery_SupplierError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	supplier := mocks.NewMockQuerySupplier(t)
+
+	supplierErr := errors.New("hh unavailable")
+	supplier.EXPECT().PreviewQuery(ctx, "golang", "113").Return(domain.QueryPreview{}, supplierErr)
+
+	// Act
+	_, err := New(supplier).PreviewQuery(ctx, "golang", "113")
+
+	// Assert
+	require.ErrorIs(t, err, supplierErr)
+}
@@ -40,8 +40,8 @@ func (_m *MockCacheProvider) EXPECT() *MockCacheProvider_Expecter {
 }
 
 // GetProfessionData provides a mock function for the type MockCacheProvider
-func (_mock *MockCacheProvider) GetProfessionData(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionDetail, error) {
-	ret := _mock.Called(ctx, professionID)
+func (_mock *MockCacheProvider) GetProfessionData(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionDetail, error) {
+	ret := _mock.Called(ctx, professionID, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProfessionData")
@@ -49,18 +49,18 @@ func (_mock *MockCacheProvider) GetProfessionData(ctx context.Context, professio
 
 	var r0 *domain.ProfessionDetail
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ProfessionDetail, error)); ok {
-		return returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*domain.ProfessionDetail, error)); ok {
+		return returnFunc(ctx, professionID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ProfessionDetail); ok {
-		r0 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *domain.ProfessionDetail); ok {
+		r0 = returnFunc(ctx, professionID, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.ProfessionDetail)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,11 +75,12 @@ type MockCacheProvider_GetProfessionData_Call struct {
 // GetProfessionData is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
-func (_e *MockCacheProvider_Expecter) GetProfessionData(ctx interface{}, professionID interface{}) *MockCacheProvider_GetProfessionData_Call {
-	return &MockCacheProvider_GetProfessionData_Call{Call: _e.mock.On("GetProfessionData", ctx, professionID)}
+//   - area string
+func (_e *MockCacheProvider_Expecter) GetProfessionData(ctx interface{}, professionID interface{}, area interface{}) *MockCacheProvider_GetProfessionData_Call {
+	return &MockCacheProvider_GetProfessionData_Call{Call: _e.mock.On("GetProfessionData", ctx, professionID, area)}
 }
 
-func (_c *MockCacheProvider_GetProfessionData_Call) Run(run func(ctx context.Context, professionID uuid.UUID)) *MockCacheProvider_GetProfessionData_Call {
+func (_c *MockCacheProvider_GetProfessionData_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string)) *MockCacheProvider_GetProfessionData_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,9 +90,14 @@ func (_c *MockCacheProvider_GetProfessionData_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -102,14 +108,14 @@ func (_c *MockCacheProvider_GetProfessionData_Call) Return(professionDetail *dom
 	return _c
 }
 
-func (_c *MockCacheProvider_GetProfessionData_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionDetail, error)) *MockCacheProvider_GetProfessionData_Call {
+func (_c *MockCacheProvider_GetProfessionData_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionDetail, error)) *MockCacheProvider_GetProfessionData_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetProfessionTrend provides a mock function for the type MockCacheProvider
-func (_mock *MockCacheProvider) GetProfessionTrend(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error) {
-	ret := _mock.Called(ctx, professionID)
+func (_mock *MockCacheProvider) GetProfessionTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error) {
+	ret := _mock.Called(ctx, professionID, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProfessionTrend")
@@ -117,18 +123,18 @@ func (_mock *MockCacheProvider) GetProfessionTrend(ctx context.Context, professi
 
 	var r0 *domain.ProfessionTrend
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.ProfessionTrend, error)); ok {
-		return returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*domain.ProfessionTrend, error)); ok {
+		return returnFunc(ctx, professionID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.ProfessionTrend); ok {
-		r0 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *domain.ProfessionTrend); ok {
+		r0 = returnFunc(ctx, professionID, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.ProfessionTrend)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -143,11 +149,12 @@ type MockCacheProvider_GetProfessionTrend_Call struct {
 // GetProfessionTrend is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
-func (_e *MockCacheProvider_Expecter) GetProfessionTrend(ctx interface{}, professionID interface{}) *MockCacheProvider_GetProfessionTrend_Call {
-	return &MockCacheProvider_GetProfessionTrend_Call{Call: _e.mock.On("GetProfessionTrend", ctx, professionID)}
+//   - area string
+func (_e *MockCacheProvider_Expecter) GetProfessionTrend(ctx interface{}, professionID interface{}, area interface{}) *MockCacheProvider_GetProfessionTrend_Call {
+	return &MockCacheProvider_GetProfessionTrend_Call{Call: _e.mock.On("GetProfessionTrend", ctx, professionID, area)}
 }
 
-func (_c *MockCacheProvider_GetProfessionTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID)) *MockCacheProvider_GetProfessionTrend_Call {
+func (_c *MockCacheProvider_GetProfessionTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string)) *MockCacheProvider_GetProfessionTrend_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -157,9 +164,14 @@ func (_c *MockCacheProvider_GetProfessionTrend_Call) Run(run func(ctx context.Co
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -170,7 +182,7 @@ func (_c *MockCacheProvider_GetProfessionTrend_Call) Return(professionTrend *dom
 	return _c
 }
 
-func (_c *MockCacheProvider_GetProfessionTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error)) *MockCacheProvider_GetProfessionTrend_Call {
+func (_c *MockCacheProvider_GetProfessionTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error)) *MockCacheProvider_GetProfessionTrend_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -295,16 +307,16 @@ func (_c *MockCacheProvider_SaveProfessionData_Call) RunAndReturn(run func(ctx c
 }
 
 // SaveProfessionTrend provides a mock function for the type MockCacheProvider
-func (_mock *MockCacheProvider) SaveProfessionTrend(ctx context.Context, professionID uuid.UUID, trend *domain.ProfessionTrend) error {
-	ret := _mock.Called(ctx, professionID, trend)
+func (_mock *MockCacheProvider) SaveProfessionTrend(ctx context.Context, professionID uuid.UUID, area string, trend *domain.ProfessionTrend) error {
+	ret := _mock.Called(ctx, professionID, area, trend)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveProfessionTrend")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *domain.ProfessionTrend) error); ok {
-		r0 = returnFunc(ctx, professionID, trend)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *domain.ProfessionTrend) error); ok {
+		r0 = returnFunc(ctx, professionID, area, trend)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -319,12 +331,13 @@ type MockCacheProvider_SaveProfessionTrend_Call struct {
 // SaveProfessionTrend is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
+//   - area string
 //   - trend *domain.ProfessionTrend
-func (_e *MockCacheProvider_Expecter) SaveProfessionTrend(ctx interface{}, professionID interface{}, trend interface{}) *MockCacheProvider_SaveProfessionTrend_Call {
-	return &MockCacheProvider_SaveProfessionTrend_Call{Call: _e.mock.On("SaveProfessionTrend", ctx, professionID, trend)}
+func (_e *MockCacheProvider_Expecter) SaveProfessionTrend(ctx interface{}, professionID interface{}, area interface{}, trend interface{}) *MockCacheProvider_SaveProfessionTrend_Call {
+	return &MockCacheProvider_SaveProfessionTrend_Call{Call: _e.mock.On("SaveProfessionTrend", ctx, professionID, area, trend)}
 }
 
-func (_c *MockCacheProvider_SaveProfessionTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID, trend *domain.ProfessionTrend)) *MockCacheProvider_SaveProfessionTrend_Call {
+func (_c *MockCacheProvider_SaveProfessionTrend_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string, trend *domain.ProfessionTrend)) *MockCacheProvider_SaveProfessionTrend_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -334,14 +347,19 @@ func (_c *MockCacheProvider_SaveProfessionTrend_Call) Run(run func(ctx context.C
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 *domain.ProfessionTrend
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(*domain.ProfessionTrend)
+			arg2 = args[2].(string)
+		}
+		var arg3 *domain.ProfessionTrend
+		if args[3] != nil {
+			arg3 = args[3].(*domain.ProfessionTrend)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -352,7 +370,7 @@ func (_c *MockCacheProvider_SaveProfessionTrend_Call) Return(err error) *MockCac
 	return _c
 }
 
-func (_c *MockCacheProvider_SaveProfessionTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, trend *domain.ProfessionTrend) error) *MockCacheProvider_SaveProfessionTrend_Call {
+func (_c *MockCacheProvider_SaveProfessionTrend_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string, trend *domain.ProfessionTrend) error) *MockCacheProvider_SaveProfessionTrend_Call {
 	_c.Call.Return(run)
 	return _c
 }
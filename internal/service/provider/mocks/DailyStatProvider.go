@@ -40,8 +40,8 @@ func (_m *MockDailyStatProvider) EXPECT() *MockDailyStatProvider_Expecter {
 }
 
 // GetStatDailyByProfessionID provides a mock function for the type MockDailyStatProvider
-func (_mock *MockDailyStatProvider) GetStatDailyByProfessionID(ctx context.Context, professionID uuid.UUID) ([]domain.StatDailyPoint, error) {
-	ret := _mock.Called(ctx, professionID)
+func (_mock *MockDailyStatProvider) GetStatDailyByProfessionID(ctx context.Context, professionID uuid.UUID, area string) ([]domain.StatDailyPoint, error) {
+	ret := _mock.Called(ctx, professionID, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetStatDailyByProfessionID")
@@ -49,18 +49,18 @@ func (_mock *MockDailyStatProvider) GetStatDailyByProfessionID(ctx context.Conte
 
 	var r0 []domain.StatDailyPoint
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.StatDailyPoint, error)); ok {
-		return returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]domain.StatDailyPoint, error)); ok {
+		return returnFunc(ctx, professionID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.StatDailyPoint); ok {
-		r0 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []domain.StatDailyPoint); ok {
+		r0 = returnFunc(ctx, professionID, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.StatDailyPoint)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,11 +75,12 @@ type MockDailyStatProvider_GetStatDailyByProfessionID_Call struct {
 // GetStatDailyByProfessionID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
-func (_e *MockDailyStatProvider_Expecter) GetStatDailyByProfessionID(ctx interface{}, professionID interface{}) *MockDailyStatProvider_GetStatDailyByProfessionID_Call {
-	return &MockDailyStatProvider_GetStatDailyByProfessionID_Call{Call: _e.mock.On("GetStatDailyByProfessionID", ctx, professionID)}
+//   - area string
+func (_e *MockDailyStatProvider_Expecter) GetStatDailyByProfessionID(ctx interface{}, professionID interface{}, area interface{}) *MockDailyStatProvider_GetStatDailyByProfessionID_Call {
+	return &MockDailyStatProvider_GetStatDailyByProfessionID_Call{Call: _e.mock.On("GetStatDailyByProfessionID", ctx, professionID, area)}
 }
 
-func (_c *MockDailyStatProvider_GetStatDailyByProfessionID_Call) Run(run func(ctx context.Context, professionID uuid.UUID)) *MockDailyStatProvider_GetStatDailyByProfessionID_Call {
+func (_c *MockDailyStatProvider_GetStatDailyByProfessionID_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string)) *MockDailyStatProvider_GetStatDailyByProfessionID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,9 +90,14 @@ func (_c *MockDailyStatProvider_GetStatDailyByProfessionID_Call) Run(run func(ct
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -102,14 +108,14 @@ func (_c *MockDailyStatProvider_GetStatDailyByProfessionID_Call) Return(statDail
 	return _c
 }
 
-func (_c *MockDailyStatProvider_GetStatDailyByProfessionID_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID) ([]domain.StatDailyPoint, error)) *MockDailyStatProvider_GetStatDailyByProfessionID_Call {
+func (_c *MockDailyStatProvider_GetStatDailyByProfessionID_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string) ([]domain.StatDailyPoint, error)) *MockDailyStatProvider_GetStatDailyByProfessionID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetStatDailyByProfessionIDs provides a mock function for the type MockDailyStatProvider
-func (_mock *MockDailyStatProvider) GetStatDailyByProfessionIDs(ctx context.Context, professionIDs []uuid.UUID) (map[uuid.UUID][]domain.StatDailyPoint, error) {
-	ret := _mock.Called(ctx, professionIDs)
+func (_mock *MockDailyStatProvider) GetStatDailyByProfessionIDs(ctx context.Context, professionIDs []uuid.UUID, area string) (map[uuid.UUID][]domain.StatDailyPoint, error) {
+	ret := _mock.Called(ctx, professionIDs, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetStatDailyByProfessionIDs")
@@ -117,18 +123,18 @@ func (_mock *MockDailyStatProvider) GetStatDailyByProfessionIDs(ctx context.Cont
 
 	var r0 map[uuid.UUID][]domain.StatDailyPoint
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID][]domain.StatDailyPoint, error)); ok {
-		return returnFunc(ctx, professionIDs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, string) (map[uuid.UUID][]domain.StatDailyPoint, error)); ok {
+		return returnFunc(ctx, professionIDs, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID][]domain.StatDailyPoint); ok {
-		r0 = returnFunc(ctx, professionIDs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, string) map[uuid.UUID][]domain.StatDailyPoint); ok {
+		r0 = returnFunc(ctx, professionIDs, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[uuid.UUID][]domain.StatDailyPoint)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionIDs)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionIDs, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -143,11 +149,12 @@ type MockDailyStatProvider_GetStatDailyByProfessionIDs_Call struct {
 // GetStatDailyByProfessionIDs is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionIDs []uuid.UUID
-func (_e *MockDailyStatProvider_Expecter) GetStatDailyByProfessionIDs(ctx interface{}, professionIDs interface{}) *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call {
-	return &MockDailyStatProvider_GetStatDailyByProfessionIDs_Call{Call: _e.mock.On("GetStatDailyByProfessionIDs", ctx, professionIDs)}
+//   - area string
+func (_e *MockDailyStatProvider_Expecter) GetStatDailyByProfessionIDs(ctx interface{}, professionIDs interface{}, area interface{}) *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call {
+	return &MockDailyStatProvider_GetStatDailyByProfessionIDs_Call{Call: _e.mock.On("GetStatDailyByProfessionIDs", ctx, professionIDs, area)}
 }
 
-func (_c *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call) Run(run func(ctx context.Context, professionIDs []uuid.UUID)) *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call {
+func (_c *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call) Run(run func(ctx context.Context, professionIDs []uuid.UUID, area string)) *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -157,9 +164,14 @@ func (_c *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call) Run(run func(c
 		if args[1] != nil {
 			arg1 = args[1].([]uuid.UUID)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -170,7 +182,7 @@ func (_c *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call) Return(uUIDToS
 	return _c
 }
 
-func (_c *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call) RunAndReturn(run func(ctx context.Context, professionIDs []uuid.UUID) (map[uuid.UUID][]domain.StatDailyPoint, error)) *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call {
+func (_c *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call) RunAndReturn(run func(ctx context.Context, professionIDs []uuid.UUID, area string) (map[uuid.UUID][]domain.StatDailyPoint, error)) *MockDailyStatProvider_GetStatDailyByProfessionIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
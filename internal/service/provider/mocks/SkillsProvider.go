@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 	"psa/internal/domain"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -39,9 +40,77 @@ func (_m *MockSkillsProvider) EXPECT() *MockSkillsProvider_Expecter {
 	return &MockSkillsProvider_Expecter{mock: &_m.Mock}
 }
 
+// GetCatalogSkillsByNames provides a mock function for the type MockSkillsProvider
+func (_mock *MockSkillsProvider) GetCatalogSkillsByNames(ctx context.Context, names []string) ([]domain.CatalogSkill, error) {
+	ret := _mock.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCatalogSkillsByNames")
+	}
+
+	var r0 []domain.CatalogSkill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.CatalogSkill, error)); ok {
+		return returnFunc(ctx, names)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.CatalogSkill); ok {
+		r0 = returnFunc(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CatalogSkill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillsProvider_GetCatalogSkillsByNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCatalogSkillsByNames'
+type MockSkillsProvider_GetCatalogSkillsByNames_Call struct {
+	*mock.Call
+}
+
+// GetCatalogSkillsByNames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *MockSkillsProvider_Expecter) GetCatalogSkillsByNames(ctx interface{}, names interface{}) *MockSkillsProvider_GetCatalogSkillsByNames_Call {
+	return &MockSkillsProvider_GetCatalogSkillsByNames_Call{Call: _e.mock.On("GetCatalogSkillsByNames", ctx, names)}
+}
+
+func (_c *MockSkillsProvider_GetCatalogSkillsByNames_Call) Run(run func(ctx context.Context, names []string)) *MockSkillsProvider_GetCatalogSkillsByNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetCatalogSkillsByNames_Call) Return(catalogSkills []domain.CatalogSkill, err error) *MockSkillsProvider_GetCatalogSkillsByNames_Call {
+	_c.Call.Return(catalogSkills, err)
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetCatalogSkillsByNames_Call) RunAndReturn(run func(ctx context.Context, names []string) ([]domain.CatalogSkill, error)) *MockSkillsProvider_GetCatalogSkillsByNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExtractedSkillsByProfessionAndDate provides a mock function for the type MockSkillsProvider
-func (_mock *MockSkillsProvider) GetExtractedSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID) ([]domain.Skill, error) {
-	ret := _mock.Called(ctx, professionID, scrapedAtID)
+func (_mock *MockSkillsProvider) GetExtractedSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string) ([]domain.Skill, error) {
+	ret := _mock.Called(ctx, professionID, scrapedAtID, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetExtractedSkillsByProfessionAndDate")
@@ -49,18 +118,18 @@ func (_mock *MockSkillsProvider) GetExtractedSkillsByProfessionAndDate(ctx conte
 
 	var r0 []domain.Skill
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]domain.Skill, error)); ok {
-		return returnFunc(ctx, professionID, scrapedAtID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) ([]domain.Skill, error)); ok {
+		return returnFunc(ctx, professionID, scrapedAtID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []domain.Skill); ok {
-		r0 = returnFunc(ctx, professionID, scrapedAtID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) []domain.Skill); ok {
+		r0 = returnFunc(ctx, professionID, scrapedAtID, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Skill)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID, scrapedAtID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, scrapedAtID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -76,11 +145,12 @@ type MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call struct {
 //   - ctx context.Context
 //   - professionID uuid.UUID
 //   - scrapedAtID uuid.UUID
-func (_e *MockSkillsProvider_Expecter) GetExtractedSkillsByProfessionAndDate(ctx interface{}, professionID interface{}, scrapedAtID interface{}) *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call {
-	return &MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call{Call: _e.mock.On("GetExtractedSkillsByProfessionAndDate", ctx, professionID, scrapedAtID)}
+//   - area string
+func (_e *MockSkillsProvider_Expecter) GetExtractedSkillsByProfessionAndDate(ctx interface{}, professionID interface{}, scrapedAtID interface{}, area interface{}) *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call {
+	return &MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call{Call: _e.mock.On("GetExtractedSkillsByProfessionAndDate", ctx, professionID, scrapedAtID, area)}
 }
 
-func (_c *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call) Run(run func(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID)) *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call {
+func (_c *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call) Run(run func(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string)) *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -94,10 +164,15 @@ func (_c *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call) Run(run
 		if args[2] != nil {
 			arg2 = args[2].(uuid.UUID)
 		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -108,14 +183,100 @@ func (_c *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call) Return(
 	return _c
 }
 
-func (_c *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID) ([]domain.Skill, error)) *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call {
+func (_c *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string) ([]domain.Skill, error)) *MockSkillsProvider_GetExtractedSkillsByProfessionAndDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExtractedSkillsWithDatesByProfessionAndDateRange provides a mock function for the type MockSkillsProvider
+func (_mock *MockSkillsProvider) GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx context.Context, professionID uuid.UUID, area string, from time.Time, to time.Time) ([]domain.SkillSnapshot, error) {
+	ret := _mock.Called(ctx, professionID, area, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExtractedSkillsWithDatesByProfessionAndDateRange")
+	}
+
+	var r0 []domain.SkillSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time) ([]domain.SkillSnapshot, error)); ok {
+		return returnFunc(ctx, professionID, area, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time) []domain.SkillSnapshot); ok {
+		r0 = returnFunc(ctx, professionID, area, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SkillSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, professionID, area, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExtractedSkillsWithDatesByProfessionAndDateRange'
+type MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call struct {
+	*mock.Call
+}
+
+// GetExtractedSkillsWithDatesByProfessionAndDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionID uuid.UUID
+//   - area string
+//   - from time.Time
+//   - to time.Time
+func (_e *MockSkillsProvider_Expecter) GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx interface{}, professionID interface{}, area interface{}, from interface{}, to interface{}) *MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call {
+	return &MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call{Call: _e.mock.On("GetExtractedSkillsWithDatesByProfessionAndDateRange", ctx, professionID, area, from, to)}
+}
+
+func (_c *MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string, from time.Time, to time.Time)) *MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call) Return(skillSnapshots []domain.SkillSnapshot, err error) *MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call {
+	_c.Call.Return(skillSnapshots, err)
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string, from time.Time, to time.Time) ([]domain.SkillSnapshot, error)) *MockSkillsProvider_GetExtractedSkillsWithDatesByProfessionAndDateRange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetFormalSkillsByProfessionAndDate provides a mock function for the type MockSkillsProvider
-func (_mock *MockSkillsProvider) GetFormalSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID) ([]domain.Skill, error) {
-	ret := _mock.Called(ctx, professionID, scrapedAtID)
+func (_mock *MockSkillsProvider) GetFormalSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string) ([]domain.Skill, error) {
+	ret := _mock.Called(ctx, professionID, scrapedAtID, area)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetFormalSkillsByProfessionAndDate")
@@ -123,18 +284,18 @@ func (_mock *MockSkillsProvider) GetFormalSkillsByProfessionAndDate(ctx context.
 
 	var r0 []domain.Skill
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]domain.Skill, error)); ok {
-		return returnFunc(ctx, professionID, scrapedAtID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) ([]domain.Skill, error)); ok {
+		return returnFunc(ctx, professionID, scrapedAtID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []domain.Skill); ok {
-		r0 = returnFunc(ctx, professionID, scrapedAtID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) []domain.Skill); ok {
+		r0 = returnFunc(ctx, professionID, scrapedAtID, area)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Skill)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID, scrapedAtID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, scrapedAtID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,11 +311,12 @@ type MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call struct {
 //   - ctx context.Context
 //   - professionID uuid.UUID
 //   - scrapedAtID uuid.UUID
-func (_e *MockSkillsProvider_Expecter) GetFormalSkillsByProfessionAndDate(ctx interface{}, professionID interface{}, scrapedAtID interface{}) *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call {
-	return &MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call{Call: _e.mock.On("GetFormalSkillsByProfessionAndDate", ctx, professionID, scrapedAtID)}
+//   - area string
+func (_e *MockSkillsProvider_Expecter) GetFormalSkillsByProfessionAndDate(ctx interface{}, professionID interface{}, scrapedAtID interface{}, area interface{}) *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call {
+	return &MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call{Call: _e.mock.On("GetFormalSkillsByProfessionAndDate", ctx, professionID, scrapedAtID, area)}
 }
 
-func (_c *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call) Run(run func(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID)) *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call {
+func (_c *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call) Run(run func(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string)) *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -168,10 +330,15 @@ func (_c *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call) Run(run fu
 		if args[2] != nil {
 			arg2 = args[2].(uuid.UUID)
 		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -182,7 +349,253 @@ func (_c *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call) Return(ski
 	return _c
 }
 
-func (_c *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID) ([]domain.Skill, error)) *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call {
+func (_c *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string) ([]domain.Skill, error)) *MockSkillsProvider_GetFormalSkillsByProfessionAndDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFormalSkillsByProfessionsAndSession provides a mock function for the type MockSkillsProvider
+func (_mock *MockSkillsProvider) GetFormalSkillsByProfessionsAndSession(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string) (map[uuid.UUID][]domain.Skill, error) {
+	ret := _mock.Called(ctx, professionIDs, sessionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFormalSkillsByProfessionsAndSession")
+	}
+
+	var r0 map[uuid.UUID][]domain.Skill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, string) (map[uuid.UUID][]domain.Skill, error)); ok {
+		return returnFunc(ctx, professionIDs, sessionID, area)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, string) map[uuid.UUID][]domain.Skill); ok {
+		r0 = returnFunc(ctx, professionIDs, sessionID, area)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]domain.Skill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionIDs, sessionID, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFormalSkillsByProfessionsAndSession'
+type MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call struct {
+	*mock.Call
+}
+
+// GetFormalSkillsByProfessionsAndSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionIDs []uuid.UUID
+//   - sessionID uuid.UUID
+//   - area string
+func (_e *MockSkillsProvider_Expecter) GetFormalSkillsByProfessionsAndSession(ctx interface{}, professionIDs interface{}, sessionID interface{}, area interface{}) *MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call {
+	return &MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call{Call: _e.mock.On("GetFormalSkillsByProfessionsAndSession", ctx, professionIDs, sessionID, area)}
+}
+
+func (_c *MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call) Run(run func(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string)) *MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call) Return(uUIDToSkills map[uuid.UUID][]domain.Skill, err error) *MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call {
+	_c.Call.Return(uUIDToSkills, err)
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call) RunAndReturn(run func(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string) (map[uuid.UUID][]domain.Skill, error)) *MockSkillsProvider_GetFormalSkillsByProfessionsAndSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFormalSkillsWithDatesByProfessionAndDateRange provides a mock function for the type MockSkillsProvider
+func (_mock *MockSkillsProvider) GetFormalSkillsWithDatesByProfessionAndDateRange(ctx context.Context, professionID uuid.UUID, area string, from time.Time, to time.Time) ([]domain.SkillSnapshot, error) {
+	ret := _mock.Called(ctx, professionID, area, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFormalSkillsWithDatesByProfessionAndDateRange")
+	}
+
+	var r0 []domain.SkillSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time) ([]domain.SkillSnapshot, error)); ok {
+		return returnFunc(ctx, professionID, area, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time, time.Time) []domain.SkillSnapshot); ok {
+		r0 = returnFunc(ctx, professionID, area, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SkillSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, professionID, area, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFormalSkillsWithDatesByProfessionAndDateRange'
+type MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call struct {
+	*mock.Call
+}
+
+// GetFormalSkillsWithDatesByProfessionAndDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionID uuid.UUID
+//   - area string
+//   - from time.Time
+//   - to time.Time
+func (_e *MockSkillsProvider_Expecter) GetFormalSkillsWithDatesByProfessionAndDateRange(ctx interface{}, professionID interface{}, area interface{}, from interface{}, to interface{}) *MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call {
+	return &MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call{Call: _e.mock.On("GetFormalSkillsWithDatesByProfessionAndDateRange", ctx, professionID, area, from, to)}
+}
+
+func (_c *MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string, from time.Time, to time.Time)) *MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call) Return(skillSnapshots []domain.SkillSnapshot, err error) *MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call {
+	_c.Call.Return(skillSnapshots, err)
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string, from time.Time, to time.Time) ([]domain.SkillSnapshot, error)) *MockSkillsProvider_GetFormalSkillsWithDatesByProfessionAndDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSkillSalariesByProfessionAndSession provides a mock function for the type MockSkillsProvider
+func (_mock *MockSkillsProvider) GetSkillSalariesByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) ([]domain.SkillSalary, error) {
+	ret := _mock.Called(ctx, professionID, sessionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSkillSalariesByProfessionAndSession")
+	}
+
+	var r0 []domain.SkillSalary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) ([]domain.SkillSalary, error)); ok {
+		return returnFunc(ctx, professionID, sessionID, area)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) []domain.SkillSalary); ok {
+		r0 = returnFunc(ctx, professionID, sessionID, area)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SkillSalary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, sessionID, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSkillSalariesByProfessionAndSession'
+type MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call struct {
+	*mock.Call
+}
+
+// GetSkillSalariesByProfessionAndSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionID uuid.UUID
+//   - sessionID uuid.UUID
+//   - area string
+func (_e *MockSkillsProvider_Expecter) GetSkillSalariesByProfessionAndSession(ctx interface{}, professionID interface{}, sessionID interface{}, area interface{}) *MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call {
+	return &MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call{Call: _e.mock.On("GetSkillSalariesByProfessionAndSession", ctx, professionID, sessionID, area)}
+}
+
+func (_c *MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call) Run(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string)) *MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call) Return(skillSalarys []domain.SkillSalary, err error) *MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call {
+	_c.Call.Return(skillSalarys, err)
+	return _c
+}
+
+func (_c *MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) ([]domain.SkillSalary, error)) *MockSkillsProvider_GetSkillSalariesByProfessionAndSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
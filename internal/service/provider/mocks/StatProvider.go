@@ -39,45 +39,277 @@ func (_m *MockStatProvider) EXPECT() *MockStatProvider_Expecter {
 	return &MockStatProvider_Expecter{mock: &_m.Mock}
 }
 
-// GetLatestStatByProfessionID provides a mock function for the type MockStatProvider
-func (_mock *MockStatProvider) GetLatestStatByProfessionID(ctx context.Context, professionID uuid.UUID) (domain.Stat, error) {
-	ret := _mock.Called(ctx, professionID)
+// GetProfessionRunByProfessionAndSession provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) GetProfessionRunByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.FetchStats, error) {
+	ret := _mock.Called(ctx, professionID, sessionID, area)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatestStatByProfessionID")
+		panic("no return value specified for GetProfessionRunByProfessionAndSession")
+	}
+
+	var r0 domain.FetchStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (domain.FetchStats, error)); ok {
+		return returnFunc(ctx, professionID, sessionID, area)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) domain.FetchStats); ok {
+		r0 = returnFunc(ctx, professionID, sessionID, area)
+	} else {
+		r0 = ret.Get(0).(domain.FetchStats)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, sessionID, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatProvider_GetProfessionRunByProfessionAndSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfessionRunByProfessionAndSession'
+type MockStatProvider_GetProfessionRunByProfessionAndSession_Call struct {
+	*mock.Call
+}
+
+// GetProfessionRunByProfessionAndSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionID uuid.UUID
+//   - sessionID uuid.UUID
+//   - area string
+func (_e *MockStatProvider_Expecter) GetProfessionRunByProfessionAndSession(ctx interface{}, professionID interface{}, sessionID interface{}, area interface{}) *MockStatProvider_GetProfessionRunByProfessionAndSession_Call {
+	return &MockStatProvider_GetProfessionRunByProfessionAndSession_Call{Call: _e.mock.On("GetProfessionRunByProfessionAndSession", ctx, professionID, sessionID, area)}
+}
+
+func (_c *MockStatProvider_GetProfessionRunByProfessionAndSession_Call) Run(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string)) *MockStatProvider_GetProfessionRunByProfessionAndSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatProvider_GetProfessionRunByProfessionAndSession_Call) Return(fetchStats domain.FetchStats, err error) *MockStatProvider_GetProfessionRunByProfessionAndSession_Call {
+	_c.Call.Return(fetchStats, err)
+	return _c
+}
+
+func (_c *MockStatProvider_GetProfessionRunByProfessionAndSession_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.FetchStats, error)) *MockStatProvider_GetProfessionRunByProfessionAndSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSalaryStatByProfessionAndSession provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) GetSalaryStatByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.SalaryStat, error) {
+	ret := _mock.Called(ctx, professionID, sessionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSalaryStatByProfessionAndSession")
+	}
+
+	var r0 domain.SalaryStat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (domain.SalaryStat, error)); ok {
+		return returnFunc(ctx, professionID, sessionID, area)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) domain.SalaryStat); ok {
+		r0 = returnFunc(ctx, professionID, sessionID, area)
+	} else {
+		r0 = ret.Get(0).(domain.SalaryStat)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, sessionID, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatProvider_GetSalaryStatByProfessionAndSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSalaryStatByProfessionAndSession'
+type MockStatProvider_GetSalaryStatByProfessionAndSession_Call struct {
+	*mock.Call
+}
+
+// GetSalaryStatByProfessionAndSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionID uuid.UUID
+//   - sessionID uuid.UUID
+//   - area string
+func (_e *MockStatProvider_Expecter) GetSalaryStatByProfessionAndSession(ctx interface{}, professionID interface{}, sessionID interface{}, area interface{}) *MockStatProvider_GetSalaryStatByProfessionAndSession_Call {
+	return &MockStatProvider_GetSalaryStatByProfessionAndSession_Call{Call: _e.mock.On("GetSalaryStatByProfessionAndSession", ctx, professionID, sessionID, area)}
+}
+
+func (_c *MockStatProvider_GetSalaryStatByProfessionAndSession_Call) Run(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string)) *MockStatProvider_GetSalaryStatByProfessionAndSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatProvider_GetSalaryStatByProfessionAndSession_Call) Return(salaryStat domain.SalaryStat, err error) *MockStatProvider_GetSalaryStatByProfessionAndSession_Call {
+	_c.Call.Return(salaryStat, err)
+	return _c
+}
+
+func (_c *MockStatProvider_GetSalaryStatByProfessionAndSession_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.SalaryStat, error)) *MockStatProvider_GetSalaryStatByProfessionAndSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSalaryStatsByProfessionID provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) GetSalaryStatsByProfessionID(ctx context.Context, professionID uuid.UUID, area string) ([]domain.SalaryTrendPoint, error) {
+	ret := _mock.Called(ctx, professionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSalaryStatsByProfessionID")
+	}
+
+	var r0 []domain.SalaryTrendPoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]domain.SalaryTrendPoint, error)); ok {
+		return returnFunc(ctx, professionID, area)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []domain.SalaryTrendPoint); ok {
+		r0 = returnFunc(ctx, professionID, area)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SalaryTrendPoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatProvider_GetSalaryStatsByProfessionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSalaryStatsByProfessionID'
+type MockStatProvider_GetSalaryStatsByProfessionID_Call struct {
+	*mock.Call
+}
+
+// GetSalaryStatsByProfessionID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionID uuid.UUID
+//   - area string
+func (_e *MockStatProvider_Expecter) GetSalaryStatsByProfessionID(ctx interface{}, professionID interface{}, area interface{}) *MockStatProvider_GetSalaryStatsByProfessionID_Call {
+	return &MockStatProvider_GetSalaryStatsByProfessionID_Call{Call: _e.mock.On("GetSalaryStatsByProfessionID", ctx, professionID, area)}
+}
+
+func (_c *MockStatProvider_GetSalaryStatsByProfessionID_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string)) *MockStatProvider_GetSalaryStatsByProfessionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatProvider_GetSalaryStatsByProfessionID_Call) Return(salaryTrendPoints []domain.SalaryTrendPoint, err error) *MockStatProvider_GetSalaryStatsByProfessionID_Call {
+	_c.Call.Return(salaryTrendPoints, err)
+	return _c
+}
+
+func (_c *MockStatProvider_GetSalaryStatsByProfessionID_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string) ([]domain.SalaryTrendPoint, error)) *MockStatProvider_GetSalaryStatsByProfessionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatByProfessionAndSession provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) GetStatByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.Stat, error) {
+	ret := _mock.Called(ctx, professionID, sessionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatByProfessionAndSession")
 	}
 
 	var r0 domain.Stat
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.Stat, error)); ok {
-		return returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (domain.Stat, error)); ok {
+		return returnFunc(ctx, professionID, sessionID, area)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.Stat); ok {
-		r0 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) domain.Stat); ok {
+		r0 = returnFunc(ctx, professionID, sessionID, area)
 	} else {
 		r0 = ret.Get(0).(domain.Stat)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, professionID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, sessionID, area)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStatProvider_GetLatestStatByProfessionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStatByProfessionID'
-type MockStatProvider_GetLatestStatByProfessionID_Call struct {
+// MockStatProvider_GetStatByProfessionAndSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatByProfessionAndSession'
+type MockStatProvider_GetStatByProfessionAndSession_Call struct {
 	*mock.Call
 }
 
-// GetLatestStatByProfessionID is a helper method to define mock.On call
+// GetStatByProfessionAndSession is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
-func (_e *MockStatProvider_Expecter) GetLatestStatByProfessionID(ctx interface{}, professionID interface{}) *MockStatProvider_GetLatestStatByProfessionID_Call {
-	return &MockStatProvider_GetLatestStatByProfessionID_Call{Call: _e.mock.On("GetLatestStatByProfessionID", ctx, professionID)}
+//   - sessionID uuid.UUID
+//   - area string
+func (_e *MockStatProvider_Expecter) GetStatByProfessionAndSession(ctx interface{}, professionID interface{}, sessionID interface{}, area interface{}) *MockStatProvider_GetStatByProfessionAndSession_Call {
+	return &MockStatProvider_GetStatByProfessionAndSession_Call{Call: _e.mock.On("GetStatByProfessionAndSession", ctx, professionID, sessionID, area)}
 }
 
-func (_c *MockStatProvider_GetLatestStatByProfessionID_Call) Run(run func(ctx context.Context, professionID uuid.UUID)) *MockStatProvider_GetLatestStatByProfessionID_Call {
+func (_c *MockStatProvider_GetStatByProfessionAndSession_Call) Run(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string)) *MockStatProvider_GetStatByProfessionAndSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -87,20 +319,188 @@ func (_c *MockStatProvider_GetLatestStatByProfessionID_Call) Run(run func(ctx co
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStatProvider_GetLatestStatByProfessionID_Call) Return(stat domain.Stat, err error) *MockStatProvider_GetLatestStatByProfessionID_Call {
+func (_c *MockStatProvider_GetStatByProfessionAndSession_Call) Return(stat domain.Stat, err error) *MockStatProvider_GetStatByProfessionAndSession_Call {
 	_c.Call.Return(stat, err)
 	return _c
 }
 
-func (_c *MockStatProvider_GetLatestStatByProfessionID_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID) (domain.Stat, error)) *MockStatProvider_GetLatestStatByProfessionID_Call {
+func (_c *MockStatProvider_GetStatByProfessionAndSession_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.Stat, error)) *MockStatProvider_GetStatByProfessionAndSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatsByProfessionsAndSession provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) GetStatsByProfessionsAndSession(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string) ([]domain.Stat, error) {
+	ret := _mock.Called(ctx, professionIDs, sessionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatsByProfessionsAndSession")
+	}
+
+	var r0 []domain.Stat
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, string) ([]domain.Stat, error)); ok {
+		return returnFunc(ctx, professionIDs, sessionID, area)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, string) []domain.Stat); ok {
+		r0 = returnFunc(ctx, professionIDs, sessionID, area)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Stat)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionIDs, sessionID, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatProvider_GetStatsByProfessionsAndSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatsByProfessionsAndSession'
+type MockStatProvider_GetStatsByProfessionsAndSession_Call struct {
+	*mock.Call
+}
+
+// GetStatsByProfessionsAndSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionIDs []uuid.UUID
+//   - sessionID uuid.UUID
+//   - area string
+func (_e *MockStatProvider_Expecter) GetStatsByProfessionsAndSession(ctx interface{}, professionIDs interface{}, sessionID interface{}, area interface{}) *MockStatProvider_GetStatsByProfessionsAndSession_Call {
+	return &MockStatProvider_GetStatsByProfessionsAndSession_Call{Call: _e.mock.On("GetStatsByProfessionsAndSession", ctx, professionIDs, sessionID, area)}
+}
+
+func (_c *MockStatProvider_GetStatsByProfessionsAndSession_Call) Run(run func(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string)) *MockStatProvider_GetStatsByProfessionsAndSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatProvider_GetStatsByProfessionsAndSession_Call) Return(stats []domain.Stat, err error) *MockStatProvider_GetStatsByProfessionsAndSession_Call {
+	_c.Call.Return(stats, err)
+	return _c
+}
+
+func (_c *MockStatProvider_GetStatsByProfessionsAndSession_Call) RunAndReturn(run func(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string) ([]domain.Stat, error)) *MockStatProvider_GetStatsByProfessionsAndSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVacancyBreakdownByProfessionAndSession provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) GetVacancyBreakdownByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.VacancyBreakdown, error) {
+	ret := _mock.Called(ctx, professionID, sessionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVacancyBreakdownByProfessionAndSession")
+	}
+
+	var r0 domain.VacancyBreakdown
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (domain.VacancyBreakdown, error)); ok {
+		return returnFunc(ctx, professionID, sessionID, area)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) domain.VacancyBreakdown); ok {
+		r0 = returnFunc(ctx, professionID, sessionID, area)
+	} else {
+		r0 = ret.Get(0).(domain.VacancyBreakdown)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, professionID, sessionID, area)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVacancyBreakdownByProfessionAndSession'
+type MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call struct {
+	*mock.Call
+}
+
+// GetVacancyBreakdownByProfessionAndSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - professionID uuid.UUID
+//   - sessionID uuid.UUID
+//   - area string
+func (_e *MockStatProvider_Expecter) GetVacancyBreakdownByProfessionAndSession(ctx interface{}, professionID interface{}, sessionID interface{}, area interface{}) *MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call {
+	return &MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call{Call: _e.mock.On("GetVacancyBreakdownByProfessionAndSession", ctx, professionID, sessionID, area)}
+}
+
+func (_c *MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call) Run(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string)) *MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call) Return(vacancyBreakdown domain.VacancyBreakdown, err error) *MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call {
+	_c.Call.Return(vacancyBreakdown, err)
+	return _c
+}
+
+func (_c *MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.VacancyBreakdown, error)) *MockStatProvider_GetVacancyBreakdownByProfessionAndSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
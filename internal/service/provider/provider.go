@@ -16,7 +16,25 @@ import (
 	"psa/pkg/logger/slogx"
 )
 
-const cacheSaveTimeout = 10 * time.Second
+const (
+	cacheSaveTimeout = 10 * time.Second
+
+	minComparedProfessions = 2
+	maxComparedProfessions = 10
+)
+
+// skillCategoryOrder is the order of skill groups in a response; skills missing from the catalogue go last.
+var skillCategoryOrder = []string{
+	domain.SkillCategoryLanguage,
+	domain.SkillCategoryFramework,
+	domain.SkillCategoryDatabase,
+	domain.SkillCategoryCloud,
+	domain.SkillCategoryDevOps,
+	domain.SkillCategoryTool,
+	domain.SkillCategoryMethodology,
+	domain.SkillCategorySoftSkill,
+	domain.SkillCategoryOther,
+}
 
 type ProfessionProvider interface {
 	GetAllProfessions(ctx context.Context) ([]domain.Profession, error)
@@ -32,28 +50,43 @@ type SessionProvider interface {
 }
 
 type StatProvider interface {
-	GetLatestStatByProfessionID(ctx context.Context, professionID uuid.UUID) (domain.Stat, error)
+	GetStatByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.Stat, error)
+	GetStatsByProfessionsAndSession(ctx context.Context, professionIDs []uuid.UUID, sessionID uuid.UUID, area string) ([]domain.Stat, error)
+	GetSalaryStatByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.SalaryStat, error)
+	GetSalaryStatsByProfessionID(ctx context.Context, professionID uuid.UUID, area string) ([]domain.SalaryTrendPoint, error)
+	GetVacancyBreakdownByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.VacancyBreakdown, error)
+	GetProfessionRunByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) (domain.FetchStats, error)
 }
 
 type DailyStatProvider interface {
-	GetStatDailyByProfessionID(ctx context.Context, professionID uuid.UUID) ([]domain.StatDailyPoint, error)
-	GetStatDailyByProfessionIDs(ctx context.Context, professionIDs []uuid.UUID) (map[uuid.UUID][]domain.StatDailyPoint, error)
+	GetStatDailyByProfessionID(ctx context.Context, professionID uuid.UUID, area string) ([]domain.StatDailyPoint, error)
+	GetStatDailyByProfessionIDs(ctx context.Context, professionIDs []uuid.UUID, area string) (map[uuid.UUID][]domain.StatDailyPoint, error)
 }
 
 type SkillsProvider interface {
-	GetFormalSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID) ([]domain.Skill, error)
-	GetExtractedSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID) ([]domain.Skill, error)
+	GetFormalSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string) ([]domain.Skill, error)
+	GetExtractedSkillsByProfessionAndDate(ctx context.Context, professionID uuid.UUID, scrapedAtID uuid.UUID, area string) ([]domain.Skill, error)
+	GetFormalSkillsWithDatesByProfessionAndDateRange(ctx context.Context, professionID uuid.UUID, area string, from, to time.Time) ([]domain.SkillSnapshot, error)
+	GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx context.Context, professionID uuid.UUID, area string, from, to time.Time) ([]domain.SkillSnapshot, error)
+	GetFormalSkillsByProfessionsAndSession(
+		ctx context.Context,
+		professionIDs []uuid.UUID,
+		sessionID uuid.UUID,
+		area string,
+	) (map[uuid.UUID][]domain.Skill, error)
+	GetSkillSalariesByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) ([]domain.SkillSalary, error)
+	GetCatalogSkillsByNames(ctx context.Context, names []string) ([]domain.CatalogSkill, error)
 }
 
 type CacheProvider interface {
 	SaveProfessionData(ctx context.Context, data *domain.ProfessionDetail) error
-	GetProfessionData(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionDetail, error)
+	GetProfessionData(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionDetail, error)
 
 	SaveProfessionsList(ctx context.Context, professions []domain.ActiveProfession) error
 	GetProfessionsList(ctx context.Context) ([]domain.ActiveProfession, error)
 
-	SaveProfessionTrend(ctx context.Context, professionID uuid.UUID, trend *domain.ProfessionTrend) error
-	GetProfessionTrend(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error)
+	SaveProfessionTrend(ctx context.Context, professionID uuid.UUID, area string, trend *domain.ProfessionTrend) error
+	GetProfessionTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error)
 }
 
 type Provider struct {
@@ -231,12 +264,12 @@ func (p *Provider) ChangeProfession(ctx context.Context, profession domain.Profe
 	return nil
 }
 
-func (p *Provider) ProfessionSkills(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionDetail, error) {
+func (p *Provider) ProfessionSkills(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionDetail, error) {
 	const op = "service.provider.ProfessionSkills"
-	log := loggerctx.FromContext(ctx).With("op", op)
+	log := loggerctx.FromContext(ctx).With("op", op, "area", area)
 
 	if p.cache != nil {
-		cached, err := p.cache.GetProfessionData(ctx, professionID)
+		cached, err := p.cache.GetProfessionData(ctx, professionID, area)
 		switch {
 		case err != nil:
 			log.Warn("cache_get_failed", "profession_id", professionID, slogx.Err(err))
@@ -263,31 +296,68 @@ func (p *Provider) ProfessionSkills(ctx context.Context, professionID uuid.UUID)
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	stat, err := p.statProvider.GetLatestStatByProfessionID(ctx, professionID)
+	stat, err := p.statProvider.GetStatByProfessionAndSession(ctx, professionID, latestScraping.ID, area)
 	if err != nil {
-		log.Error("get_latest_stat_failed", "profession_id", professionID, slogx.Err(err))
+		log.Error("get_stat_failed", "profession_id", professionID, slogx.Err(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	formalSkills, err := p.skillsProvider.GetFormalSkillsByProfessionAndDate(ctx, professionID, latestScraping.ID)
+	formalSkills, err := p.skillsProvider.GetFormalSkillsByProfessionAndDate(ctx, professionID, latestScraping.ID, area)
 	if err != nil {
 		log.Error("get_formal_skills_failed", "profession_id", professionID, slogx.Err(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	extractedSkills, err := p.skillsProvider.GetExtractedSkillsByProfessionAndDate(ctx, professionID, latestScraping.ID)
+	extractedSkills, err := p.skillsProvider.GetExtractedSkillsByProfessionAndDate(ctx, professionID, latestScraping.ID, area)
 	if err != nil {
 		log.Error("get_extracted_skills_failed", "profession_id", professionID, slogx.Err(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	var salary *domain.SalaryStat
+	salaryStat, err := p.statProvider.GetSalaryStatByProfessionAndSession(ctx, professionID, latestScraping.ID, area)
+	switch {
+	case errors.Is(err, domain.ErrSalaryStatNotFound):
+	case err != nil:
+		log.Error("get_salary_stat_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	default:
+		salary = &salaryStat
+	}
+
+	var breakdown *domain.VacancyBreakdown
+	vacancyBreakdown, err := p.statProvider.GetVacancyBreakdownByProfessionAndSession(ctx, professionID, latestScraping.ID, area)
+	if err != nil {
+		log.Error("get_breakdown_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(vacancyBreakdown.Experience) > 0 || len(vacancyBreakdown.Employment) > 0 || len(vacancyBreakdown.Schedule) > 0 ||
+		len(vacancyBreakdown.Source) > 0 {
+		breakdown = &vacancyBreakdown
+	}
+
+	var coverage *domain.FetchStats
+	fetchStats, err := p.statProvider.GetProfessionRunByProfessionAndSession(ctx, professionID, latestScraping.ID, area)
+	switch {
+	case errors.Is(err, domain.ErrProfessionRunNotFound):
+	case err != nil:
+		log.Error("get_profession_run_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	default:
+		coverage = &fetchStats
+	}
+
 	response := &domain.ProfessionDetail{
 		ProfessionID:    professionID,
 		ProfessionName:  profession.Name,
+		Area:            area,
 		ScrapedAt:       latestScraping.ScrapedAt.Format(time.RFC3339),
 		VacancyCount:    stat.VacancyCount,
 		FormalSkills:    p.transformAndSortSkills(formalSkills),
 		ExtractedSkills: p.transformAndSortSkills(extractedSkills),
+		Salary:          salary,
+		Breakdown:       breakdown,
+		Coverage:        coverage,
 	}
 
 	if p.cache != nil {
@@ -323,8 +393,9 @@ func (p *Provider) transformAndSortSkills(skills []domain.Skill) []domain.SkillR
 	resp := make([]domain.SkillResponse, len(skills))
 	for i, s := range skills {
 		resp[i] = domain.SkillResponse{
-			Skill: s.Skill,
-			Count: s.Count,
+			Skill:      s.Skill,
+			Count:      s.Count,
+			Percentage: s.Percentage,
 		}
 	}
 
@@ -335,12 +406,68 @@ func (p *Provider) transformAndSortSkills(skills []domain.Skill) []domain.SkillR
 	return resp
 }
 
-func (p *Provider) ProfessionTrend(ctx context.Context, professionID uuid.UUID) (*domain.ProfessionTrend, error) {
-	const op = "service.provider.ProfessionTrend"
+// GroupSkillsByCategory groups skills by their catalogue category, keeping the order of skills within a group.
+// Empty groups are omitted.
+func (p *Provider) GroupSkillsByCategory(ctx context.Context, skills []domain.SkillResponse) ([]domain.SkillGroup, error) {
+	const op = "service.provider.GroupSkillsByCategory"
 	log := loggerctx.FromContext(ctx).With("op", op)
 
+	if len(skills) == 0 {
+		return []domain.SkillGroup{}, nil
+	}
+
+	names := make([]string, len(skills))
+	for i, s := range skills {
+		names[i] = s.Skill
+	}
+
+	catalog, err := p.skillsProvider.GetCatalogSkillsByNames(ctx, names)
+	if err != nil {
+		log.Error("get_catalog_skills_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	byName := make(map[string]domain.CatalogSkill, len(catalog))
+	for _, c := range catalog {
+		byName[c.Name] = c
+	}
+
+	byCategory := make(map[string][]domain.GroupedSkill)
+	for _, s := range skills {
+		entry, ok := byName[s.Skill]
+		if !ok {
+			entry.Category = domain.SkillCategoryOther
+		}
+
+		byCategory[entry.Category] = append(byCategory[entry.Category], domain.GroupedSkill{
+			Skill:      s.Skill,
+			Count:      s.Count,
+			Percentage: s.Percentage,
+			Parent:     entry.Parent,
+		})
+	}
+
+	groups := make([]domain.SkillGroup, 0, len(byCategory))
+	for _, category := range skillCategoryOrder {
+		if grouped, ok := byCategory[category]; ok {
+			groups = append(groups, domain.SkillGroup{
+				Category: category,
+				Skills:   grouped,
+			})
+		}
+	}
+
+	log.Debug("skills_grouped_by_category", "skill_count", len(skills), "group_count", len(groups))
+
+	return groups, nil
+}
+
+func (p *Provider) ProfessionTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionTrend, error) {
+	const op = "service.provider.ProfessionTrend"
+	log := loggerctx.FromContext(ctx).With("op", op, "area", area)
+
 	if p.cache != nil {
-		cached, err := p.cache.GetProfessionTrend(ctx, professionID)
+		cached, err := p.cache.GetProfessionTrend(ctx, professionID, area)
 		switch {
 		case err != nil:
 			log.Warn("cache_get_failed", "profession_id", professionID, slogx.Err(err))
@@ -361,7 +488,7 @@ func (p *Provider) ProfessionTrend(ctx context.Context, professionID uuid.UUID)
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	points, err := p.dailyStatProvider.GetStatDailyByProfessionID(ctx, professionID)
+	points, err := p.dailyStatProvider.GetStatDailyByProfessionID(ctx, professionID, area)
 	if err != nil {
 		log.Error("get_stat_daily_failed", "profession_id", professionID, slogx.Err(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -370,6 +497,7 @@ func (p *Provider) ProfessionTrend(ctx context.Context, professionID uuid.UUID)
 	trend := &domain.ProfessionTrend{
 		ProfessionID:   professionID,
 		ProfessionName: profession.Name,
+		Area:           area,
 		Data:           points,
 	}
 
@@ -386,7 +514,7 @@ func (p *Provider) ProfessionTrend(ctx context.Context, professionID uuid.UUID)
 
 			cacheLog := l.With("async", "cache_save", "profession_id", profID)
 
-			if err := p.cache.SaveProfessionTrend(cacheCtx, profID, t); err != nil {
+			if err := p.cache.SaveProfessionTrend(cacheCtx, profID, t.Area, t); err != nil {
 				cacheLog.Error("cache_save_failed", slogx.Err(err))
 			} else {
 				cacheLog.Debug("cache_saved", "points_count", len(t.Data))
@@ -399,6 +527,379 @@ func (p *Provider) ProfessionTrend(ctx context.Context, professionID uuid.UUID)
 	return trend, nil
 }
 
+// ProfessionSalaryTrend returns salary quartiles of every archive session in chronological order.
+func (p *Provider) ProfessionSalaryTrend(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionSalaryTrend, error) {
+	const op = "service.provider.ProfessionSalaryTrend"
+	log := loggerctx.FromContext(ctx).With("op", op, "area", area)
+
+	profession, err := p.professionProvider.GetProfessionByID(ctx, professionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return nil, domain.ErrProfessionNotFound
+		}
+		log.Error("get_profession_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	points, err := p.statProvider.GetSalaryStatsByProfessionID(ctx, professionID, area)
+	if err != nil {
+		log.Error("get_salary_stats_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Debug("profession_salary_trend_loaded", "profession_id", professionID, "points_count", len(points))
+
+	return &domain.ProfessionSalaryTrend{
+		ProfessionID:   professionID,
+		ProfessionName: profession.Name,
+		Area:           area,
+		Data:           points,
+	}, nil
+}
+
+// ProfessionSkillSalaries returns how median salaries of vacancies with a formal skill differ from those without it
+// in the latest archive session, the best paid skills first.
+func (p *Provider) ProfessionSkillSalaries(ctx context.Context, professionID uuid.UUID, area string) (*domain.ProfessionSkillSalaries, error) {
+	const op = "service.provider.ProfessionSkillSalaries"
+	log := loggerctx.FromContext(ctx).With("op", op, "area", area)
+
+	profession, err := p.professionProvider.GetProfessionByID(ctx, professionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return nil, domain.ErrProfessionNotFound
+		}
+		log.Error("get_profession_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	latestScraping, err := p.sessionProvider.GetLatestScraping(ctx)
+	if err != nil {
+		log.Error("get_latest_scraping_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	skills, err := p.skillsProvider.GetSkillSalariesByProfessionAndSession(ctx, professionID, latestScraping.ID, area)
+	if err != nil {
+		log.Error("get_skill_salaries_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Debug("profession_skill_salaries_loaded", "profession_id", professionID, "skill_count", len(skills))
+
+	return &domain.ProfessionSkillSalaries{
+		ProfessionID:   professionID,
+		ProfessionName: profession.Name,
+		Area:           area,
+		ScrapedAt:      latestScraping.ScrapedAt,
+		Skills:         skills,
+	}, nil
+}
+
+// ProfessionSkillHistory returns per-skill counts for every archive session in [from, to].
+// If skill is not empty, only that skill is returned.
+func (p *Provider) ProfessionSkillHistory(
+	ctx context.Context,
+	professionID uuid.UUID,
+	area string,
+	from, to time.Time,
+	skill string,
+) (*domain.ProfessionSkillHistory, error) {
+	const op = "service.provider.ProfessionSkillHistory"
+	log := loggerctx.FromContext(ctx).With("op", op, "area", area)
+
+	if from.After(to) {
+		return nil, domain.ErrInvalidDateRange
+	}
+
+	profession, err := p.professionProvider.GetProfessionByID(ctx, professionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return nil, domain.ErrProfessionNotFound
+		}
+		log.Error("get_profession_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	formalSkills, err := p.skillsProvider.GetFormalSkillsWithDatesByProfessionAndDateRange(ctx, professionID, area, from, to)
+	if err != nil {
+		log.Error("get_formal_skills_history_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	extractedSkills, err := p.skillsProvider.GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx, professionID, area, from, to)
+	if err != nil {
+		log.Error("get_extracted_skills_history_failed", "profession_id", professionID, slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	skill = strings.ToLower(strings.TrimSpace(skill))
+
+	history := &domain.ProfessionSkillHistory{
+		ProfessionID:    professionID,
+		ProfessionName:  profession.Name,
+		Area:            area,
+		From:            from,
+		To:              to,
+		FormalSkills:    buildSkillHistory(formalSkills, skill),
+		ExtractedSkills: buildSkillHistory(extractedSkills, skill),
+	}
+
+	log.Debug("profession_skill_history_loaded", "profession_id", professionID,
+		"formal_count", len(history.FormalSkills), "extracted_count", len(history.ExtractedSkills))
+
+	return history, nil
+}
+
+// buildSkillHistory groups snapshots by skill and aligns every skill to the same set of sessions,
+// so a session where the skill was not found gets a zero count instead of a gap.
+func buildSkillHistory(snapshots []domain.SkillSnapshot, skill string) []domain.SkillHistory {
+	sessions := make([]time.Time, 0)
+	seen := make(map[time.Time]struct{})
+	counts := make(map[string]map[time.Time]int32)
+	totals := make(map[string]int32)
+
+	for _, s := range snapshots {
+		if _, ok := seen[s.ScrapedAt]; !ok {
+			seen[s.ScrapedAt] = struct{}{}
+			sessions = append(sessions, s.ScrapedAt)
+		}
+
+		if skill != "" && s.Skill != skill {
+			continue
+		}
+
+		if counts[s.Skill] == nil {
+			counts[s.Skill] = make(map[time.Time]int32)
+		}
+		counts[s.Skill][s.ScrapedAt] += s.Count
+		totals[s.Skill] += s.Count
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Before(sessions[j])
+	})
+
+	result := make([]domain.SkillHistory, 0, len(counts))
+	for name, bySession := range counts {
+		points := make([]domain.SkillHistoryPoint, len(sessions))
+		for i, scrapedAt := range sessions {
+			points[i] = domain.SkillHistoryPoint{
+				ScrapedAt: scrapedAt,
+				Count:     bySession[scrapedAt],
+			}
+		}
+
+		result = append(result, domain.SkillHistory{
+			Skill: name,
+			Data:  points,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if totals[result[i].Skill] != totals[result[j].Skill] {
+			return totals[result[i].Skill] > totals[result[j].Skill]
+		}
+		return result[i].Skill < result[j].Skill
+	})
+
+	return result
+}
+
+// CompareProfessions returns latest vacancy counts, aligned daily trends and top formal skills overlap
+// for several professions at once. Counts and skills are taken from the latest archive session.
+func (p *Provider) CompareProfessions(ctx context.Context, professionIDs []uuid.UUID, area string, topSkills int) (*domain.ProfessionComparison, error) {
+	const op = "service.provider.CompareProfessions"
+	log := loggerctx.FromContext(ctx).With("op", op, "area", area)
+
+	professionIDs = uniqueIDs(professionIDs)
+	if len(professionIDs) < minComparedProfessions || len(professionIDs) > maxComparedProfessions || topSkills <= 0 {
+		return nil, domain.ErrInvalidComparison
+	}
+
+	professions := make([]domain.ComparedProfession, len(professionIDs))
+	for i, id := range professionIDs {
+		profession, err := p.professionProvider.GetProfessionByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, domain.ErrProfessionNotFound) {
+				log.Warn("profession_not_found", "profession_id", id)
+				return nil, domain.ErrProfessionNotFound
+			}
+			log.Error("get_profession_failed", "profession_id", id, slogx.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		professions[i] = domain.ComparedProfession{
+			ProfessionID:   id,
+			ProfessionName: profession.Name,
+		}
+	}
+
+	latestScraping, err := p.sessionProvider.GetLatestScraping(ctx)
+	if err != nil {
+		log.Error("get_latest_scraping_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stats, err := p.statProvider.GetStatsByProfessionsAndSession(ctx, professionIDs, latestScraping.ID, area)
+	if err != nil {
+		log.Error("get_stats_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	skills, err := p.skillsProvider.GetFormalSkillsByProfessionsAndSession(ctx, professionIDs, latestScraping.ID, area)
+	if err != nil {
+		log.Error("get_formal_skills_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	daily, err := p.dailyStatProvider.GetStatDailyByProfessionIDs(ctx, professionIDs, area)
+	if err != nil {
+		log.Error("get_stat_daily_failed", slogx.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vacancyCounts := make(map[uuid.UUID]int32, len(stats))
+	for _, stat := range stats {
+		vacancyCounts[stat.ProfessionID] = stat.VacancyCount
+	}
+
+	for i := range professions {
+		id := professions[i].ProfessionID
+		professions[i].VacancyCount = vacancyCounts[id]
+		professions[i].TopSkills = topSkillsOf(skills[id], topSkills)
+	}
+
+	comparison := &domain.ProfessionComparison{
+		Area:         area,
+		ScrapedAt:    latestScraping.ScrapedAt,
+		Professions:  professions,
+		Trend:        alignDailyTrends(daily),
+		SkillOverlap: overlapTopSkills(professions),
+	}
+
+	log.Debug("professions_compared", "count", len(professions),
+		"trend_points", len(comparison.Trend), "overlap_count", len(comparison.SkillOverlap))
+
+	return comparison, nil
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+
+	return result
+}
+
+func topSkillsOf(skills []domain.Skill, limit int) []domain.SkillResponse {
+	result := make([]domain.SkillResponse, len(skills))
+	for i, s := range skills {
+		result[i] = domain.SkillResponse{
+			Skill:      s.Skill,
+			Count:      s.Count,
+			Percentage: s.Percentage,
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Skill < result[j].Skill
+	})
+
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result
+}
+
+// alignDailyTrends puts daily points of all professions on a common date axis.
+// If a profession has several points in one day, the latest one wins.
+func alignDailyTrends(daily map[uuid.UUID][]domain.StatDailyPoint) []domain.ComparisonTrendPoint {
+	byDate := make(map[time.Time]map[uuid.UUID]domain.StatDailyPoint)
+	for id, points := range daily {
+		for _, point := range points {
+			y, m, d := point.Date.UTC().Date()
+			date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+			if byDate[date] == nil {
+				byDate[date] = make(map[uuid.UUID]domain.StatDailyPoint)
+			}
+			if prev, ok := byDate[date][id]; ok && prev.Date.After(point.Date) {
+				continue
+			}
+			byDate[date][id] = point
+		}
+	}
+
+	result := make([]domain.ComparisonTrendPoint, 0, len(byDate))
+	for date, points := range byDate {
+		counts := make(map[uuid.UUID]int32, len(points))
+		for id, point := range points {
+			counts[id] = point.VacancyCount
+		}
+		result = append(result, domain.ComparisonTrendPoint{
+			Date:          date,
+			VacancyCounts: counts,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Date.Before(result[j].Date)
+	})
+
+	return result
+}
+
+// overlapTopSkills returns skills shared by the top skills of at least two professions,
+// most widely shared first.
+func overlapTopSkills(professions []domain.ComparedProfession) []domain.SkillOverlap {
+	counts := make(map[string]map[uuid.UUID]int32)
+	for _, profession := range professions {
+		for _, skill := range profession.TopSkills {
+			if counts[skill.Skill] == nil {
+				counts[skill.Skill] = make(map[uuid.UUID]int32)
+			}
+			counts[skill.Skill][profession.ProfessionID] = skill.Count
+		}
+	}
+
+	result := make([]domain.SkillOverlap, 0)
+	totals := make(map[string]int32)
+	for skill, byProfession := range counts {
+		if len(byProfession) < 2 {
+			continue
+		}
+		for _, c := range byProfession {
+			totals[skill] += c
+		}
+		result = append(result, domain.SkillOverlap{
+			Skill:  skill,
+			Counts: byProfession,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i].Counts) != len(result[j].Counts) {
+			return len(result[i].Counts) > len(result[j].Counts)
+		}
+		if totals[result[i].Skill] != totals[result[j].Skill] {
+			return totals[result[i].Skill] > totals[result[j].Skill]
+		}
+		return result[i].Skill < result[j].Skill
+	})
+
+	return result
+}
+
 func validateProfessionInput(profession domain.Profession) error {
 	if strings.TrimSpace(profession.Name) == "" {
 		return domain.ErrInvalidProfessionName
@@ -741,8 +741,9 @@ func TestProvider_ProfessionSkills_Success(t *testing.T) {
 		VacancyCount: 100,
 	}
 
+	goPercentage := 52.6
 	formalSkills := []domain.Skill{
-		{Skill: "go", Count: 50},
+		{Skill: "go", Count: 50, Percentage: &goPercentage},
 		{Skill: "python", Count: 30},
 	}
 
@@ -753,9 +754,15 @@ func TestProvider_ProfessionSkills_Success(t *testing.T) {
 
 	professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
 	sessionProvider.EXPECT().GetLatestScraping(ctx).Return(latestScraping, nil)
-	statProvider.EXPECT().GetLatestStatByProfessionID(ctx, professionID).Return(stat, nil)
-	skillsProvider.EXPECT().GetFormalSkillsByProfessionAndDate(ctx, professionID, scrapingID).Return(formalSkills, nil)
-	skillsProvider.EXPECT().GetExtractedSkillsByProfessionAndDate(ctx, professionID, scrapingID).Return(extractedSkills, nil)
+	statProvider.EXPECT().GetStatByProfessionAndSession(ctx, professionID, scrapingID, "113").Return(stat, nil)
+	skillsProvider.EXPECT().GetFormalSkillsByProfessionAndDate(ctx, professionID, scrapingID, "113").Return(formalSkills, nil)
+	skillsProvider.EXPECT().GetExtractedSkillsByProfessionAndDate(ctx, professionID, scrapingID, "113").Return(extractedSkills, nil)
+	statProvider.EXPECT().GetSalaryStatByProfessionAndSession(ctx, professionID, scrapingID, "113").
+		Return(domain.SalaryStat{SampleSize: 40, P25: 150000, Median: 200000, P75: 260000}, nil)
+	statProvider.EXPECT().GetVacancyBreakdownByProfessionAndSession(ctx, professionID, scrapingID, "113").
+		Return(domain.VacancyBreakdown{Schedule: []domain.BreakdownItem{{Value: domain.ScheduleRemote, Count: 30}}}, nil)
+	statProvider.EXPECT().GetProfessionRunByProfessionAndSession(ctx, professionID, scrapingID, "113").
+		Return(domain.FetchStats{TotalFound: 100, IDsCollected: 100, VacanciesFetched: 95, VacanciesFailed: 5}, nil)
 
 	providerService := New(
 		professionProvider,
@@ -767,18 +774,65 @@ func TestProvider_ProfessionSkills_Success(t *testing.T) {
 	)
 
 	// Act
-	result, err := providerService.ProfessionSkills(ctx, professionID)
+	result, err := providerService.ProfessionSkills(ctx, professionID, "113")
 
 	// Assert
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, professionID, result.ProfessionID)
 	assert.Equal(t, "Go Developer", result.ProfessionName)
+	assert.Equal(t, "113", result.Area)
 	assert.Equal(t, int32(100), result.VacancyCount)
 	require.Len(t, result.FormalSkills, 2)
 	assert.Equal(t, "go", result.FormalSkills[0].Skill)
 	assert.Equal(t, int32(50), result.FormalSkills[0].Count)
+	assert.Equal(t, &goPercentage, result.FormalSkills[0].Percentage)
+	assert.Nil(t, result.FormalSkills[1].Percentage)
 	require.Len(t, result.ExtractedSkills, 2)
+	require.NotNil(t, result.Salary)
+	assert.Equal(t, int32(200000), result.Salary.Median)
+	require.NotNil(t, result.Breakdown)
+	assert.Equal(t, int32(30), result.Breakdown.Schedule[0].Count)
+	require.NotNil(t, result.Coverage)
+	assert.Equal(t, 95, result.Coverage.VacanciesFetched)
+}
+
+func TestProvider_ProfessionSkills_NoSalaryAndBreakdown(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	scrapingID := uuid.New()
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{ID: professionID, Name: "Go Developer"}, nil)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).Return(domain.Scraping{ID: scrapingID, ScrapedAt: time.Now()}, nil)
+	deps.statProvider.EXPECT().GetStatByProfessionAndSession(ctx, professionID, scrapingID, "113").Return(domain.Stat{VacancyCount: 10}, nil)
+	deps.skillsProvider.EXPECT().GetFormalSkillsByProfessionAndDate(ctx, professionID, scrapingID, "113").Return(nil, nil)
+	deps.skillsProvider.EXPECT().GetExtractedSkillsByProfessionAndDate(ctx, professionID, scrapingID, "113").Return(nil, nil)
+	deps.statProvider.EXPECT().GetSalaryStatByProfessionAndSession(ctx, professionID, scrapingID, "113").
+		Return(domain.SalaryStat{}, domain.ErrSalaryStatNotFound)
+	deps.statProvider.EXPECT().GetVacancyBreakdownByProfessionAndSession(ctx, professionID, scrapingID, "113").
+		Return(domain.VacancyBreakdown{}, nil)
+	deps.statProvider.EXPECT().GetProfessionRunByProfessionAndSession(ctx, professionID, scrapingID, "113").
+		Return(domain.FetchStats{}, domain.ErrProfessionRunNotFound)
+
+	// cache = nil — избегаем асинхронных вызовов
+	providerService := New(deps.professionProvider, deps.sessionProvider, deps.statProvider, deps.skillsProvider, nil, deps.dailyStatProvider)
+
+	// Act
+	result, err := providerService.ProfessionSkills(ctx, professionID, "113")
+
+	// Assert: сессия без зарплат не ломает ответ
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Nil(t, result.Salary)
+	assert.Nil(t, result.Breakdown)
+	assert.Nil(t, result.Coverage)
+	assert.Equal(t, int32(10), result.VacancyCount)
 }
 
 func TestProvider_ProfessionSkills_CacheHit(t *testing.T) {
@@ -797,12 +851,12 @@ func TestProvider_ProfessionSkills_CacheHit(t *testing.T) {
 		VacancyCount:   50,
 	}
 
-	deps.cache.EXPECT().GetProfessionData(ctx, professionID).Return(cachedData, nil)
+	deps.cache.EXPECT().GetProfessionData(ctx, professionID, "113").Return(cachedData, nil)
 
 	providerService := deps.provider()
 
 	// Act
-	result, err := providerService.ProfessionSkills(ctx, professionID)
+	result, err := providerService.ProfessionSkills(ctx, professionID, "113")
 
 	// Assert
 	require.NoError(t, err)
@@ -810,7 +864,7 @@ func TestProvider_ProfessionSkills_CacheHit(t *testing.T) {
 	assert.Equal(t, "Cached Go Developer", result.ProfessionName)
 	deps.professionProvider.AssertNotCalled(t, "GetProfessionByID")
 	deps.sessionProvider.AssertNotCalled(t, "GetLatestScraping")
-	deps.statProvider.AssertNotCalled(t, "GetLatestStatByProfessionID")
+	deps.statProvider.AssertNotCalled(t, "GetStatByProfessionAndSession")
 	deps.skillsProvider.AssertNotCalled(t, "GetFormalSkillsByProfessionAndDate")
 	deps.skillsProvider.AssertNotCalled(t, "GetExtractedSkillsByProfessionAndDate")
 }
@@ -840,7 +894,7 @@ func TestProvider_ProfessionSkills_ProfessionNotFound(t *testing.T) {
 	)
 
 	// Act
-	result, err := providerService.ProfessionSkills(ctx, professionID)
+	result, err := providerService.ProfessionSkills(ctx, professionID, "113")
 
 	// Assert
 	require.Error(t, err)
@@ -848,6 +902,253 @@ func TestProvider_ProfessionSkills_ProfessionNotFound(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrProfessionNotFound)
 }
 
+// ==================== GroupSkillsByCategory ====================
+
+func TestProvider_GroupSkillsByCategory_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	skills := []domain.SkillResponse{
+		{Skill: "docker", Count: 50},
+		{Skill: "go", Count: 40},
+		{Skill: "gin", Count: 20},
+		{Skill: "grpc", Count: 15},
+		{Skill: "python", Count: 10},
+	}
+
+	deps.skillsProvider.EXPECT().
+		GetCatalogSkillsByNames(ctx, []string{"docker", "go", "gin", "grpc", "python"}).
+		Return([]domain.CatalogSkill{
+			{Name: "python", Category: domain.SkillCategoryLanguage},
+			{Name: "go", Category: domain.SkillCategoryLanguage},
+			{Name: "gin", Category: domain.SkillCategoryFramework, Parent: "go"},
+			{Name: "docker", Category: domain.SkillCategoryDevOps},
+		}, nil)
+
+	providerService := deps.provider()
+
+	// Act
+	groups, err := providerService.GroupSkillsByCategory(ctx, skills)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SkillGroup{
+		{Category: domain.SkillCategoryLanguage, Skills: []domain.GroupedSkill{
+			{Skill: "go", Count: 40},
+			{Skill: "python", Count: 10},
+		}},
+		{Category: domain.SkillCategoryFramework, Skills: []domain.GroupedSkill{
+			{Skill: "gin", Count: 20, Parent: "go"},
+		}},
+		{Category: domain.SkillCategoryDevOps, Skills: []domain.GroupedSkill{
+			{Skill: "docker", Count: 50},
+		}},
+		{Category: domain.SkillCategoryOther, Skills: []domain.GroupedSkill{
+			{Skill: "grpc", Count: 15},
+		}},
+	}, groups)
+}
+
+func TestProvider_GroupSkillsByCategory_Empty(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	providerService := deps.provider()
+
+	// Act
+	groups, err := providerService.GroupSkillsByCategory(ctx, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+	deps.skillsProvider.AssertNotCalled(t, "GetCatalogSkillsByNames")
+}
+
+func TestProvider_GroupSkillsByCategory_CatalogError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	deps.skillsProvider.EXPECT().
+		GetCatalogSkillsByNames(ctx, []string{"go"}).
+		Return(nil, assert.AnError)
+
+	providerService := deps.provider()
+
+	// Act
+	groups, err := providerService.GroupSkillsByCategory(ctx, []domain.SkillResponse{{Skill: "go", Count: 1}})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, groups)
+}
+
+// ==================== ProfessionSkillSalaries ====================
+
+func TestProvider_ProfessionSkillSalaries_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	scraping := domain.Scraping{ID: uuid.New(), ScrapedAt: time.Now()}
+	skills := []domain.SkillSalary{
+		{Skill: "kubernetes", WithCount: 30, WithMedian: 320000, WithoutCount: 70, WithoutMedian: 250000},
+	}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{ID: professionID, Name: "Go Developer"}, nil)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).Return(scraping, nil)
+	deps.skillsProvider.EXPECT().GetSkillSalariesByProfessionAndSession(ctx, professionID, scraping.ID, "113").Return(skills, nil)
+
+	providerService := deps.provider()
+
+	// Act
+	result, err := providerService.ProfessionSkillSalaries(ctx, professionID, "113")
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "Go Developer", result.ProfessionName)
+	assert.Equal(t, scraping.ScrapedAt, result.ScrapedAt)
+	assert.Equal(t, skills, result.Skills)
+}
+
+func TestProvider_ProfessionSkillSalaries_ProfessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{}, domain.ErrProfessionNotFound)
+
+	providerService := deps.provider()
+
+	// Act
+	result, err := providerService.ProfessionSkillSalaries(ctx, professionID, "113")
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrProfessionNotFound)
+	assert.Nil(t, result)
+	deps.sessionProvider.AssertNotCalled(t, "GetLatestScraping")
+}
+
+func TestProvider_ProfessionSkillSalaries_GetSkillSalariesError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	scraping := domain.Scraping{ID: uuid.New(), ScrapedAt: time.Now()}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{ID: professionID}, nil)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).Return(scraping, nil)
+	deps.skillsProvider.EXPECT().GetSkillSalariesByProfessionAndSession(ctx, professionID, scraping.ID, "113").Return(nil, assert.AnError)
+
+	providerService := deps.provider()
+
+	// Act
+	result, err := providerService.ProfessionSkillSalaries(ctx, professionID, "113")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
+// ==================== ProfessionSalaryTrend ====================
+
+func TestProvider_ProfessionSalaryTrend_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	points := []domain.SalaryTrendPoint{
+		{ScrapedAt: time.Now().AddDate(0, -1, 0), SalaryStat: domain.SalaryStat{SampleSize: 30, P25: 140000, Median: 180000, P75: 240000}},
+		{ScrapedAt: time.Now(), SalaryStat: domain.SalaryStat{SampleSize: 35, P25: 150000, Median: 200000, P75: 250000}},
+	}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{ID: professionID, Name: "Go Developer"}, nil)
+	deps.statProvider.EXPECT().GetSalaryStatsByProfessionID(ctx, professionID, "113").Return(points, nil)
+
+	providerService := deps.provider()
+
+	// Act
+	result, err := providerService.ProfessionSalaryTrend(ctx, professionID, "113")
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "Go Developer", result.ProfessionName)
+	assert.Equal(t, points, result.Data)
+}
+
+func TestProvider_ProfessionSalaryTrend_ProfessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{}, domain.ErrProfessionNotFound)
+
+	providerService := deps.provider()
+
+	// Act
+	result, err := providerService.ProfessionSalaryTrend(ctx, professionID, "113")
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrProfessionNotFound)
+	assert.Nil(t, result)
+	deps.statProvider.AssertNotCalled(t, "GetSalaryStatsByProfessionID")
+}
+
+func TestProvider_ProfessionSalaryTrend_GetSalaryStatsError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{ID: professionID}, nil)
+	deps.statProvider.EXPECT().GetSalaryStatsByProfessionID(ctx, professionID, "113").Return(nil, assert.AnError)
+
+	providerService := deps.provider()
+
+	// Act
+	result, err := providerService.ProfessionSalaryTrend(ctx, professionID, "113")
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
 // ==================== ProfessionTrend ====================
 
 func TestProvider_ProfessionTrend_Success(t *testing.T) {
@@ -878,7 +1179,7 @@ func TestProvider_ProfessionTrend_Success(t *testing.T) {
 	}
 
 	professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
-	dailyStatProvider.EXPECT().GetStatDailyByProfessionID(ctx, professionID).Return(statPoints, nil)
+	dailyStatProvider.EXPECT().GetStatDailyByProfessionID(ctx, professionID, "113").Return(statPoints, nil)
 
 	providerService := New(
 		professionProvider,
@@ -890,13 +1191,14 @@ func TestProvider_ProfessionTrend_Success(t *testing.T) {
 	)
 
 	// Act
-	result, err := providerService.ProfessionTrend(ctx, professionID)
+	result, err := providerService.ProfessionTrend(ctx, professionID, "113")
 
 	// Assert
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, professionID, result.ProfessionID)
 	assert.Equal(t, "Go Developer", result.ProfessionName)
+	assert.Equal(t, "113", result.Area)
 	require.Len(t, result.Data, 3)
 }
 
@@ -916,12 +1218,12 @@ func TestProvider_ProfessionTrend_CacheHit(t *testing.T) {
 		Data:           []domain.StatDailyPoint{{Date: time.Now(), VacancyCount: 50}},
 	}
 
-	deps.cache.EXPECT().GetProfessionTrend(ctx, professionID).Return(cachedTrend, nil)
+	deps.cache.EXPECT().GetProfessionTrend(ctx, professionID, "113").Return(cachedTrend, nil)
 
 	providerService := deps.provider()
 
 	// Act
-	result, err := providerService.ProfessionTrend(ctx, professionID)
+	result, err := providerService.ProfessionTrend(ctx, professionID, "113")
 
 	// Assert
 	require.NoError(t, err)
@@ -956,7 +1258,7 @@ func TestProvider_ProfessionTrend_ProfessionNotFound(t *testing.T) {
 	)
 
 	// Act
-	result, err := providerService.ProfessionTrend(ctx, professionID)
+	result, err := providerService.ProfessionTrend(ctx, professionID, "113")
 
 	// Assert
 	require.Error(t, err)
@@ -985,7 +1287,7 @@ func TestProvider_ProfessionTrend_GetStatDailyError(t *testing.T) {
 
 	dbError := assert.AnError
 	professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
-	dailyStatProvider.EXPECT().GetStatDailyByProfessionID(ctx, professionID).Return(nil, dbError)
+	dailyStatProvider.EXPECT().GetStatDailyByProfessionID(ctx, professionID, "113").Return(nil, dbError)
 
 	providerService := New(
 		professionProvider,
@@ -997,9 +1299,332 @@ func TestProvider_ProfessionTrend_GetStatDailyError(t *testing.T) {
 	)
 
 	// Act
-	result, err := providerService.ProfessionTrend(ctx, professionID)
+	result, err := providerService.ProfessionTrend(ctx, professionID, "113")
 
 	// Assert
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+// ==================== ProfessionSkillHistory ====================
+
+func TestProvider_ProfessionSkillHistory_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	jan := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 15, 3, 0, 0, 0, time.UTC)
+
+	formal := []domain.SkillSnapshot{
+		{Skill: "golang", Count: 100, ScrapedAt: jan},
+		{Skill: "kubernetes", Count: 20, ScrapedAt: jan},
+		{Skill: "golang", Count: 110, ScrapedAt: feb},
+	}
+	extracted := []domain.SkillSnapshot{
+		{Skill: "grpc", Count: 5, ScrapedAt: feb},
+	}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).
+		Return(domain.Profession{ID: professionID, Name: "Go Developer"}, nil)
+	deps.skillsProvider.EXPECT().GetFormalSkillsWithDatesByProfessionAndDateRange(ctx, professionID, "113", from, to).
+		Return(formal, nil)
+	deps.skillsProvider.EXPECT().GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx, professionID, "113", from, to).
+		Return(extracted, nil)
+
+	// Act
+	result, err := deps.provider().ProfessionSkillHistory(ctx, professionID, "113", from, to, "")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Go Developer", result.ProfessionName)
+	require.Len(t, result.FormalSkills, 2)
+
+	// Навыки отсортированы по суммарному количеству
+	assert.Equal(t, "golang", result.FormalSkills[0].Skill)
+	assert.Equal(t, []domain.SkillHistoryPoint{
+		{ScrapedAt: jan, Count: 100},
+		{ScrapedAt: feb, Count: 110},
+	}, result.FormalSkills[0].Data)
+
+	// Сессия без навыка заполняется нулём
+	assert.Equal(t, "kubernetes", result.FormalSkills[1].Skill)
+	assert.Equal(t, []domain.SkillHistoryPoint{
+		{ScrapedAt: jan, Count: 20},
+		{ScrapedAt: feb, Count: 0},
+	}, result.FormalSkills[1].Data)
+
+	require.Len(t, result.ExtractedSkills, 1)
+	assert.Equal(t, "grpc", result.ExtractedSkills[0].Skill)
+}
+
+func TestProvider_ProfessionSkillHistory_SkillFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	jan := time.Date(2025, 1, 15, 3, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 15, 3, 0, 0, 0, time.UTC)
+
+	formal := []domain.SkillSnapshot{
+		{Skill: "golang", Count: 100, ScrapedAt: jan},
+		{Skill: "golang", Count: 110, ScrapedAt: feb},
+		{Skill: "kubernetes", Count: 20, ScrapedAt: feb},
+	}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).
+		Return(domain.Profession{ID: professionID, Name: "Go Developer"}, nil)
+	deps.skillsProvider.EXPECT().GetFormalSkillsWithDatesByProfessionAndDateRange(ctx, professionID, "113", from, to).
+		Return(formal, nil)
+	deps.skillsProvider.EXPECT().GetExtractedSkillsWithDatesByProfessionAndDateRange(ctx, professionID, "113", from, to).
+		Return(nil, nil)
+
+	// Act
+	result, err := deps.provider().ProfessionSkillHistory(ctx, professionID, "113", from, to, "  Kubernetes ")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.FormalSkills, 1)
+	assert.Equal(t, "kubernetes", result.FormalSkills[0].Skill)
+	// Точки выровнены по всем сессиям профессии, а не только по сессиям с навыком
+	assert.Equal(t, []domain.SkillHistoryPoint{
+		{ScrapedAt: jan, Count: 0},
+		{ScrapedAt: feb, Count: 20},
+	}, result.FormalSkills[0].Data)
+	assert.Empty(t, result.ExtractedSkills)
+}
+
+func TestProvider_ProfessionSkillHistory_InvalidRange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	from := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	result, err := deps.provider().ProfessionSkillHistory(ctx, uuid.New(), "113", from, to, "")
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrInvalidDateRange)
+	assert.Nil(t, result)
+}
+
+func TestProvider_ProfessionSkillHistory_ProfessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).
+		Return(domain.Profession{}, domain.ErrProfessionNotFound)
+
+	// Act
+	result, err := deps.provider().ProfessionSkillHistory(ctx, professionID, "113", from, to, "")
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrProfessionNotFound)
+	assert.Nil(t, result)
+}
+
+// ==================== CompareProfessions ====================
+
+func TestProvider_CompareProfessions_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	goID := uuid.New()
+	pyID := uuid.New()
+	ids := []uuid.UUID{goID, pyID}
+	sessionID := uuid.New()
+	scrapedAt := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	day1 := time.Date(2025, 2, 27, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, goID).
+		Return(domain.Profession{ID: goID, Name: "Go Developer"}, nil)
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, pyID).
+		Return(domain.Profession{ID: pyID, Name: "Python Developer"}, nil)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).
+		Return(domain.Scraping{ID: sessionID, ScrapedAt: scrapedAt}, nil)
+	// сессия ищется по идентификатору, а не по времени сбора
+	deps.statProvider.EXPECT().GetStatsByProfessionsAndSession(ctx, ids, sessionID, "113").
+		Return([]domain.Stat{
+			{ProfessionID: goID, VacancyCount: 500},
+			{ProfessionID: pyID, VacancyCount: 800},
+		}, nil)
+	deps.skillsProvider.EXPECT().GetFormalSkillsByProfessionsAndSession(ctx, ids, sessionID, "113").
+		Return(map[uuid.UUID][]domain.Skill{
+			goID: {
+				{Skill: "golang", Count: 300},
+				{Skill: "docker", Count: 150},
+				{Skill: "sql", Count: 100},
+			},
+			pyID: {
+				{Skill: "python", Count: 600},
+				{Skill: "sql", Count: 200},
+				{Skill: "docker", Count: 50},
+			},
+		}, nil)
+	deps.dailyStatProvider.EXPECT().GetStatDailyByProfessionIDs(ctx, ids, "113").
+		Return(map[uuid.UUID][]domain.StatDailyPoint{
+			goID: {
+				{Date: day1, VacancyCount: 480},
+				{Date: day2, VacancyCount: 500},
+			},
+			pyID: {
+				{Date: day2, VacancyCount: 800},
+			},
+		}, nil)
+
+	// Act
+	result, err := deps.provider().CompareProfessions(ctx, ids, "113", 3)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, scrapedAt, result.ScrapedAt)
+
+	require.Len(t, result.Professions, 2)
+	assert.Equal(t, "Go Developer", result.Professions[0].ProfessionName)
+	assert.Equal(t, int32(500), result.Professions[0].VacancyCount)
+	assert.Equal(t, "golang", result.Professions[0].TopSkills[0].Skill)
+	assert.Equal(t, int32(800), result.Professions[1].VacancyCount)
+
+	// Тренды выровнены по общей оси дат
+	require.Len(t, result.Trend, 2)
+	assert.Equal(t, day1, result.Trend[0].Date)
+	assert.Equal(t, map[uuid.UUID]int32{goID: 480}, result.Trend[0].VacancyCounts)
+	assert.Equal(t, map[uuid.UUID]int32{goID: 500, pyID: 800}, result.Trend[1].VacancyCounts)
+
+	// Пересечение содержит только общие навыки, сначала с большей суммой
+	require.Len(t, result.SkillOverlap, 2)
+	assert.Equal(t, "sql", result.SkillOverlap[0].Skill)
+	assert.Equal(t, map[uuid.UUID]int32{goID: 100, pyID: 200}, result.SkillOverlap[0].Counts)
+	assert.Equal(t, "docker", result.SkillOverlap[1].Skill)
+}
+
+func TestProvider_CompareProfessions_TopSkillsLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	goID := uuid.New()
+	pyID := uuid.New()
+	ids := []uuid.UUID{goID, pyID}
+	sessionID := uuid.New()
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, mock.Anything).
+		Return(domain.Profession{Name: "Developer"}, nil).Times(2)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).
+		Return(domain.Scraping{ID: sessionID, ScrapedAt: time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)}, nil)
+	deps.statProvider.EXPECT().GetStatsByProfessionsAndSession(ctx, ids, sessionID, "113").
+		Return(nil, nil)
+	deps.skillsProvider.EXPECT().GetFormalSkillsByProfessionsAndSession(ctx, ids, sessionID, "113").
+		Return(map[uuid.UUID][]domain.Skill{
+			goID: {
+				{Skill: "golang", Count: 300},
+				{Skill: "sql", Count: 10},
+			},
+			pyID: {
+				{Skill: "python", Count: 600},
+				{Skill: "sql", Count: 200},
+			},
+		}, nil)
+	deps.dailyStatProvider.EXPECT().GetStatDailyByProfessionIDs(ctx, ids, "113").
+		Return(map[uuid.UUID][]domain.StatDailyPoint{}, nil)
+
+	// Act
+	result, err := deps.provider().CompareProfessions(ctx, ids, "113", 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Professions[0].TopSkills, 1)
+	require.Len(t, result.Professions[1].TopSkills, 1)
+	// sql не входит в топ-1 у Go, поэтому пересечения нет
+	assert.Empty(t, result.SkillOverlap)
+	assert.Empty(t, result.Trend)
+}
+
+func TestProvider_CompareProfessions_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	tests := []struct {
+		name string
+		ids  []uuid.UUID
+		top  int
+	}{
+		{name: "single profession", ids: []uuid.UUID{id}, top: 10},
+		{name: "duplicates only", ids: []uuid.UUID{id, id}, top: 10},
+		{name: "too many professions", ids: make([]uuid.UUID, 0), top: 10},
+		{name: "zero top", ids: []uuid.UUID{id, uuid.New()}, top: 0},
+	}
+	for i := 0; i <= maxComparedProfessions; i++ {
+		tests[2].ids = append(tests[2].ids, uuid.New())
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			deps := newDeps(t)
+
+			result, err := deps.provider().CompareProfessions(context.Background(), tt.ids, "113", tt.top)
+
+			require.ErrorIs(t, err, domain.ErrInvalidComparison)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestProvider_CompareProfessions_ProfessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	goID := uuid.New()
+	missingID := uuid.New()
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, goID).
+		Return(domain.Profession{ID: goID, Name: "Go Developer"}, nil)
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, missingID).
+		Return(domain.Profession{}, domain.ErrProfessionNotFound)
+
+	// Act
+	result, err := deps.provider().CompareProfessions(ctx, []uuid.UUID{goID, missingID}, "113", 10)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrProfessionNotFound)
+	assert.Nil(t, result)
+}
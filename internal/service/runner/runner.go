@@ -0,0 +1,273 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+type RunProvider interface {
+	CreateScrapingRun(ctx context.Context, run domain.ScrapingRun) error
+	FinishScrapingRun(ctx context.Context, id uuid.UUID, status, errText string) error
+	GetScrapingRuns(ctx context.Context, limit int) ([]domain.ScrapingRun, error)
+	GetScrapingRunByID(ctx context.Context, id uuid.UUID) (domain.ScrapingRun, error)
+}
+
+type ProfessionRunProvider interface {
+	GetProfessionRunsByRun(ctx context.Context, runID uuid.UUID) ([]domain.ProfessionRun, error)
+}
+
+// JobProvider cancels the queued jobs of a run processed by the scraping workers and finishes the archive sessions
+// they fill. InTx runs fn in a transaction, so that the jobs, their sessions and the run are cancelled together.
+type JobProvider interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	CancelScrapingJobs(ctx context.Context, runID uuid.UUID) ([]domain.ScrapingJob, error)
+	FinishQueuedScraping(ctx context.Context, sessionID uuid.UUID, runID uuid.UUID) error
+}
+
+// Runner records scraping runs: every run is persisted when it starts and finished with its outcome.
+// Runs executing in this process are kept in a registry so that they can be cancelled. With a job queue
+// a run only enqueues its professions and is completed by the worker ending its last job.
+type Runner struct {
+	runs           RunProvider
+	professionRuns ProfessionRunProvider
+	jobs           JobProvider
+	startTimeout   time.Duration
+	inProgress     atomic.Bool
+
+	mu     sync.Mutex
+	active map[uuid.UUID]context.CancelCauseFunc
+}
+
+// New builds the runner. jobs is nil when runs are processed in this process. startTimeout bounds a run started
+// in the background; without the queue such a run scrapes every profession and area, so it is set to fit them.
+func New(runs RunProvider, professionRuns ProfessionRunProvider, jobs JobProvider, startTimeout time.Duration) *Runner {
+	return &Runner{
+		runs:           runs,
+		professionRuns: professionRuns,
+		jobs:           jobs,
+		startTimeout:   startTimeout,
+		active:         make(map[uuid.UUID]context.CancelCauseFunc),
+	}
+}
+
+// Run records the run and executes task synchronously, passing it the run ID.
+func (r *Runner) Run(ctx context.Context, run domain.ScrapingRun, task func(ctx context.Context, runID uuid.UUID) error) error {
+	const op = "service.runner.Run"
+
+	run, err := r.begin(ctx, run)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return r.execute(ctx, run, task)
+}
+
+// Start records the run and executes task in the background bounded by the start timeout. Only one started run
+// executes at a time; while it does, Start returns domain.ErrScrapingInProgress.
+func (r *Runner) Start(ctx context.Context, run domain.ScrapingRun, task func(ctx context.Context, runID uuid.UUID) error) (domain.ScrapingRun, error) {
+	const op = "service.runner.Start"
+
+	if !r.inProgress.CompareAndSwap(false, true) {
+		return domain.ScrapingRun{}, domain.ErrScrapingInProgress
+	}
+
+	run, err := r.begin(ctx, run)
+	if err != nil {
+		r.inProgress.Store(false)
+		return domain.ScrapingRun{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	go func() {
+		defer r.inProgress.Store(false)
+
+		taskCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.startTimeout)
+		defer cancel()
+
+		log := loggerctx.FromContext(taskCtx).With("op", op, "run_id", run.ID, "mode", run.Mode)
+
+		if err := r.execute(taskCtx, run, task); err != nil {
+			log.Error("scraping_failed", slogx.Err(err))
+			return
+		}
+
+		log.Info("scraping_task_completed")
+	}()
+
+	return run, nil
+}
+
+// Cancel stops a run executing in this process. The run context is cancelled with domain.ErrScrapingRunCancelled,
+// the scraper starts no more professions, the ones in flight are recorded as failed and the run as cancelled. With a job queue a running run
+// is cancelled with its queued jobs and the archive sessions they fill are failed, as no worker ends their jobs
+// any more; jobs already taken by the workers are let finish. Any other run that is not executing in this process
+// returns domain.ErrScrapingRunNotActive.
+func (r *Runner) Cancel(ctx context.Context, id uuid.UUID) error {
+	const op = "service.runner.Cancel"
+
+	r.mu.Lock()
+	cancel, ok := r.active[id]
+	r.mu.Unlock()
+
+	if ok {
+		cancel(domain.ErrScrapingRunCancelled)
+		loggerctx.FromContext(ctx).Info("scraping_run_cancel_requested", "run_id", id)
+		return nil
+	}
+
+	run, err := r.runs.GetScrapingRunByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrScrapingRunNotFound) {
+			return domain.ErrScrapingRunNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if r.jobs == nil || run.Status != domain.ScrapingRunStatusRunning {
+		return domain.ErrScrapingRunNotActive
+	}
+
+	var cancelled []domain.ScrapingJob
+	err = r.jobs.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if cancelled, err = r.jobs.CancelScrapingJobs(ctx, id); err != nil {
+			return err
+		}
+
+		for _, sessionID := range archiveSessions(cancelled) {
+			if err := r.jobs.FinishQueuedScraping(ctx, sessionID, id); err != nil {
+				return err
+			}
+		}
+
+		return r.runs.FinishScrapingRun(ctx, id, domain.ScrapingRunStatusCancelled, domain.ErrScrapingRunCancelled.Error())
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	loggerctx.FromContext(ctx).Info("scraping_run_jobs_cancelled", "run_id", id, "job_count", len(cancelled))
+
+	return nil
+}
+
+// Runs returns the latest runs, newest first.
+func (r *Runner) Runs(ctx context.Context, limit int) ([]domain.ScrapingRun, error) {
+	const op = "service.runner.Runs"
+
+	runs, err := r.runs.GetScrapingRuns(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return runs, nil
+}
+
+// RunByID returns the run with the outcome of every profession it processed.
+func (r *Runner) RunByID(ctx context.Context, id uuid.UUID) (domain.ScrapingRun, error) {
+	const op = "service.runner.RunByID"
+
+	run, err := r.runs.GetScrapingRunByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrScrapingRunNotFound) {
+			return domain.ScrapingRun{}, domain.ErrScrapingRunNotFound
+		}
+		return domain.ScrapingRun{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	professions, err := r.professionRuns.GetProfessionRunsByRun(ctx, id)
+	if err != nil {
+		return domain.ScrapingRun{}, fmt.Errorf("%s: %w", op, err)
+	}
+	run.Professions = professions
+
+	return run, nil
+}
+
+func (r *Runner) begin(ctx context.Context, run domain.ScrapingRun) (domain.ScrapingRun, error) {
+	if run.ID == uuid.Nil {
+		run.ID = uuid.New()
+	}
+	run.Status = domain.ScrapingRunStatusRunning
+	run.StartedAt = time.Now().UTC()
+
+	if err := r.runs.CreateScrapingRun(ctx, run); err != nil {
+		return domain.ScrapingRun{}, err
+	}
+
+	loggerctx.FromContext(ctx).Info("scraping_run_created", "run_id", run.ID, "mode", run.Mode, "trigger", run.Trigger)
+
+	return run, nil
+}
+
+// execute runs task registered as active and finishes the run with its outcome. A panic in task fails the run.
+func (r *Runner) execute(ctx context.Context, run domain.ScrapingRun, task func(ctx context.Context, runID uuid.UUID) error) (err error) {
+	const op = "service.runner.execute"
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	r.register(run.ID, cancel)
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			loggerctx.FromContext(ctx).Error("scraping_panic", "run_id", run.ID, "mode", run.Mode,
+				"panic", rec, "stack", string(debug.Stack()))
+			err = fmt.Errorf("%s: panic: %v", op, rec)
+		}
+
+		r.unregister(run.ID)
+		r.finish(ctx, run.ID, err)
+		cancel(nil)
+	}()
+
+	return task(ctx, run.ID)
+}
+
+func (r *Runner) register(id uuid.UUID, cancel context.CancelCauseFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.active[id] = cancel
+}
+
+func (r *Runner) unregister(id uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.active, id)
+}
+
+// finish records the outcome of the run. The context of the run may be done by now, so its cancellation is dropped.
+func (r *Runner) finish(ctx context.Context, runID uuid.UUID, runErr error) {
+	status, errText := domain.ScrapingRunStatusCompleted, ""
+	if runErr != nil {
+		status, errText = domain.ScrapingRunStatusFailed, runErr.Error()
+		if errors.Is(context.Cause(ctx), domain.ErrScrapingRunCancelled) {
+			status = domain.ScrapingRunStatusCancelled
+		}
+	}
+
+	if err := r.runs.FinishScrapingRun(context.WithoutCancel(ctx), runID, status, errText); err != nil {
+		loggerctx.FromContext(ctx).Warn("scraping_run_finish_failed", "run_id", runID, slogx.Err(err))
+	}
+}
+
+// archiveSessions returns the archive sessions the jobs fill, each once.
+func archiveSessions(jobs []domain.ScrapingJob) []uuid.UUID {
+	var sessions []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, job := range jobs {
+		if job.SaveToDB && !seen[job.SessionID] {
+			seen[job.SessionID] = true
+			sessions = append(sessions, job.SessionID)
+		}
+	}
+	return sessions
+}
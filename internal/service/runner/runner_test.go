@@ -0,0 +1,582 @@
+package runner
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/service/runner/mocks"
+)
+
+// testDeps содержит зависимости для тестирования Runner
+type testDeps struct {
+	runs           *mocks.MockRunProvider
+	professionRuns *mocks.MockProfessionRunProvider
+	jobs           *mocks.MockJobProvider
+}
+
+func newDeps(t *testing.T) testDeps {
+	t.Helper()
+	return testDeps{
+		runs:           mocks.NewMockRunProvider(t),
+		professionRuns: mocks.NewMockProfessionRunProvider(t),
+		jobs:           mocks.NewMockJobProvider(t),
+	}
+}
+
+func (d testDeps) runner() *Runner {
+	return New(d.runs, d.professionRuns, d.jobs, time.Hour)
+}
+
+func cronRun() domain.ScrapingRun {
+	return domain.ScrapingRun{Mode: domain.ScrapingModeArchive, Trigger: domain.ScrapingTriggerCron}
+}
+
+// ==================== Run ====================
+
+func TestRunner_Run_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.MatchedBy(func(run domain.ScrapingRun) bool {
+		return run.ID == runID && run.Mode == domain.ScrapingModeArchive && run.Trigger == domain.ScrapingTriggerCron &&
+			run.Status == domain.ScrapingRunStatusRunning && !run.StartedAt.IsZero()
+	})).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, runID, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+
+	run := cronRun()
+	run.ID = runID
+
+	var taskRunID uuid.UUID
+
+	// Act
+	err := deps.runner().Run(ctx, run, func(_ context.Context, id uuid.UUID) error {
+		taskRunID = id
+		return nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, runID, taskRunID)
+}
+
+func TestRunner_Run_GeneratesID(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	var createdID uuid.UUID
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).RunAndReturn(func(_ context.Context, run domain.ScrapingRun) error {
+		createdID = run.ID
+		return nil
+	}).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, mock.Anything, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+
+	var taskRunID uuid.UUID
+
+	// Act
+	err := deps.runner().Run(ctx, cronRun(), func(_ context.Context, id uuid.UUID) error {
+		taskRunID = id
+		return nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, createdID)
+	assert.Equal(t, createdID, taskRunID)
+}
+
+func TestRunner_Run_TaskError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, mock.Anything, domain.ScrapingRunStatusFailed, assert.AnError.Error()).Return(nil).Once()
+
+	// Act
+	err := deps.runner().Run(ctx, cronRun(), func(context.Context, uuid.UUID) error {
+		return assert.AnError
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestRunner_Run_Panic(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, mock.Anything, domain.ScrapingRunStatusFailed,
+		mock.MatchedBy(func(errText string) bool {
+			return strings.Contains(errText, "panic: boom")
+		})).Return(nil).Once()
+
+	// Act
+	err := deps.runner().Run(ctx, cronRun(), func(context.Context, uuid.UUID) error {
+		panic("boom")
+	})
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestRunner_Run_CreateError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).Return(assert.AnError).Once()
+
+	// Act
+	err := deps.runner().Run(ctx, cronRun(), func(context.Context, uuid.UUID) error {
+		t.Fatal("task must not run without a recorded run")
+		return nil
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestRunner_Run_FinishAfterTimeout(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	deps.runs.EXPECT().CreateScrapingRun(mock.Anything, mock.Anything).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}), mock.Anything, domain.ScrapingRunStatusFailed, mock.Anything).Return(nil).Once()
+
+	// Act
+	err := deps.runner().Run(ctx, cronRun(), func(ctx context.Context, _ uuid.UUID) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// ==================== Start ====================
+
+func TestRunner_Start_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	finished := make(chan struct{})
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, mock.Anything, domain.ScrapingRunStatusCompleted, "").
+		RunAndReturn(func(context.Context, uuid.UUID, string, string) error {
+			close(finished)
+			return nil
+		}).Once()
+
+	var (
+		taskRunID   uuid.UUID
+		hasDeadline bool
+	)
+
+	// Act
+	run, err := deps.runner().Start(ctx, domain.ScrapingRun{Mode: domain.ScrapingModeCache, Trigger: domain.ScrapingTriggerManual},
+		func(ctx context.Context, id uuid.UUID) error {
+			taskRunID = id
+			_, hasDeadline = ctx.Deadline()
+			return nil
+		})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, run.ID)
+	assert.Equal(t, domain.ScrapingRunStatusRunning, run.Status)
+
+	<-finished
+	assert.Equal(t, run.ID, taskRunID)
+	assert.True(t, hasDeadline, "background run should have a timeout")
+}
+
+func TestRunner_Start_ConcurrentBlocked(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+	r := deps.runner()
+
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).Return(nil).Twice()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, mock.Anything, domain.ScrapingRunStatusCompleted, "").
+		RunAndReturn(func(context.Context, uuid.UUID, string, string) error {
+			finished <- struct{}{}
+			return nil
+		}).Twice()
+
+	task := func(context.Context, uuid.UUID) error {
+		<-release
+		return nil
+	}
+
+	// Act: первый запуск выполняется, второй блокируется
+	_, err := r.Start(ctx, cronRun(), task)
+	require.NoError(t, err)
+
+	_, err = r.Start(ctx, cronRun(), task)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingInProgress)
+
+	// Act: после завершения первого запуска можно запустить снова
+	close(release)
+	<-finished
+	require.Eventually(t, func() bool { return !r.inProgress.Load() }, time.Second, time.Millisecond)
+
+	_, err = r.Start(ctx, cronRun(), task)
+
+	// Assert
+	require.NoError(t, err)
+	<-finished
+}
+
+func TestRunner_Start_CreateError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+	r := deps.runner()
+
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).Return(assert.AnError).Once()
+
+	// Act
+	_, err := r.Start(ctx, cronRun(), func(context.Context, uuid.UUID) error { return nil })
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.False(t, r.inProgress.Load(), "failed start should not block later runs")
+}
+
+// ==================== Cancel ====================
+
+func TestRunner_Cancel_ActiveRun(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+	r := deps.runner()
+
+	started := make(chan struct{})
+	finished := make(chan struct{})
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, mock.Anything, domain.ScrapingRunStatusCancelled,
+		domain.ErrScrapingRunCancelled.Error()).
+		RunAndReturn(func(context.Context, uuid.UUID, string, string) error {
+			close(finished)
+			return nil
+		}).Once()
+
+	run, err := r.Start(ctx, cronRun(), func(ctx context.Context, _ uuid.UUID) error {
+		close(started)
+		<-ctx.Done()
+		return context.Cause(ctx)
+	})
+	require.NoError(t, err)
+	<-started
+
+	// Act
+	err = r.Cancel(ctx, run.ID)
+
+	// Assert
+	require.NoError(t, err)
+	<-finished
+}
+
+func TestRunner_Cancel_TimeoutIsNotCancellation(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	deps := newDeps(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	deps.runs.EXPECT().CreateScrapingRun(mock.Anything, mock.Anything).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, mock.Anything, domain.ScrapingRunStatusFailed, mock.Anything).Return(nil).Once()
+
+	// Act
+	err := deps.runner().Run(ctx, cronRun(), func(ctx context.Context, _ uuid.UUID) error {
+		<-ctx.Done()
+		return context.Cause(ctx)
+	})
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRunner_Cancel_FinishedRun(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).
+		Return(domain.ScrapingRun{ID: runID, Status: domain.ScrapingRunStatusCompleted}, nil).Once()
+
+	// Act
+	err := deps.runner().Cancel(ctx, runID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingRunNotActive)
+}
+
+func TestRunner_Cancel_QueuedRun(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	sessionID := uuid.New()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).
+		Return(domain.ScrapingRun{ID: runID, Status: domain.ScrapingRunStatusRunning}, nil).Once()
+	deps.jobs.EXPECT().InTx(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Once()
+	// все задания ещё в очереди: ни один воркер не завершит сессию, её завершает отмена
+	deps.jobs.EXPECT().CancelScrapingJobs(ctx, runID).Return([]domain.ScrapingJob{
+		{ID: uuid.New(), RunID: runID, SessionID: sessionID, SaveToDB: true, Status: domain.ScrapingJobStatusCancelled},
+		{ID: uuid.New(), RunID: runID, SessionID: sessionID, SaveToDB: true, Status: domain.ScrapingJobStatusCancelled},
+		{ID: uuid.New(), RunID: runID, SessionID: sessionID, SaveToDB: true, Status: domain.ScrapingJobStatusCancelled},
+	}, nil).Once()
+	deps.jobs.EXPECT().FinishQueuedScraping(ctx, sessionID, runID).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(ctx, runID, domain.ScrapingRunStatusCancelled,
+		domain.ErrScrapingRunCancelled.Error()).Return(nil).Once()
+
+	// Act: задания запуска обрабатывают воркеры, в этом процессе запуск не выполняется
+	err := deps.runner().Cancel(ctx, runID)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestRunner_Cancel_QueuedRunWithoutSession(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).
+		Return(domain.ScrapingRun{ID: runID, Status: domain.ScrapingRunStatusRunning}, nil).Once()
+	deps.jobs.EXPECT().InTx(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Once()
+	deps.jobs.EXPECT().CancelScrapingJobs(ctx, runID).Return([]domain.ScrapingJob{
+		{ID: uuid.New(), RunID: runID, Status: domain.ScrapingJobStatusCancelled},
+	}, nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(ctx, runID, domain.ScrapingRunStatusCancelled,
+		domain.ErrScrapingRunCancelled.Error()).Return(nil).Once()
+
+	// Act: кэш-режим не пишет сессию архива
+	err := deps.runner().Cancel(ctx, runID)
+
+	// Assert
+	require.NoError(t, err)
+	deps.jobs.AssertNotCalled(t, "FinishQueuedScraping", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunner_Cancel_QueuedRunSessionError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	sessionID := uuid.New()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).
+		Return(domain.ScrapingRun{ID: runID, Status: domain.ScrapingRunStatusRunning}, nil).Once()
+	deps.jobs.EXPECT().InTx(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Once()
+	deps.jobs.EXPECT().CancelScrapingJobs(ctx, runID).Return([]domain.ScrapingJob{
+		{ID: uuid.New(), RunID: runID, SessionID: sessionID, SaveToDB: true, Status: domain.ScrapingJobStatusCancelled},
+	}, nil).Once()
+	deps.jobs.EXPECT().FinishQueuedScraping(ctx, sessionID, runID).Return(assert.AnError).Once()
+
+	// Act
+	err := deps.runner().Cancel(ctx, runID)
+
+	// Assert: транзакция откатывается, запуск не отмечается отменённым
+	require.ErrorIs(t, err, assert.AnError)
+	deps.runs.AssertNotCalled(t, "FinishScrapingRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunner_Cancel_RunningWithoutQueue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).
+		Return(domain.ScrapingRun{ID: runID, Status: domain.ScrapingRunStatusRunning}, nil).Once()
+
+	// Act: запуск выполняется в другом процессе
+	err := New(deps.runs, deps.professionRuns, nil, time.Hour).Cancel(ctx, runID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingRunNotActive)
+}
+
+func TestRunner_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).Return(domain.ScrapingRun{}, domain.ErrScrapingRunNotFound).Once()
+
+	// Act
+	err := deps.runner().Cancel(ctx, runID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingRunNotFound)
+}
+
+func TestRunner_Cancel_AfterRunFinished(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+	r := deps.runner()
+
+	runID := uuid.New()
+	deps.runs.EXPECT().CreateScrapingRun(ctx, mock.Anything).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, runID, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).
+		Return(domain.ScrapingRun{ID: runID, Status: domain.ScrapingRunStatusCompleted}, nil).Once()
+
+	run := cronRun()
+	run.ID = runID
+	require.NoError(t, r.Run(ctx, run, func(context.Context, uuid.UUID) error { return nil }))
+
+	// Act: завершённый запуск удалён из реестра
+	err := r.Cancel(ctx, runID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingRunNotActive)
+}
+
+// ==================== Runs & RunByID ====================
+
+func TestRunner_Runs(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runs := []domain.ScrapingRun{{ID: uuid.New()}, {ID: uuid.New()}}
+	deps.runs.EXPECT().GetScrapingRuns(ctx, 20).Return(runs, nil).Once()
+
+	// Act
+	result, err := deps.runner().Runs(ctx, 20)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, runs, result)
+}
+
+func TestRunner_RunByID_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	professions := []domain.ProfessionRun{{ProfessionID: uuid.New(), Status: domain.ProfessionRunStatusSuccess}}
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).Return(domain.ScrapingRun{ID: runID}, nil).Once()
+	deps.professionRuns.EXPECT().GetProfessionRunsByRun(ctx, runID).Return(professions, nil).Once()
+
+	// Act
+	result, err := deps.runner().RunByID(ctx, runID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, runID, result.ID)
+	assert.Equal(t, professions, result.Professions)
+}
+
+func TestRunner_RunByID_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).Return(domain.ScrapingRun{}, domain.ErrScrapingRunNotFound).Once()
+
+	// Act
+	_, err := deps.runner().RunByID(ctx, runID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingRunNotFound)
+}
+
+func TestRunner_RunByID_ProfessionsError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	deps.runs.EXPECT().GetScrapingRunByID(ctx, runID).Return(domain.ScrapingRun{ID: runID}, nil).Once()
+	deps.professionRuns.EXPECT().GetProfessionRunsByRun(ctx, runID).Return(nil, assert.AnError).Once()
+
+	// Act
+	_, err := deps.runner().RunByID(ctx, runID)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,60 @@
+package scraper
+
+import (
+	"psa/internal/domain"
+)
+
+// canonicalSkills returns a copy of the vacancies with key skills replaced by their canonical names,
+// so that "golang" and "go" count as one skill. A skill listed under several aliases in one vacancy
+// is kept once. The vacancies are not modified: they are stored with the skills as published.
+func canonicalSkills(data []domain.VacancyData, aliases map[string]string) []domain.VacancyData {
+	if len(aliases) == 0 {
+		return data
+	}
+
+	result := make([]domain.VacancyData, len(data))
+	for i, d := range data {
+		skills := make([]string, 0, len(d.Skills))
+		seen := make(map[string]struct{}, len(d.Skills))
+		for _, skill := range d.Skills {
+			skill = canonicalSkill(skill, aliases)
+			if _, ok := seen[skill]; ok {
+				continue
+			}
+			seen[skill] = struct{}{}
+			skills = append(skills, skill)
+		}
+
+		d.Skills = skills
+		result[i] = d
+	}
+
+	return result
+}
+
+// canonicalSkill returns the canonical name of the skill, or the skill itself when it is not an alias.
+func canonicalSkill(skill string, aliases map[string]string) string {
+	if canonical, ok := aliases[skill]; ok {
+		return canonical
+	}
+	return skill
+}
+
+// withAliases returns the white list extended with the aliases of its skills.
+func withAliases(whiteList map[string]int, aliases map[string]string) map[string]int {
+	if len(aliases) == 0 {
+		return whiteList
+	}
+
+	result := make(map[string]int, len(whiteList))
+	for skill, count := range whiteList {
+		result[skill] = count
+	}
+	for alias, skill := range aliases {
+		if count, ok := whiteList[skill]; ok {
+			result[alias] = count
+		}
+	}
+
+	return result
+}
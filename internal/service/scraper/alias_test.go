@@ -0,0 +1,72 @@
+package scraper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"psa/internal/domain"
+)
+
+func TestCanonicalSkills(t *testing.T) {
+	aliases := map[string]string{"golang": "go", "postgres": "postgresql"}
+
+	tests := []struct {
+		name     string
+		data     []domain.VacancyData
+		aliases  map[string]string
+		expected [][]string
+	}{
+		{
+			name:     "aliases replaced",
+			data:     []domain.VacancyData{{Skills: []string{"golang", "postgres", "docker"}}},
+			aliases:  aliases,
+			expected: [][]string{{"go", "postgresql", "docker"}},
+		},
+		{
+			name:     "alias and canonical skill in one vacancy",
+			data:     []domain.VacancyData{{Skills: []string{"go", "golang"}}, {Skills: []string{"golang"}}},
+			aliases:  aliases,
+			expected: [][]string{{"go"}, {"go"}},
+		},
+		{
+			name:     "no aliases",
+			data:     []domain.VacancyData{{Skills: []string{"golang", "go"}}},
+			aliases:  nil,
+			expected: [][]string{{"golang", "go"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := canonicalSkills(tt.data, tt.aliases)
+
+			skills := make([][]string, len(result))
+			for i, d := range result {
+				skills[i] = d.Skills
+			}
+			assert.Equal(t, tt.expected, skills)
+		})
+	}
+}
+
+func TestCanonicalSkills_KeepsVacancies(t *testing.T) {
+	data := []domain.VacancyData{{ID: "1", Skills: []string{"golang"}}}
+
+	result := canonicalSkills(data, map[string]string{"golang": "go"})
+
+	assert.Equal(t, "1", result[0].ID)
+	assert.Equal(t, []string{"go"}, result[0].Skills)
+	assert.Equal(t, []string{"golang"}, data[0].Skills)
+}
+
+func TestWithAliases(t *testing.T) {
+	whiteList := map[string]int{"go": 5, "docker": 3}
+	aliases := map[string]string{"golang": "go", "postgres": "postgresql"}
+
+	result := withAliases(whiteList, aliases)
+
+	// синоним навыка не из белого списка не добавляется
+	assert.Equal(t, map[string]int{"go": 5, "golang": 5, "docker": 3}, result)
+	assert.Equal(t, map[string]int{"go": 5, "docker": 3}, whiteList)
+}
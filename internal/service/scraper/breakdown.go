@@ -0,0 +1,57 @@
+package scraper
+
+import (
+	"sort"
+
+	"psa/internal/domain"
+)
+
+// vacancyBreakdown counts vacancies by required experience, employment type, schedule and source.
+func vacancyBreakdown(data []domain.VacancyData) domain.VacancyBreakdown {
+	experience := make(map[string]int32)
+	employment := make(map[string]int32)
+	schedule := make(map[string]int32)
+	source := make(map[string]int32)
+
+	for _, d := range data {
+		if d.Experience != "" {
+			experience[d.Experience]++
+		}
+		if d.Employment != "" {
+			employment[d.Employment]++
+		}
+		if d.Schedule != "" {
+			schedule[d.Schedule]++
+		}
+		if d.Source != "" {
+			source[d.Source]++
+		}
+	}
+
+	return domain.VacancyBreakdown{
+		Experience: breakdownItems(experience),
+		Employment: breakdownItems(employment),
+		Schedule:   breakdownItems(schedule),
+		Source:     breakdownItems(source),
+	}
+}
+
+func breakdownItems(counts map[string]int32) []domain.BreakdownItem {
+	items := make([]domain.BreakdownItem, 0, len(counts))
+	for value, count := range counts {
+		items = append(items, domain.BreakdownItem{Value: value, Count: count})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Value < items[j].Value
+	})
+
+	return items
+}
+
+func isEmptyBreakdown(b domain.VacancyBreakdown) bool {
+	return len(b.Experience) == 0 && len(b.Employment) == 0 && len(b.Schedule) == 0 && len(b.Source) == 0
+}
@@ -0,0 +1,49 @@
+package scraper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"psa/internal/domain"
+)
+
+func TestVacancyBreakdown(t *testing.T) {
+	data := []domain.VacancyData{
+		{Experience: "between3And6", Employment: "full", Schedule: "remote", Source: domain.SourceHH},
+		{Experience: "between3And6", Employment: "full", Schedule: "fullDay", Source: domain.SourceHH},
+		{Experience: "between1And3", Employment: "full", Schedule: "remote", Source: domain.SourceFile},
+		{Experience: "moreThan6", Employment: "part", Source: domain.SourceHH},
+		{},
+	}
+
+	result := vacancyBreakdown(data)
+
+	// Сортировка по убыванию количества, при равенстве — по значению; пустые значения не считаются
+	assert.Equal(t, domain.VacancyBreakdown{
+		Experience: []domain.BreakdownItem{
+			{Value: "between3And6", Count: 2},
+			{Value: "between1And3", Count: 1},
+			{Value: "moreThan6", Count: 1},
+		},
+		Employment: []domain.BreakdownItem{
+			{Value: "full", Count: 3},
+			{Value: "part", Count: 1},
+		},
+		Schedule: []domain.BreakdownItem{
+			{Value: "remote", Count: 2},
+			{Value: "fullDay", Count: 1},
+		},
+		Source: []domain.BreakdownItem{
+			{Value: domain.SourceHH, Count: 3},
+			{Value: domain.SourceFile, Count: 1},
+		},
+	}, result)
+	assert.False(t, isEmptyBreakdown(result))
+}
+
+func TestVacancyBreakdown_Empty(t *testing.T) {
+	result := vacancyBreakdown([]domain.VacancyData{{}, {}})
+
+	assert.True(t, isEmptyBreakdown(result))
+}
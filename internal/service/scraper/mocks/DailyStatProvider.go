@@ -40,16 +40,16 @@ func (_m *MockDailyStatProvider) EXPECT() *MockDailyStatProvider_Expecter {
 }
 
 // SaveStatDaily provides a mock function for the type MockDailyStatProvider
-func (_mock *MockDailyStatProvider) SaveStatDaily(ctx context.Context, professionID uuid.UUID, vacancyCount int, scrapedAt time.Time) error {
-	ret := _mock.Called(ctx, professionID, vacancyCount, scrapedAt)
+func (_mock *MockDailyStatProvider) SaveStatDaily(ctx context.Context, professionID uuid.UUID, area string, vacancyCount int, scrapedAt time.Time) error {
+	ret := _mock.Called(ctx, professionID, area, vacancyCount, scrapedAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveStatDaily")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, time.Time) error); ok {
-		r0 = returnFunc(ctx, professionID, vacancyCount, scrapedAt)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int, time.Time) error); ok {
+		r0 = returnFunc(ctx, professionID, area, vacancyCount, scrapedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -64,13 +64,14 @@ type MockDailyStatProvider_SaveStatDaily_Call struct {
 // SaveStatDaily is a helper method to define mock.On call
 //   - ctx context.Context
 //   - professionID uuid.UUID
+//   - area string
 //   - vacancyCount int
 //   - scrapedAt time.Time
-func (_e *MockDailyStatProvider_Expecter) SaveStatDaily(ctx interface{}, professionID interface{}, vacancyCount interface{}, scrapedAt interface{}) *MockDailyStatProvider_SaveStatDaily_Call {
-	return &MockDailyStatProvider_SaveStatDaily_Call{Call: _e.mock.On("SaveStatDaily", ctx, professionID, vacancyCount, scrapedAt)}
+func (_e *MockDailyStatProvider_Expecter) SaveStatDaily(ctx interface{}, professionID interface{}, area interface{}, vacancyCount interface{}, scrapedAt interface{}) *MockDailyStatProvider_SaveStatDaily_Call {
+	return &MockDailyStatProvider_SaveStatDaily_Call{Call: _e.mock.On("SaveStatDaily", ctx, professionID, area, vacancyCount, scrapedAt)}
 }
 
-func (_c *MockDailyStatProvider_SaveStatDaily_Call) Run(run func(ctx context.Context, professionID uuid.UUID, vacancyCount int, scrapedAt time.Time)) *MockDailyStatProvider_SaveStatDaily_Call {
+func (_c *MockDailyStatProvider_SaveStatDaily_Call) Run(run func(ctx context.Context, professionID uuid.UUID, area string, vacancyCount int, scrapedAt time.Time)) *MockDailyStatProvider_SaveStatDaily_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -80,19 +81,24 @@ func (_c *MockDailyStatProvider_SaveStatDaily_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
 		}
-		var arg3 time.Time
+		var arg3 int
 		if args[3] != nil {
-			arg3 = args[3].(time.Time)
+			arg3 = args[3].(int)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -103,7 +109,7 @@ func (_c *MockDailyStatProvider_SaveStatDaily_Call) Return(err error) *MockDaily
 	return _c
 }
 
-func (_c *MockDailyStatProvider_SaveStatDaily_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, vacancyCount int, scrapedAt time.Time) error) *MockDailyStatProvider_SaveStatDaily_Call {
+func (_c *MockDailyStatProvider_SaveStatDaily_Call) RunAndReturn(run func(ctx context.Context, professionID uuid.UUID, area string, vacancyCount int, scrapedAt time.Time) error) *MockDailyStatProvider_SaveStatDaily_Call {
 	_c.Call.Return(run)
 	return _c
 }
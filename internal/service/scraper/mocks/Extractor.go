@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"psa/internal/service/extractor"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -35,76 +37,220 @@ func (_m *MockExtractor) EXPECT() *MockExtractor_Expecter {
 	return &MockExtractor_Expecter{mock: &_m.Mock}
 }
 
-// ExtractSkills provides a mock function for the type MockExtractor
-func (_mock *MockExtractor) ExtractSkills(text string, whiteList map[string]int, maxNgram int) (map[string]int, error) {
-	ret := _mock.Called(text, whiteList, maxNgram)
+// CountsDocuments provides a mock function for the type MockExtractor
+func (_mock *MockExtractor) CountsDocuments() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountsDocuments")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockExtractor_CountsDocuments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountsDocuments'
+type MockExtractor_CountsDocuments_Call struct {
+	*mock.Call
+}
+
+// CountsDocuments is a helper method to define mock.On call
+func (_e *MockExtractor_Expecter) CountsDocuments() *MockExtractor_CountsDocuments_Call {
+	return &MockExtractor_CountsDocuments_Call{Call: _e.mock.On("CountsDocuments")}
+}
+
+func (_c *MockExtractor_CountsDocuments_Call) Run(run func()) *MockExtractor_CountsDocuments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockExtractor_CountsDocuments_Call) Return(b bool) *MockExtractor_CountsDocuments_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockExtractor_CountsDocuments_Call) RunAndReturn(run func() bool) *MockExtractor_CountsDocuments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Match provides a mock function for the type MockExtractor
+func (_mock *MockExtractor) Match(text string, matcher *extractor.Matcher) (map[string]int, error) {
+	ret := _mock.Called(text, matcher)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExtractSkills")
+		panic("no return value specified for Match")
 	}
 
 	var r0 map[string]int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, map[string]int, int) (map[string]int, error)); ok {
-		return returnFunc(text, whiteList, maxNgram)
+	if returnFunc, ok := ret.Get(0).(func(string, *extractor.Matcher) (map[string]int, error)); ok {
+		return returnFunc(text, matcher)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, map[string]int, int) map[string]int); ok {
-		r0 = returnFunc(text, whiteList, maxNgram)
+	if returnFunc, ok := ret.Get(0).(func(string, *extractor.Matcher) map[string]int); ok {
+		r0 = returnFunc(text, matcher)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]int)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, map[string]int, int) error); ok {
-		r1 = returnFunc(text, whiteList, maxNgram)
+	if returnFunc, ok := ret.Get(1).(func(string, *extractor.Matcher) error); ok {
+		r1 = returnFunc(text, matcher)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockExtractor_ExtractSkills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtractSkills'
-type MockExtractor_ExtractSkills_Call struct {
+// MockExtractor_Match_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Match'
+type MockExtractor_Match_Call struct {
 	*mock.Call
 }
 
-// ExtractSkills is a helper method to define mock.On call
+// Match is a helper method to define mock.On call
 //   - text string
-//   - whiteList map[string]int
-//   - maxNgram int
-func (_e *MockExtractor_Expecter) ExtractSkills(text interface{}, whiteList interface{}, maxNgram interface{}) *MockExtractor_ExtractSkills_Call {
-	return &MockExtractor_ExtractSkills_Call{Call: _e.mock.On("ExtractSkills", text, whiteList, maxNgram)}
+//   - matcher *extractor.Matcher
+func (_e *MockExtractor_Expecter) Match(text interface{}, matcher interface{}) *MockExtractor_Match_Call {
+	return &MockExtractor_Match_Call{Call: _e.mock.On("Match", text, matcher)}
 }
 
-func (_c *MockExtractor_ExtractSkills_Call) Run(run func(text string, whiteList map[string]int, maxNgram int)) *MockExtractor_ExtractSkills_Call {
+func (_c *MockExtractor_Match_Call) Run(run func(text string, matcher *extractor.Matcher)) *MockExtractor_Match_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
-		var arg1 map[string]int
+		var arg1 *extractor.Matcher
 		if args[1] != nil {
-			arg1 = args[1].(map[string]int)
-		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg1 = args[1].(*extractor.Matcher)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockExtractor_ExtractSkills_Call) Return(stringToInt map[string]int, err error) *MockExtractor_ExtractSkills_Call {
+func (_c *MockExtractor_Match_Call) Return(stringToInt map[string]int, err error) *MockExtractor_Match_Call {
 	_c.Call.Return(stringToInt, err)
 	return _c
 }
 
-func (_c *MockExtractor_ExtractSkills_Call) RunAndReturn(run func(text string, whiteList map[string]int, maxNgram int) (map[string]int, error)) *MockExtractor_ExtractSkills_Call {
+func (_c *MockExtractor_Match_Call) RunAndReturn(run func(text string, matcher *extractor.Matcher) (map[string]int, error)) *MockExtractor_Match_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMatcher provides a mock function for the type MockExtractor
+func (_mock *MockExtractor) NewMatcher(whiteList map[string]int) (*extractor.Matcher, error) {
+	ret := _mock.Called(whiteList)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewMatcher")
+	}
+
+	var r0 *extractor.Matcher
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(map[string]int) (*extractor.Matcher, error)); ok {
+		return returnFunc(whiteList)
+	}
+	if returnFunc, ok := ret.Get(0).(func(map[string]int) *extractor.Matcher); ok {
+		r0 = returnFunc(whiteList)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*extractor.Matcher)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(map[string]int) error); ok {
+		r1 = returnFunc(whiteList)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExtractor_NewMatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewMatcher'
+type MockExtractor_NewMatcher_Call struct {
+	*mock.Call
+}
+
+// NewMatcher is a helper method to define mock.On call
+//   - whiteList map[string]int
+func (_e *MockExtractor_Expecter) NewMatcher(whiteList interface{}) *MockExtractor_NewMatcher_Call {
+	return &MockExtractor_NewMatcher_Call{Call: _e.mock.On("NewMatcher", whiteList)}
+}
+
+func (_c *MockExtractor_NewMatcher_Call) Run(run func(whiteList map[string]int)) *MockExtractor_NewMatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 map[string]int
+		if args[0] != nil {
+			arg0 = args[0].(map[string]int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExtractor_NewMatcher_Call) Return(matcher *extractor.Matcher, err error) *MockExtractor_NewMatcher_Call {
+	_c.Call.Return(matcher, err)
+	return _c
+}
+
+func (_c *MockExtractor_NewMatcher_Call) RunAndReturn(run func(whiteList map[string]int) (*extractor.Matcher, error)) *MockExtractor_NewMatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Version provides a mock function for the type MockExtractor
+func (_mock *MockExtractor) Version() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockExtractor_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type MockExtractor_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+func (_e *MockExtractor_Expecter) Version() *MockExtractor_Version_Call {
+	return &MockExtractor_Version_Call{Call: _e.mock.On("Version")}
+}
+
+func (_c *MockExtractor_Version_Call) Run(run func()) *MockExtractor_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockExtractor_Version_Call) Return(s string) *MockExtractor_Version_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockExtractor_Version_Call) RunAndReturn(run func() string) *MockExtractor_Version_Call {
 	_c.Call.Return(run)
 	return _c
 }
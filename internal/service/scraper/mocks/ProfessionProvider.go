@@ -8,6 +8,7 @@ import (
 	"context"
 	"psa/internal/domain"
 
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -99,3 +100,69 @@ func (_c *MockProfessionProvider_GetActiveProfessions_Call) RunAndReturn(run fun
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetProfessionByID provides a mock function for the type MockProfessionProvider
+func (_mock *MockProfessionProvider) GetProfessionByID(ctx context.Context, id uuid.UUID) (domain.Profession, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfessionByID")
+	}
+
+	var r0 domain.Profession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.Profession, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.Profession); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Profession)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfessionProvider_GetProfessionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfessionByID'
+type MockProfessionProvider_GetProfessionByID_Call struct {
+	*mock.Call
+}
+
+// GetProfessionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockProfessionProvider_Expecter) GetProfessionByID(ctx interface{}, id interface{}) *MockProfessionProvider_GetProfessionByID_Call {
+	return &MockProfessionProvider_GetProfessionByID_Call{Call: _e.mock.On("GetProfessionByID", ctx, id)}
+}
+
+func (_c *MockProfessionProvider_GetProfessionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockProfessionProvider_GetProfessionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProfessionProvider_GetProfessionByID_Call) Return(profession domain.Profession, err error) *MockProfessionProvider_GetProfessionByID_Call {
+	_c.Call.Return(profession, err)
+	return _c
+}
+
+func (_c *MockProfessionProvider_GetProfessionByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (domain.Profession, error)) *MockProfessionProvider_GetProfessionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
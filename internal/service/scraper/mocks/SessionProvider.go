@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"psa/internal/domain"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -38,6 +39,75 @@ func (_m *MockSessionProvider) EXPECT() *MockSessionProvider_Expecter {
 	return &MockSessionProvider_Expecter{mock: &_m.Mock}
 }
 
+// ClearProfessionSession provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) ClearProfessionSession(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string) error {
+	ret := _mock.Called(ctx, sessionID, professionID, area)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearProfessionSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, sessionID, professionID, area)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionProvider_ClearProfessionSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearProfessionSession'
+type MockSessionProvider_ClearProfessionSession_Call struct {
+	*mock.Call
+}
+
+// ClearProfessionSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+//   - professionID uuid.UUID
+//   - area string
+func (_e *MockSessionProvider_Expecter) ClearProfessionSession(ctx interface{}, sessionID interface{}, professionID interface{}, area interface{}) *MockSessionProvider_ClearProfessionSession_Call {
+	return &MockSessionProvider_ClearProfessionSession_Call{Call: _e.mock.On("ClearProfessionSession", ctx, sessionID, professionID, area)}
+}
+
+func (_c *MockSessionProvider_ClearProfessionSession_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string)) *MockSessionProvider_ClearProfessionSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_ClearProfessionSession_Call) Return(err error) *MockSessionProvider_ClearProfessionSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionProvider_ClearProfessionSession_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string) error) *MockSessionProvider_ClearProfessionSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateScrapingSession provides a mock function for the type MockSessionProvider
 func (_mock *MockSessionProvider) CreateScrapingSession(ctx context.Context) (uuid.UUID, error) {
 	ret := _mock.Called(ctx)
@@ -99,3 +169,317 @@ func (_c *MockSessionProvider_CreateScrapingSession_Call) RunAndReturn(run func(
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetLatestScraping provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) GetLatestScraping(ctx context.Context) (domain.Scraping, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestScraping")
+	}
+
+	var r0 domain.Scraping
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.Scraping, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.Scraping); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.Scraping)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionProvider_GetLatestScraping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestScraping'
+type MockSessionProvider_GetLatestScraping_Call struct {
+	*mock.Call
+}
+
+// GetLatestScraping is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSessionProvider_Expecter) GetLatestScraping(ctx interface{}) *MockSessionProvider_GetLatestScraping_Call {
+	return &MockSessionProvider_GetLatestScraping_Call{Call: _e.mock.On("GetLatestScraping", ctx)}
+}
+
+func (_c *MockSessionProvider_GetLatestScraping_Call) Run(run func(ctx context.Context)) *MockSessionProvider_GetLatestScraping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_GetLatestScraping_Call) Return(scraping domain.Scraping, err error) *MockSessionProvider_GetLatestScraping_Call {
+	_c.Call.Return(scraping, err)
+	return _c
+}
+
+func (_c *MockSessionProvider_GetLatestScraping_Call) RunAndReturn(run func(ctx context.Context) (domain.Scraping, error)) *MockSessionProvider_GetLatestScraping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScrapingByID provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) GetScrapingByID(ctx context.Context, id uuid.UUID) (domain.Scraping, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScrapingByID")
+	}
+
+	var r0 domain.Scraping
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.Scraping, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.Scraping); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Scraping)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionProvider_GetScrapingByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScrapingByID'
+type MockSessionProvider_GetScrapingByID_Call struct {
+	*mock.Call
+}
+
+// GetScrapingByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockSessionProvider_Expecter) GetScrapingByID(ctx interface{}, id interface{}) *MockSessionProvider_GetScrapingByID_Call {
+	return &MockSessionProvider_GetScrapingByID_Call{Call: _e.mock.On("GetScrapingByID", ctx, id)}
+}
+
+func (_c *MockSessionProvider_GetScrapingByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockSessionProvider_GetScrapingByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_GetScrapingByID_Call) Return(scraping domain.Scraping, err error) *MockSessionProvider_GetScrapingByID_Call {
+	_c.Call.Return(scraping, err)
+	return _c
+}
+
+func (_c *MockSessionProvider_GetScrapingByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (domain.Scraping, error)) *MockSessionProvider_GetScrapingByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSucceededProfessionAreas provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) GetSucceededProfessionAreas(ctx context.Context, sessionID uuid.UUID) ([]domain.ProfessionArea, error) {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSucceededProfessionAreas")
+	}
+
+	var r0 []domain.ProfessionArea
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]domain.ProfessionArea, error)); ok {
+		return returnFunc(ctx, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []domain.ProfessionArea); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProfessionArea)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionProvider_GetSucceededProfessionAreas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSucceededProfessionAreas'
+type MockSessionProvider_GetSucceededProfessionAreas_Call struct {
+	*mock.Call
+}
+
+// GetSucceededProfessionAreas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *MockSessionProvider_Expecter) GetSucceededProfessionAreas(ctx interface{}, sessionID interface{}) *MockSessionProvider_GetSucceededProfessionAreas_Call {
+	return &MockSessionProvider_GetSucceededProfessionAreas_Call{Call: _e.mock.On("GetSucceededProfessionAreas", ctx, sessionID)}
+}
+
+func (_c *MockSessionProvider_GetSucceededProfessionAreas_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *MockSessionProvider_GetSucceededProfessionAreas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_GetSucceededProfessionAreas_Call) Return(professionAreas []domain.ProfessionArea, err error) *MockSessionProvider_GetSucceededProfessionAreas_Call {
+	_c.Call.Return(professionAreas, err)
+	return _c
+}
+
+func (_c *MockSessionProvider_GetSucceededProfessionAreas_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID) ([]domain.ProfessionArea, error)) *MockSessionProvider_GetSucceededProfessionAreas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InTx provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionProvider_InTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InTx'
+type MockSessionProvider_InTx_Call struct {
+	*mock.Call
+}
+
+// InTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(ctx context.Context) error
+func (_e *MockSessionProvider_Expecter) InTx(ctx interface{}, fn interface{}) *MockSessionProvider_InTx_Call {
+	return &MockSessionProvider_InTx_Call{Call: _e.mock.On("InTx", ctx, fn)}
+}
+
+func (_c *MockSessionProvider_InTx_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *MockSessionProvider_InTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(ctx context.Context) error
+		if args[1] != nil {
+			arg1 = args[1].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_InTx_Call) Return(err error) *MockSessionProvider_InTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionProvider_InTx_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error) error) *MockSessionProvider_InTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetScrapingStatus provides a mock function for the type MockSessionProvider
+func (_mock *MockSessionProvider) SetScrapingStatus(ctx context.Context, id uuid.UUID, status string) error {
+	ret := _mock.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetScrapingStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionProvider_SetScrapingStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetScrapingStatus'
+type MockSessionProvider_SetScrapingStatus_Call struct {
+	*mock.Call
+}
+
+// SetScrapingStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - status string
+func (_e *MockSessionProvider_Expecter) SetScrapingStatus(ctx interface{}, id interface{}, status interface{}) *MockSessionProvider_SetScrapingStatus_Call {
+	return &MockSessionProvider_SetScrapingStatus_Call{Call: _e.mock.On("SetScrapingStatus", ctx, id, status)}
+}
+
+func (_c *MockSessionProvider_SetScrapingStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, status string)) *MockSessionProvider_SetScrapingStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionProvider_SetScrapingStatus_Call) Return(err error) *MockSessionProvider_SetScrapingStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionProvider_SetScrapingStatus_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, status string) error) *MockSessionProvider_SetScrapingStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
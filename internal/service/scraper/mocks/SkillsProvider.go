@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"psa/internal/domain"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -38,17 +39,116 @@ func (_m *MockSkillsProvider) EXPECT() *MockSkillsProvider_Expecter {
 	return &MockSkillsProvider_Expecter{mock: &_m.Mock}
 }
 
+// ReplaceSkills provides a mock function for the type MockSkillsProvider
+func (_mock *MockSkillsProvider) ReplaceSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, formalSkills map[string]int, formalPercentages map[string]float64, extractedSkills map[string]int, extractedPercentages map[string]float64) error {
+	ret := _mock.Called(ctx, sessionID, professionID, area, extractorVersion, formalSkills, formalPercentages, extractedSkills, extractedPercentages)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceSkills")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, map[string]int, map[string]float64, map[string]int, map[string]float64) error); ok {
+		r0 = returnFunc(ctx, sessionID, professionID, area, extractorVersion, formalSkills, formalPercentages, extractedSkills, extractedPercentages)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSkillsProvider_ReplaceSkills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceSkills'
+type MockSkillsProvider_ReplaceSkills_Call struct {
+	*mock.Call
+}
+
+// ReplaceSkills is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+//   - professionID uuid.UUID
+//   - area string
+//   - extractorVersion string
+//   - formalSkills map[string]int
+//   - formalPercentages map[string]float64
+//   - extractedSkills map[string]int
+//   - extractedPercentages map[string]float64
+func (_e *MockSkillsProvider_Expecter) ReplaceSkills(ctx interface{}, sessionID interface{}, professionID interface{}, area interface{}, extractorVersion interface{}, formalSkills interface{}, formalPercentages interface{}, extractedSkills interface{}, extractedPercentages interface{}) *MockSkillsProvider_ReplaceSkills_Call {
+	return &MockSkillsProvider_ReplaceSkills_Call{Call: _e.mock.On("ReplaceSkills", ctx, sessionID, professionID, area, extractorVersion, formalSkills, formalPercentages, extractedSkills, extractedPercentages)}
+}
+
+func (_c *MockSkillsProvider_ReplaceSkills_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, formalSkills map[string]int, formalPercentages map[string]float64, extractedSkills map[string]int, extractedPercentages map[string]float64)) *MockSkillsProvider_ReplaceSkills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 map[string]int
+		if args[5] != nil {
+			arg5 = args[5].(map[string]int)
+		}
+		var arg6 map[string]float64
+		if args[6] != nil {
+			arg6 = args[6].(map[string]float64)
+		}
+		var arg7 map[string]int
+		if args[7] != nil {
+			arg7 = args[7].(map[string]int)
+		}
+		var arg8 map[string]float64
+		if args[8] != nil {
+			arg8 = args[8].(map[string]float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+			arg7,
+			arg8,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillsProvider_ReplaceSkills_Call) Return(err error) *MockSkillsProvider_ReplaceSkills_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSkillsProvider_ReplaceSkills_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, formalSkills map[string]int, formalPercentages map[string]float64, extractedSkills map[string]int, extractedPercentages map[string]float64) error) *MockSkillsProvider_ReplaceSkills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveExtractedSkills provides a mock function for the type MockSkillsProvider
-func (_mock *MockSkillsProvider) SaveExtractedSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int) error {
-	ret := _mock.Called(ctx, sessionID, professionID, skills)
+func (_mock *MockSkillsProvider) SaveExtractedSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, skills map[string]int, percentages map[string]float64) error {
+	ret := _mock.Called(ctx, sessionID, professionID, area, extractorVersion, skills, percentages)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveExtractedSkills")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, map[string]int) error); ok {
-		r0 = returnFunc(ctx, sessionID, professionID, skills)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, map[string]int, map[string]float64) error); ok {
+		r0 = returnFunc(ctx, sessionID, professionID, area, extractorVersion, skills, percentages)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -64,12 +164,15 @@ type MockSkillsProvider_SaveExtractedSkills_Call struct {
 //   - ctx context.Context
 //   - sessionID uuid.UUID
 //   - professionID uuid.UUID
+//   - area string
+//   - extractorVersion string
 //   - skills map[string]int
-func (_e *MockSkillsProvider_Expecter) SaveExtractedSkills(ctx interface{}, sessionID interface{}, professionID interface{}, skills interface{}) *MockSkillsProvider_SaveExtractedSkills_Call {
-	return &MockSkillsProvider_SaveExtractedSkills_Call{Call: _e.mock.On("SaveExtractedSkills", ctx, sessionID, professionID, skills)}
+//   - percentages map[string]float64
+func (_e *MockSkillsProvider_Expecter) SaveExtractedSkills(ctx interface{}, sessionID interface{}, professionID interface{}, area interface{}, extractorVersion interface{}, skills interface{}, percentages interface{}) *MockSkillsProvider_SaveExtractedSkills_Call {
+	return &MockSkillsProvider_SaveExtractedSkills_Call{Call: _e.mock.On("SaveExtractedSkills", ctx, sessionID, professionID, area, extractorVersion, skills, percentages)}
 }
 
-func (_c *MockSkillsProvider_SaveExtractedSkills_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int)) *MockSkillsProvider_SaveExtractedSkills_Call {
+func (_c *MockSkillsProvider_SaveExtractedSkills_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, skills map[string]int, percentages map[string]float64)) *MockSkillsProvider_SaveExtractedSkills_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -83,15 +186,30 @@ func (_c *MockSkillsProvider_SaveExtractedSkills_Call) Run(run func(ctx context.
 		if args[2] != nil {
 			arg2 = args[2].(uuid.UUID)
 		}
-		var arg3 map[string]int
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(map[string]int)
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 map[string]int
+		if args[5] != nil {
+			arg5 = args[5].(map[string]int)
+		}
+		var arg6 map[string]float64
+		if args[6] != nil {
+			arg6 = args[6].(map[string]float64)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -102,22 +220,22 @@ func (_c *MockSkillsProvider_SaveExtractedSkills_Call) Return(err error) *MockSk
 	return _c
 }
 
-func (_c *MockSkillsProvider_SaveExtractedSkills_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int) error) *MockSkillsProvider_SaveExtractedSkills_Call {
+func (_c *MockSkillsProvider_SaveExtractedSkills_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, skills map[string]int, percentages map[string]float64) error) *MockSkillsProvider_SaveExtractedSkills_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // SaveFormalSkills provides a mock function for the type MockSkillsProvider
-func (_mock *MockSkillsProvider) SaveFormalSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int) error {
-	ret := _mock.Called(ctx, sessionID, professionID, skills)
+func (_mock *MockSkillsProvider) SaveFormalSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, skills map[string]int, percentages map[string]float64) error {
+	ret := _mock.Called(ctx, sessionID, professionID, area, extractorVersion, skills, percentages)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveFormalSkills")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, map[string]int) error); ok {
-		r0 = returnFunc(ctx, sessionID, professionID, skills)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, string, map[string]int, map[string]float64) error); ok {
+		r0 = returnFunc(ctx, sessionID, professionID, area, extractorVersion, skills, percentages)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -133,12 +251,15 @@ type MockSkillsProvider_SaveFormalSkills_Call struct {
 //   - ctx context.Context
 //   - sessionID uuid.UUID
 //   - professionID uuid.UUID
+//   - area string
+//   - extractorVersion string
 //   - skills map[string]int
-func (_e *MockSkillsProvider_Expecter) SaveFormalSkills(ctx interface{}, sessionID interface{}, professionID interface{}, skills interface{}) *MockSkillsProvider_SaveFormalSkills_Call {
-	return &MockSkillsProvider_SaveFormalSkills_Call{Call: _e.mock.On("SaveFormalSkills", ctx, sessionID, professionID, skills)}
+//   - percentages map[string]float64
+func (_e *MockSkillsProvider_Expecter) SaveFormalSkills(ctx interface{}, sessionID interface{}, professionID interface{}, area interface{}, extractorVersion interface{}, skills interface{}, percentages interface{}) *MockSkillsProvider_SaveFormalSkills_Call {
+	return &MockSkillsProvider_SaveFormalSkills_Call{Call: _e.mock.On("SaveFormalSkills", ctx, sessionID, professionID, area, extractorVersion, skills, percentages)}
 }
 
-func (_c *MockSkillsProvider_SaveFormalSkills_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int)) *MockSkillsProvider_SaveFormalSkills_Call {
+func (_c *MockSkillsProvider_SaveFormalSkills_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, skills map[string]int, percentages map[string]float64)) *MockSkillsProvider_SaveFormalSkills_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -152,15 +273,30 @@ func (_c *MockSkillsProvider_SaveFormalSkills_Call) Run(run func(ctx context.Con
 		if args[2] != nil {
 			arg2 = args[2].(uuid.UUID)
 		}
-		var arg3 map[string]int
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(map[string]int)
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 map[string]int
+		if args[5] != nil {
+			arg5 = args[5].(map[string]int)
+		}
+		var arg6 map[string]float64
+		if args[6] != nil {
+			arg6 = args[6].(map[string]float64)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -171,7 +307,82 @@ func (_c *MockSkillsProvider_SaveFormalSkills_Call) Return(err error) *MockSkill
 	return _c
 }
 
-func (_c *MockSkillsProvider_SaveFormalSkills_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int) error) *MockSkillsProvider_SaveFormalSkills_Call {
+func (_c *MockSkillsProvider_SaveFormalSkills_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, extractorVersion string, skills map[string]int, percentages map[string]float64) error) *MockSkillsProvider_SaveFormalSkills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSkillSalaries provides a mock function for the type MockSkillsProvider
+func (_mock *MockSkillsProvider) SaveSkillSalaries(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, skills []domain.SkillSalary) error {
+	ret := _mock.Called(ctx, sessionID, professionID, area, skills)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSkillSalaries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, []domain.SkillSalary) error); ok {
+		r0 = returnFunc(ctx, sessionID, professionID, area, skills)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSkillsProvider_SaveSkillSalaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSkillSalaries'
+type MockSkillsProvider_SaveSkillSalaries_Call struct {
+	*mock.Call
+}
+
+// SaveSkillSalaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+//   - professionID uuid.UUID
+//   - area string
+//   - skills []domain.SkillSalary
+func (_e *MockSkillsProvider_Expecter) SaveSkillSalaries(ctx interface{}, sessionID interface{}, professionID interface{}, area interface{}, skills interface{}) *MockSkillsProvider_SaveSkillSalaries_Call {
+	return &MockSkillsProvider_SaveSkillSalaries_Call{Call: _e.mock.On("SaveSkillSalaries", ctx, sessionID, professionID, area, skills)}
+}
+
+func (_c *MockSkillsProvider_SaveSkillSalaries_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, skills []domain.SkillSalary)) *MockSkillsProvider_SaveSkillSalaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []domain.SkillSalary
+		if args[4] != nil {
+			arg4 = args[4].([]domain.SkillSalary)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillsProvider_SaveSkillSalaries_Call) Return(err error) *MockSkillsProvider_SaveSkillSalaries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSkillsProvider_SaveSkillSalaries_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, skills []domain.SkillSalary) error) *MockSkillsProvider_SaveSkillSalaries_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"psa/internal/domain"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -38,17 +39,155 @@ func (_m *MockStatProvider) EXPECT() *MockStatProvider_Expecter {
 	return &MockStatProvider_Expecter{mock: &_m.Mock}
 }
 
+// SaveProfessionRun provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) SaveProfessionRun(ctx context.Context, runID uuid.UUID, run domain.ProfessionRun) error {
+	ret := _mock.Called(ctx, runID, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProfessionRun")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.ProfessionRun) error); ok {
+		r0 = returnFunc(ctx, runID, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatProvider_SaveProfessionRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProfessionRun'
+type MockStatProvider_SaveProfessionRun_Call struct {
+	*mock.Call
+}
+
+// SaveProfessionRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID uuid.UUID
+//   - run domain.ProfessionRun
+func (_e *MockStatProvider_Expecter) SaveProfessionRun(ctx interface{}, runID interface{}, run interface{}) *MockStatProvider_SaveProfessionRun_Call {
+	return &MockStatProvider_SaveProfessionRun_Call{Call: _e.mock.On("SaveProfessionRun", ctx, runID, run)}
+}
+
+func (_c *MockStatProvider_SaveProfessionRun_Call) Run(run func(ctx context.Context, runID uuid.UUID, run domain.ProfessionRun)) *MockStatProvider_SaveProfessionRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 domain.ProfessionRun
+		if args[2] != nil {
+			arg2 = args[2].(domain.ProfessionRun)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatProvider_SaveProfessionRun_Call) Return(err error) *MockStatProvider_SaveProfessionRun_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatProvider_SaveProfessionRun_Call) RunAndReturn(run func(ctx context.Context, runID uuid.UUID, run domain.ProfessionRun) error) *MockStatProvider_SaveProfessionRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSalaryStat provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) SaveSalaryStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, stat domain.SalaryStat) error {
+	ret := _mock.Called(ctx, sessionID, professionID, area, stat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSalaryStat")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, domain.SalaryStat) error); ok {
+		r0 = returnFunc(ctx, sessionID, professionID, area, stat)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatProvider_SaveSalaryStat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSalaryStat'
+type MockStatProvider_SaveSalaryStat_Call struct {
+	*mock.Call
+}
+
+// SaveSalaryStat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+//   - professionID uuid.UUID
+//   - area string
+//   - stat domain.SalaryStat
+func (_e *MockStatProvider_Expecter) SaveSalaryStat(ctx interface{}, sessionID interface{}, professionID interface{}, area interface{}, stat interface{}) *MockStatProvider_SaveSalaryStat_Call {
+	return &MockStatProvider_SaveSalaryStat_Call{Call: _e.mock.On("SaveSalaryStat", ctx, sessionID, professionID, area, stat)}
+}
+
+func (_c *MockStatProvider_SaveSalaryStat_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, stat domain.SalaryStat)) *MockStatProvider_SaveSalaryStat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 domain.SalaryStat
+		if args[4] != nil {
+			arg4 = args[4].(domain.SalaryStat)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatProvider_SaveSalaryStat_Call) Return(err error) *MockStatProvider_SaveSalaryStat_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatProvider_SaveSalaryStat_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, stat domain.SalaryStat) error) *MockStatProvider_SaveSalaryStat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveStat provides a mock function for the type MockStatProvider
-func (_mock *MockStatProvider) SaveStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, vacancyCount int) error {
-	ret := _mock.Called(ctx, sessionID, professionID, vacancyCount)
+func (_mock *MockStatProvider) SaveStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, vacancyCount int) error {
+	ret := _mock.Called(ctx, sessionID, professionID, area, vacancyCount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveStat")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) error); ok {
-		r0 = returnFunc(ctx, sessionID, professionID, vacancyCount)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, int) error); ok {
+		r0 = returnFunc(ctx, sessionID, professionID, area, vacancyCount)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -64,12 +203,13 @@ type MockStatProvider_SaveStat_Call struct {
 //   - ctx context.Context
 //   - sessionID uuid.UUID
 //   - professionID uuid.UUID
+//   - area string
 //   - vacancyCount int
-func (_e *MockStatProvider_Expecter) SaveStat(ctx interface{}, sessionID interface{}, professionID interface{}, vacancyCount interface{}) *MockStatProvider_SaveStat_Call {
-	return &MockStatProvider_SaveStat_Call{Call: _e.mock.On("SaveStat", ctx, sessionID, professionID, vacancyCount)}
+func (_e *MockStatProvider_Expecter) SaveStat(ctx interface{}, sessionID interface{}, professionID interface{}, area interface{}, vacancyCount interface{}) *MockStatProvider_SaveStat_Call {
+	return &MockStatProvider_SaveStat_Call{Call: _e.mock.On("SaveStat", ctx, sessionID, professionID, area, vacancyCount)}
 }
 
-func (_c *MockStatProvider_SaveStat_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, vacancyCount int)) *MockStatProvider_SaveStat_Call {
+func (_c *MockStatProvider_SaveStat_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, vacancyCount int)) *MockStatProvider_SaveStat_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -83,15 +223,20 @@ func (_c *MockStatProvider_SaveStat_Call) Run(run func(ctx context.Context, sess
 		if args[2] != nil {
 			arg2 = args[2].(uuid.UUID)
 		}
-		var arg3 int
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(int)
+			arg3 = args[3].(string)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -102,7 +247,82 @@ func (_c *MockStatProvider_SaveStat_Call) Return(err error) *MockStatProvider_Sa
 	return _c
 }
 
-func (_c *MockStatProvider_SaveStat_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, vacancyCount int) error) *MockStatProvider_SaveStat_Call {
+func (_c *MockStatProvider_SaveStat_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, vacancyCount int) error) *MockStatProvider_SaveStat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveVacancyBreakdown provides a mock function for the type MockStatProvider
+func (_mock *MockStatProvider) SaveVacancyBreakdown(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, breakdown domain.VacancyBreakdown) error {
+	ret := _mock.Called(ctx, sessionID, professionID, area, breakdown)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveVacancyBreakdown")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, domain.VacancyBreakdown) error); ok {
+		r0 = returnFunc(ctx, sessionID, professionID, area, breakdown)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatProvider_SaveVacancyBreakdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveVacancyBreakdown'
+type MockStatProvider_SaveVacancyBreakdown_Call struct {
+	*mock.Call
+}
+
+// SaveVacancyBreakdown is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+//   - professionID uuid.UUID
+//   - area string
+//   - breakdown domain.VacancyBreakdown
+func (_e *MockStatProvider_Expecter) SaveVacancyBreakdown(ctx interface{}, sessionID interface{}, professionID interface{}, area interface{}, breakdown interface{}) *MockStatProvider_SaveVacancyBreakdown_Call {
+	return &MockStatProvider_SaveVacancyBreakdown_Call{Call: _e.mock.On("SaveVacancyBreakdown", ctx, sessionID, professionID, area, breakdown)}
+}
+
+func (_c *MockStatProvider_SaveVacancyBreakdown_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, breakdown domain.VacancyBreakdown)) *MockStatProvider_SaveVacancyBreakdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 domain.VacancyBreakdown
+		if args[4] != nil {
+			arg4 = args[4].(domain.VacancyBreakdown)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatProvider_SaveVacancyBreakdown_Call) Return(err error) *MockStatProvider_SaveVacancyBreakdown_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatProvider_SaveVacancyBreakdown_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, breakdown domain.VacancyBreakdown) error) *MockStatProvider_SaveVacancyBreakdown_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -39,7 +39,7 @@ func (_m *MockSupplierPort) EXPECT() *MockSupplierPort_Expecter {
 }
 
 // FetchDataProfession provides a mock function for the type MockSupplierPort
-func (_mock *MockSupplierPort) FetchDataProfession(ctx context.Context, query string, area string) ([]domain.VacancyData, int, error) {
+func (_mock *MockSupplierPort) FetchDataProfession(ctx context.Context, query string, area string) ([]domain.VacancyData, domain.FetchStats, error) {
 	ret := _mock.Called(ctx, query, area)
 
 	if len(ret) == 0 {
@@ -47,9 +47,9 @@ func (_mock *MockSupplierPort) FetchDataProfession(ctx context.Context, query st
 	}
 
 	var r0 []domain.VacancyData
-	var r1 int
+	var r1 domain.FetchStats
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.VacancyData, int, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.VacancyData, domain.FetchStats, error)); ok {
 		return returnFunc(ctx, query, area)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []domain.VacancyData); ok {
@@ -59,10 +59,10 @@ func (_mock *MockSupplierPort) FetchDataProfession(ctx context.Context, query st
 			r0 = ret.Get(0).([]domain.VacancyData)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) int); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) domain.FetchStats); ok {
 		r1 = returnFunc(ctx, query, area)
 	} else {
-		r1 = ret.Get(1).(int)
+		r1 = ret.Get(1).(domain.FetchStats)
 	}
 	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
 		r2 = returnFunc(ctx, query, area)
@@ -108,12 +108,56 @@ func (_c *MockSupplierPort_FetchDataProfession_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *MockSupplierPort_FetchDataProfession_Call) Return(vacancyDatas []domain.VacancyData, n int, err error) *MockSupplierPort_FetchDataProfession_Call {
-	_c.Call.Return(vacancyDatas, n, err)
+func (_c *MockSupplierPort_FetchDataProfession_Call) Return(vacancyDatas []domain.VacancyData, fetchStats domain.FetchStats, err error) *MockSupplierPort_FetchDataProfession_Call {
+	_c.Call.Return(vacancyDatas, fetchStats, err)
 	return _c
 }
 
-func (_c *MockSupplierPort_FetchDataProfession_Call) RunAndReturn(run func(ctx context.Context, query string, area string) ([]domain.VacancyData, int, error)) *MockSupplierPort_FetchDataProfession_Call {
+func (_c *MockSupplierPort_FetchDataProfession_Call) RunAndReturn(run func(ctx context.Context, query string, area string) ([]domain.VacancyData, domain.FetchStats, error)) *MockSupplierPort_FetchDataProfession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function for the type MockSupplierPort
+func (_mock *MockSupplierPort) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockSupplierPort_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockSupplierPort_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockSupplierPort_Expecter) Name() *MockSupplierPort_Name_Call {
+	return &MockSupplierPort_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockSupplierPort_Name_Call) Run(run func()) *MockSupplierPort_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSupplierPort_Name_Call) Return(s string) *MockSupplierPort_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockSupplierPort_Name_Call) RunAndReturn(run func() string) *MockSupplierPort_Name_Call {
 	_c.Call.Return(run)
 	return _c
 }
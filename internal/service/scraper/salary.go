@@ -0,0 +1,113 @@
+package scraper
+
+import (
+	"math"
+	"sort"
+
+	"psa/internal/domain"
+)
+
+// minSkillSalarySample is the minimum number of salaried vacancies on each side of a skill comparison.
+const minSkillSalarySample = 3
+
+// salaryStat returns quartiles of vacancy salaries in roubles.
+// Vacancies without a rouble estimate are skipped; ok is false if no vacancy has one.
+func salaryStat(data []domain.VacancyData) (stat domain.SalaryStat, ok bool) {
+	salaries := salariesRUB(data)
+	if len(salaries) == 0 {
+		return domain.SalaryStat{}, false
+	}
+
+	return domain.SalaryStat{
+		SampleSize: int32(len(salaries)),
+		P25:        int32(percentile(salaries, 0.25)),
+		Median:     int32(percentile(salaries, 0.5)),
+		P75:        int32(percentile(salaries, 0.75)),
+	}, true
+}
+
+// skillSalaries compares median salaries of vacancies with and without each of the skills.
+// A skill is reported only if both groups have at least minSkillSalarySample vacancies with a rouble estimate.
+func skillSalaries(data []domain.VacancyData, skills map[string]int) []domain.SkillSalary {
+	type salaried struct {
+		rub    float64
+		skills map[string]struct{}
+	}
+
+	vacancies := make([]salaried, 0, len(data))
+	for _, d := range data {
+		if d.Salary == nil || d.Salary.RUB <= 0 {
+			continue
+		}
+
+		set := make(map[string]struct{}, len(d.Skills))
+		for _, skill := range d.Skills {
+			set[skill] = struct{}{}
+		}
+		vacancies = append(vacancies, salaried{rub: float64(d.Salary.RUB), skills: set})
+	}
+
+	result := make([]domain.SkillSalary, 0)
+	for skill := range skills {
+		with := make([]float64, 0)
+		without := make([]float64, 0)
+		for _, v := range vacancies {
+			if _, ok := v.skills[skill]; ok {
+				with = append(with, v.rub)
+			} else {
+				without = append(without, v.rub)
+			}
+		}
+
+		if len(with) < minSkillSalarySample || len(without) < minSkillSalarySample {
+			continue
+		}
+
+		sort.Float64s(with)
+		sort.Float64s(without)
+
+		result = append(result, domain.SkillSalary{
+			Skill:         skill,
+			WithCount:     int32(len(with)),
+			WithMedian:    int32(percentile(with, 0.5)),
+			WithoutCount:  int32(len(without)),
+			WithoutMedian: int32(percentile(without, 0.5)),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Skill < result[j].Skill
+	})
+
+	return result
+}
+
+// salariesRUB returns sorted rouble estimates of vacancy salaries.
+func salariesRUB(data []domain.VacancyData) []float64 {
+	salaries := make([]float64, 0, len(data))
+	for _, d := range data {
+		if d.Salary == nil || d.Salary.RUB <= 0 {
+			continue
+		}
+		salaries = append(salaries, float64(d.Salary.RUB))
+	}
+
+	sort.Float64s(salaries)
+
+	return salaries
+}
+
+// percentile returns the p-th percentile of sorted values with linear interpolation between closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := p * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	value := sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+
+	return math.Round(value)
+}
@@ -0,0 +1,99 @@
+package scraper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"psa/internal/domain"
+)
+
+func withSalary(rub int) domain.VacancyData {
+	return domain.VacancyData{Salary: &domain.Salary{Currency: domain.CurrencyRUB, From: rub, RUB: rub}}
+}
+
+func TestSalaryStat(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []domain.VacancyData
+		expected domain.SalaryStat
+		ok       bool
+	}{
+		{
+			name: "no salaries",
+			data: []domain.VacancyData{{}, {Salary: &domain.Salary{From: 1000, Currency: "GEL"}}},
+			ok:   false,
+		},
+		{
+			name:     "single salary",
+			data:     []domain.VacancyData{withSalary(100000)},
+			expected: domain.SalaryStat{SampleSize: 1, P25: 100000, Median: 100000, P75: 100000},
+			ok:       true,
+		},
+		{
+			name: "odd sample, unsorted, vacancies without salary skipped",
+			data: []domain.VacancyData{
+				withSalary(300000), {}, withSalary(100000), withSalary(200000),
+				withSalary(500000), withSalary(400000),
+			},
+			expected: domain.SalaryStat{SampleSize: 5, P25: 200000, Median: 300000, P75: 400000},
+			ok:       true,
+		},
+		{
+			name: "even sample is interpolated",
+			data: []domain.VacancyData{
+				withSalary(100000), withSalary(200000), withSalary(300000), withSalary(400000),
+			},
+			expected: domain.SalaryStat{SampleSize: 4, P25: 175000, Median: 250000, P75: 325000},
+			ok:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stat, ok := salaryStat(tt.data)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, stat)
+		})
+	}
+}
+
+func TestSkillSalaries(t *testing.T) {
+	vacancy := func(rub int, skills ...string) domain.VacancyData {
+		v := withSalary(rub)
+		v.Skills = skills
+		return v
+	}
+
+	data := []domain.VacancyData{
+		vacancy(300000, "go", "kubernetes"),
+		vacancy(320000, "go", "kubernetes"),
+		vacancy(340000, "go", "kubernetes", "sql"),
+		vacancy(200000, "go", "sql"),
+		vacancy(180000, "go", "sql"),
+		vacancy(220000, "go"),
+		{Skills: []string{"go", "kubernetes"}}, // без зарплаты — не учитывается
+	}
+
+	skills := map[string]int{"go": 7, "kubernetes": 4, "sql": 3}
+
+	result := skillSalaries(data, skills)
+
+	// go есть во всех вакансиях с зарплатой — группа без навыка пуста, навык не попадает в отчёт
+	assert.Equal(t, []domain.SkillSalary{
+		{Skill: "kubernetes", WithCount: 3, WithMedian: 320000, WithoutCount: 3, WithoutMedian: 200000},
+		{Skill: "sql", WithCount: 3, WithMedian: 200000, WithoutCount: 3, WithoutMedian: 300000},
+	}, result)
+}
+
+func TestSkillSalaries_NotEnoughSalaries(t *testing.T) {
+	data := []domain.VacancyData{
+		{Skills: []string{"go"}, Salary: &domain.Salary{RUB: 100000}},
+		{Skills: []string{"java"}, Salary: &domain.Salary{RUB: 200000}},
+	}
+
+	result := skillSalaries(data, map[string]int{"go": 1, "java": 1})
+
+	assert.Empty(t, result)
+}
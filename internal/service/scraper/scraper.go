@@ -2,121 +2,192 @@ package scraper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
 	"psa/internal/domain"
+	"psa/internal/service/extractor"
 	"psa/pkg/logger/loggerctx"
 	"psa/pkg/logger/slogx"
 )
 
 const (
-	ngram = 3
-	area  = "113" // Russia
+	// jobMaxAttempts bounds the attempts of a queued profession job.
+	jobMaxAttempts = 3
 )
 
 type ProfessionProvider interface {
 	GetActiveProfessions(ctx context.Context) ([]domain.Profession, error)
+	GetProfessionByID(ctx context.Context, id uuid.UUID) (domain.Profession, error)
 }
 
 type SessionProvider interface {
 	CreateScrapingSession(ctx context.Context) (uuid.UUID, error)
+	GetScrapingByID(ctx context.Context, id uuid.UUID) (domain.Scraping, error)
+	GetLatestScraping(ctx context.Context) (domain.Scraping, error)
+	SetScrapingStatus(ctx context.Context, id uuid.UUID, status string) error
+	GetSucceededProfessionAreas(ctx context.Context, sessionID uuid.UUID) ([]domain.ProfessionArea, error)
+	ClearProfessionSession(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string) error
+	// InTx runs fn in a transaction the providers' saves called with the context fn gets are part of.
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type SkillsProvider interface {
-	SaveFormalSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int) error
-	SaveExtractedSkills(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, skills map[string]int) error
+	SaveFormalSkills(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		professionID uuid.UUID,
+		area, extractorVersion string,
+		skills map[string]int,
+		percentages map[string]float64,
+	) error
+	SaveExtractedSkills(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		professionID uuid.UUID,
+		area, extractorVersion string,
+		skills map[string]int,
+		percentages map[string]float64,
+	) error
+	ReplaceSkills(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		professionID uuid.UUID,
+		area string,
+		extractorVersion string,
+		formalSkills map[string]int,
+		formalPercentages map[string]float64,
+		extractedSkills map[string]int,
+		extractedPercentages map[string]float64,
+	) error
+	SaveSkillSalaries(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, skills []domain.SkillSalary) error
 }
 
 type StatProvider interface {
-	SaveStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, vacancyCount int) error
+	SaveStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, vacancyCount int) error
+	SaveSalaryStat(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, stat domain.SalaryStat) error
+	SaveVacancyBreakdown(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, breakdown domain.VacancyBreakdown) error
+	SaveProfessionRun(ctx context.Context, runID uuid.UUID, run domain.ProfessionRun) error
 }
 
 type DailyStatProvider interface {
-	SaveStatDaily(ctx context.Context, professionID uuid.UUID, vacancyCount int, scrapedAt time.Time) error
+	SaveStatDaily(ctx context.Context, professionID uuid.UUID, area string, vacancyCount int, scrapedAt time.Time) error
 }
 
+type VacancyProvider interface {
+	SaveVacancies(ctx context.Context, sessionID uuid.UUID, professionID uuid.UUID, area string, vacancies []domain.VacancyData) error
+	GetVacancyProfessionAreasBySession(ctx context.Context, sessionID uuid.UUID) ([]domain.ProfessionArea, error)
+	GetAllVacanciesByProfessionAndSession(ctx context.Context, professionID uuid.UUID, sessionID uuid.UUID, area string) ([]domain.VacancyData, error)
+}
+
+// SupplierPort is a vacancy source, e.g. a job board. Name tags every vacancy fetched from the source.
 type SupplierPort interface {
-	FetchDataProfession(ctx context.Context, query, area string) ([]domain.VacancyData, int, error)
+	Name() string
+	FetchDataProfession(ctx context.Context, query, area string) ([]domain.VacancyData, domain.FetchStats, error)
 }
 
+// Extractor finds white-listed skills in a text with a matcher built once per white list. CountsDocuments
+// reports whether a skill is counted once per text (document frequency) rather than per mention.
 type Extractor interface {
-	ExtractSkills(text string, whiteList map[string]int, maxNgram int) (map[string]int, error)
+	NewMatcher(whiteList map[string]int) (*extractor.Matcher, error)
+	Match(text string, matcher *extractor.Matcher) (map[string]int, error)
+	Version() string
+	CountsDocuments() bool
+}
+
+// SkillAliases maps variant spellings of skills to their canonical names.
+type SkillAliases interface {
+	Aliases(ctx context.Context) (map[string]string, error)
 }
 
 type CacheProvider interface {
 	SaveProfessionData(ctx context.Context, data *domain.ProfessionDetail) error
 }
 
+// JobQueue hands professions over to the scraping workers.
+type JobQueue interface {
+	EnqueueScrapingJobs(ctx context.Context, jobs []domain.ScrapingJob) error
+}
+
 type Scraper struct {
 	professionProvider ProfessionProvider
 	sessionProvider    SessionProvider
 	skillsProvider     SkillsProvider
 	statProvider       StatProvider
 	dailyStatProvider  DailyStatProvider
-	supplierPort       SupplierPort
+	vacancyProvider    VacancyProvider
+	suppliers          []SupplierPort
 	extractor          Extractor
+	aliases            SkillAliases
 	cache              CacheProvider
+	areas              []string
+	concurrency        int
+	jobs               JobQueue
 }
 
+// New builds the scraper. With a nil jobs queue runs process professions in this process; otherwise
+// they only enqueue a job per profession and area for the workers, see ProcessJob. With nil aliases
+// skills are counted as spelled in the vacancies.
 func New(
 	professionProvider ProfessionProvider,
 	sessionCreator SessionProvider,
 	skillSaver SkillsProvider,
 	statSaver StatProvider,
 	dailyStatSaver DailyStatProvider,
-	vacancyFetcher SupplierPort,
+	vacancySaver VacancyProvider,
+	suppliers []SupplierPort,
 	extractor Extractor,
+	aliases SkillAliases,
 	cache CacheProvider,
+	areas []string,
+	concurrency int,
+	jobs JobQueue,
 ) *Scraper {
+	if len(areas) == 0 {
+		areas = []string{domain.DefaultArea}
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
 	return &Scraper{
 		professionProvider: professionProvider,
 		sessionProvider:    sessionCreator,
 		skillsProvider:     skillSaver,
 		statProvider:       statSaver,
 		dailyStatProvider:  dailyStatSaver,
-		supplierPort:       vacancyFetcher,
+		vacancyProvider:    vacancySaver,
+		suppliers:          suppliers,
 		extractor:          extractor,
+		aliases:            aliases,
 		cache:              cache,
+		areas:              areas,
+		concurrency:        concurrency,
+		jobs:               jobs,
 	}
 }
 
-// ProcessActiveProfessionsArchive — full scraping (all to db)
-func (s *Scraper) ProcessActiveProfessionsArchive(ctx context.Context) error {
-	return s.processActiveProfessions(ctx, true)
+// ProcessActiveProfessionsArchive — full scraping (all to db). The outcome of every profession is recorded in the run.
+func (s *Scraper) ProcessActiveProfessionsArchive(ctx context.Context, runID uuid.UUID) error {
+	return s.processActiveProfessions(ctx, runID, true)
 }
 
-// ProcessActiveProfessionsDaily — daily scraping (stat_daily to db, other to cache)
-func (s *Scraper) ProcessActiveProfessionsDaily(ctx context.Context) error {
-	return s.processActiveProfessions(ctx, false)
+// ProcessActiveProfessionsDaily — daily scraping (stat_daily to db, other to cache). The outcome of every profession is recorded in the run.
+func (s *Scraper) ProcessActiveProfessionsDaily(ctx context.Context, runID uuid.UUID) error {
+	return s.processActiveProfessions(ctx, runID, false)
 }
 
-func (s *Scraper) processActiveProfessions(ctx context.Context, saveToDB bool) error {
+func (s *Scraper) processActiveProfessions(ctx context.Context, runID uuid.UUID, saveToDB bool) error {
 	const op = "service.scraper.processActiveProfessions"
-	log := loggerctx.FromContext(ctx).With("op", op)
-
-	start := time.Now()
-
-	var (
-		professionsProcessed int
-		professionSuccess    int
-		professionFailed     int
-		totalVacancies       int
-	)
-
-	defer func() {
-		log.Info("scraping_completed",
-			"duration", time.Since(start),
-			"profession_processed", professionsProcessed,
-			"profession_success", professionSuccess,
-			"profession_failed", professionFailed,
-			"vacancies_total", totalVacancies)
-	}()
+	log := loggerctx.FromContext(ctx).With("op", op, "run_id", runID)
 
-	log.Info("scraping_started", "save_to_db", saveToDB)
+	log.Info("scraping_started", "save_to_db", saveToDB, "areas", s.areas, "concurrency", s.concurrency, "queued", s.jobs != nil)
 
 	professions, err := s.professionProvider.GetActiveProfessions(ctx)
 	if err != nil {
@@ -139,28 +210,411 @@ func (s *Scraper) processActiveProfessions(ctx context.Context, saveToDB bool) e
 		log.Info("session_temporary", "session_id", sessionID)
 	}
 
+	tasks := make([]professionTask, 0, len(professions)*len(s.areas))
 	for _, profession := range professions {
-		professionsProcessed++
-		totalFound, err := s.processProfession(ctx, profession, sessionID, saveToDB)
-		if err != nil {
-			log.Error("profession_process_failed", "profession_id", profession.ID,
-				"profession_name", profession.Name, slogx.Err(err))
-			professionFailed++
-			continue
+		for _, area := range s.areas {
+			tasks = append(tasks, professionTask{profession: profession, area: area})
+		}
+	}
+
+	if s.jobs != nil && len(tasks) > 0 {
+		if err := s.enqueueJobs(ctx, runID, sessionID, tasks, saveToDB); err != nil {
+			// no worker is going to finish the session
+			if saveToDB {
+				s.setSessionStatus(ctx, sessionID, domain.ScrapingStatusFailed)
+			}
+			return err
+		}
+		return nil
+	}
+
+	outcome := s.processTasks(ctx, runID, sessionID, tasks, saveToDB, false)
+
+	if saveToDB {
+		s.setSessionStatus(ctx, sessionID, outcome.sessionStatus())
+	}
+
+	if outcome.interrupted {
+		return fmt.Errorf("%s: %w", op, context.Cause(ctx))
+	}
+
+	return nil
+}
+
+// ResumeSession scrapes into an incomplete archive session the active professions that were not scraped into it
+// successfully, e.g. after the run died half-way, and sets the session status by their outcome. Professions already
+// in the session are not scraped again; failed attempts left in it are replaced.
+func (s *Scraper) ResumeSession(ctx context.Context, runID uuid.UUID, sessionID uuid.UUID) error {
+	const op = "service.scraper.ResumeSession"
+	log := loggerctx.FromContext(ctx).With("op", op, "run_id", runID, "session_id", sessionID)
+
+	session, err := s.sessionProvider.GetScrapingByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrScrapingNotFound) {
+			log.Warn("session_not_found")
+			return domain.ErrScrapingNotFound
+		}
+		log.Error("get_session_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if session.Status == domain.ScrapingStatusComplete {
+		log.Warn("session_already_complete")
+		return domain.ErrScrapingComplete
+	}
+
+	professions, err := s.professionProvider.GetActiveProfessions(ctx)
+	if err != nil {
+		log.Error("get_active_professions_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	succeeded, err := s.sessionProvider.GetSucceededProfessionAreas(ctx, sessionID)
+	if err != nil {
+		log.Error("get_succeeded_professions_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	done := make(map[domain.ProfessionArea]bool, len(succeeded))
+	for _, pa := range succeeded {
+		done[pa] = true
+	}
+
+	var tasks []professionTask
+	for _, profession := range professions {
+		for _, area := range s.areas {
+			if !done[domain.ProfessionArea{ProfessionID: profession.ID, Area: area}] {
+				tasks = append(tasks, professionTask{profession: profession, area: area})
+			}
+		}
+	}
+
+	log.Info("resume_started", "session_status", session.Status, "missing", len(tasks), "succeeded", len(succeeded))
+
+	if err := s.sessionProvider.SetScrapingStatus(ctx, sessionID, domain.ScrapingStatusRunning); err != nil {
+		log.Error("session_status_save_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if s.jobs != nil && len(tasks) > 0 {
+		if err := s.enqueueJobs(ctx, runID, sessionID, tasks, true); err != nil {
+			// no worker is going to finish the session
+			s.setSessionStatus(ctx, sessionID, domain.ScrapingStatusFailed)
+			return err
+		}
+		return nil
+	}
+
+	outcome := s.processTasks(ctx, runID, sessionID, tasks, true, true)
+	s.setSessionStatus(ctx, sessionID, outcome.sessionStatus())
+
+	if outcome.interrupted {
+		return fmt.Errorf("%s: %w", op, context.Cause(ctx))
+	}
+
+	return nil
+}
+
+// professionTask is a profession to be scraped in an area.
+type professionTask struct {
+	profession domain.Profession
+	area       string
+}
+
+// tasksOutcome sums up the professions processed by processTasks.
+type tasksOutcome struct {
+	processed   int
+	success     int
+	failed      int
+	vacancies   int
+	interrupted bool
+}
+
+// sessionStatus is the status of an archive session scraped with the outcome: failed when the run was interrupted
+// or nothing was scraped, partial when some professions failed.
+func (o tasksOutcome) sessionStatus() string {
+	switch {
+	case o.interrupted || (o.success == 0 && o.failed > 0):
+		return domain.ScrapingStatusFailed
+	case o.failed > 0:
+		return domain.ScrapingStatusPartial
+	default:
+		return domain.ScrapingStatusComplete
+	}
+}
+
+// processTasks processes the professions with at most s.concurrency workers, recording each in the run.
+// A cancelled or timed out ctx stops starting professions; the ones in flight are recorded as failed as they end.
+func (s *Scraper) processTasks(
+	ctx context.Context,
+	runID uuid.UUID,
+	sessionID uuid.UUID,
+	tasks []professionTask,
+	saveToDB bool,
+	replace bool,
+) (outcome tasksOutcome) {
+	log := loggerctx.FromContext(ctx).With("run_id", runID)
+
+	start := time.Now()
+	defer func() {
+		log.Info("scraping_completed",
+			"duration", time.Since(start),
+			"profession_processed", outcome.processed,
+			"profession_success", outcome.success,
+			"profession_failed", outcome.failed,
+			"vacancies_total", outcome.vacancies)
+	}()
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	sem := make(chan struct{}, s.concurrency)
+
+	// vacancy sources share their rate limits between the workers
+	for _, task := range tasks {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+
+		if ctx.Err() != nil {
+			outcome.interrupted = true
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			fetchStats, err := s.processRecorded(ctx, runID, sessionID, task.profession, task.area, saveToDB, replace)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			outcome.processed++
+			if err != nil {
+				log.Error("profession_process_failed", "profession_id", task.profession.ID,
+					"profession_name", task.profession.Name, "area", task.area, slogx.Err(err))
+				outcome.failed++
+				return
+			}
+			outcome.success++
+			outcome.vacancies += fetchStats.TotalFound
+		}()
+	}
+
+	wg.Wait()
+
+	// the professions in flight when ctx was done failed, even if no profession was left to start
+	if ctx.Err() != nil {
+		outcome.interrupted = true
+	}
+
+	if outcome.interrupted {
+		log.Warn("scraping_interrupted", "profession_processed", outcome.processed, slogx.Err(context.Cause(ctx)))
+	}
+
+	return outcome
+}
+
+// setSessionStatus records the final status of an archive session that no worker is going to finish. The run
+// context may be done by now, the status is recorded anyway.
+func (s *Scraper) setSessionStatus(ctx context.Context, sessionID uuid.UUID, status string) {
+	if err := s.sessionProvider.SetScrapingStatus(context.WithoutCancel(ctx), sessionID, status); err != nil {
+		loggerctx.FromContext(ctx).Warn("session_status_save_failed", "session_id", sessionID, "status", status, slogx.Err(err))
+		return
+	}
+
+	loggerctx.FromContext(ctx).Info("session_finished", "session_id", sessionID, "status", status)
+}
+
+// ProcessProfessionArchive scrapes one profession into the latest archive session, replacing the profession's
+// data there, so that a fixed vacancy query does not wait for the next full run. The latest session stays
+// the latest one; a new session is created only when there is none yet.
+func (s *Scraper) ProcessProfessionArchive(ctx context.Context, runID uuid.UUID, professionID uuid.UUID) error {
+	return s.processSingleProfession(ctx, runID, professionID, true)
+}
+
+// ProcessProfessionDaily scrapes one profession like the daily run does: stat_daily to db, other to cache.
+func (s *Scraper) ProcessProfessionDaily(ctx context.Context, runID uuid.UUID, professionID uuid.UUID) error {
+	return s.processSingleProfession(ctx, runID, professionID, false)
+}
+
+func (s *Scraper) processSingleProfession(ctx context.Context, runID uuid.UUID, professionID uuid.UUID, saveToDB bool) error {
+	const op = "service.scraper.processSingleProfession"
+	log := loggerctx.FromContext(ctx).With("op", op, "run_id", runID, "profession_id", professionID)
+
+	profession, err := s.professionProvider.GetProfessionByID(ctx, professionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			log.Warn("profession_not_found")
+			return domain.ErrProfessionNotFound
 		}
-		professionSuccess++
-		totalVacancies += totalFound
+		log.Error("get_profession_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("profession_scraping_started", "profession_name", profession.Name, "save_to_db", saveToDB, "areas", s.areas)
+
+	var sessionID uuid.UUID
+	if saveToDB {
+		latest, err := s.sessionProvider.GetLatestScraping(ctx)
+		switch {
+		case errors.Is(err, domain.ErrScrapingNotFound):
+			sessionID, err = s.sessionProvider.CreateScrapingSession(ctx)
+			if err != nil {
+				log.Error("session_create_failed", slogx.Err(err))
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			// a session holding a single profession is never the latest one; resuming it scrapes the others
+			if err := s.sessionProvider.SetScrapingStatus(ctx, sessionID, domain.ScrapingStatusPartial); err != nil {
+				log.Error("session_status_save_failed", slogx.Err(err))
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			log.Info("session_created", "session_id", sessionID)
+		case err != nil:
+			log.Error("get_latest_scraping_failed", slogx.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		default:
+			sessionID = latest.ID
+			log.Info("session_reused", "session_id", sessionID)
+		}
+	} else {
+		sessionID = uuid.New()
+		log.Info("session_temporary", "session_id", sessionID)
+	}
+
+	if s.jobs != nil {
+		tasks := make([]professionTask, len(s.areas))
+		for i, area := range s.areas {
+			tasks[i] = professionTask{profession: profession, area: area}
+		}
+		return s.enqueueJobs(ctx, runID, sessionID, tasks, saveToDB)
+	}
+
+	var errs []error
+	for _, area := range s.areas {
+		if ctx.Err() != nil {
+			log.Warn("scraping_interrupted", slogx.Err(context.Cause(ctx)))
+			return fmt.Errorf("%s: %w", op, context.Cause(ctx))
+		}
+
+		if _, err := s.processRecorded(ctx, runID, sessionID, profession, area, saveToDB, saveToDB); err != nil {
+			log.Error("profession_process_failed", "area", area, slogx.Err(err))
+			errs = append(errs, fmt.Errorf("%s: %w", area, err))
+		}
+	}
+
+	// unlike the run over all professions, the only profession failing fails the run
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", op, errors.Join(errs...))
 	}
 
 	return nil
 }
 
-func (s *Scraper) processProfession(ctx context.Context, profession domain.Profession, sessionID uuid.UUID, saveToDB bool) (int, error) {
+// enqueueJobs queues a job per task for the workers instead of processing them in this process.
+// Jobs saving to the db replace the profession's data in the session, so that a job retried after a crash
+// does not save it twice.
+func (s *Scraper) enqueueJobs(ctx context.Context, runID, sessionID uuid.UUID, tasks []professionTask, saveToDB bool) error {
+	const op = "service.scraper.enqueueJobs"
+
+	jobs := make([]domain.ScrapingJob, len(tasks))
+	for i, task := range tasks {
+		jobs[i] = domain.ScrapingJob{
+			ID:           uuid.New(),
+			RunID:        runID,
+			SessionID:    sessionID,
+			ProfessionID: task.profession.ID,
+			Area:         task.area,
+			SaveToDB:     saveToDB,
+			Replace:      saveToDB,
+			MaxAttempts:  jobMaxAttempts,
+		}
+	}
+
+	if err := s.jobs.EnqueueScrapingJobs(ctx, jobs); err != nil {
+		loggerctx.FromContext(ctx).Error("jobs_enqueue_failed", "run_id", runID, slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	loggerctx.FromContext(ctx).Info("jobs_enqueued", "run_id", runID, "session_id", sessionID, "job_count", len(jobs))
+
+	return nil
+}
+
+// ProcessJob scrapes the profession of a queued job. The outcome is recorded in the job's run once the job
+// succeeds or its last attempt fails, so that attempts to be retried do not show up as failed professions. A job
+// interrupted by its stopping worker (domain.ErrWorkerStopped) goes back to the queue and is not recorded either.
+// A profession deleted since the job was queued returns domain.ErrProfessionNotFound.
+func (s *Scraper) ProcessJob(ctx context.Context, job domain.ScrapingJob) (err error) {
+	const op = "service.scraper.ProcessJob"
+
+	profession, err := s.professionProvider.GetProfessionByID(ctx, job.ProfessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProfessionNotFound) {
+			return domain.ErrProfessionNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	startedAt := time.Now()
+	var fetchStats domain.FetchStats
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: panic: %v", op, r)
+		}
+		stopped := errors.Is(context.Cause(ctx), domain.ErrWorkerStopped)
+		if err == nil || (job.Attempts >= job.MaxAttempts && !stopped) {
+			s.saveProfessionRun(ctx, job.RunID, job.SessionID, profession, job.Area, startedAt, fetchStats, err)
+		}
+	}()
+
+	fetchStats, err = s.processProfession(ctx, profession, job.Area, job.SessionID, job.SaveToDB, job.Replace)
+	return err
+}
+
+// processRecorded processes the profession and records its outcome in the run. A panic fails just
+// the profession: it may happen in a worker goroutine, out of reach of the runner's recovery.
+func (s *Scraper) processRecorded(
+	ctx context.Context,
+	runID uuid.UUID,
+	sessionID uuid.UUID,
+	profession domain.Profession,
+	area string,
+	saveToDB bool,
+	replace bool,
+) (fetchStats domain.FetchStats, err error) {
+	startedAt := time.Now()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+		s.saveProfessionRun(ctx, runID, sessionID, profession, area, startedAt, fetchStats, err)
+	}()
+
+	return s.processProfession(ctx, profession, area, sessionID, saveToDB, replace)
+}
+
+// processProfession scrapes the profession in the area. With replace the profession's rows already stored
+// in the session are deleted once the vacancies are fetched, before the new ones are saved.
+func (s *Scraper) processProfession(
+	ctx context.Context,
+	profession domain.Profession,
+	area string,
+	sessionID uuid.UUID,
+	saveToDB bool,
+	replace bool,
+) (domain.FetchStats, error) {
 	const op = "service.scraper.processProfession"
 	log := loggerctx.FromContext(ctx).With(
 		"op", op,
 		"profession_id", profession.ID,
 		"profession_name", profession.Name,
+		"area", area,
 		"session_id", sessionID,
 	)
 
@@ -171,59 +625,310 @@ func (s *Scraper) processProfession(ctx context.Context, profession domain.Profe
 		log.Info("profession_completed", "duration", time.Since(start))
 	}()
 
-	vacancyData, totalFound, err := s.supplierPort.FetchDataProfession(ctx, profession.VacancyQuery, area)
+	vacancyData, fetchStats, err := s.fetchVacancies(ctx, profession.VacancyQuery, area)
 	if err != nil {
 		log.Error("vacancy_fetch_failed", slogx.Err(err))
-		return totalFound, fmt.Errorf("%s: fetch vacancy data: %w", op, err)
+		return fetchStats, fmt.Errorf("%s: fetch vacancy data: %w", op, err)
 	}
 
-	log.Debug("vacancy_fetched", "vacancy_count", len(vacancyData), "total_found", totalFound)
+	totalFound := fetchStats.TotalFound
+
+	log.Debug("vacancy_fetched", "vacancy_count", len(vacancyData), "total_found", totalFound,
+		"pages_failed", fetchStats.PagesFailed, "vacancies_failed", fetchStats.VacanciesFailed)
 
-	formalSkills := s.aggregateFormalSkills(vacancyData)
-	filteredFormalSkills := s.filterRareSkills(formalSkills, 2)
-	extractedSkills := s.extractSkillsFromText(ctx, vacancyData, filteredFormalSkills)
+	aliases := s.skillAliases(ctx)
+	skillData := canonicalSkills(vacancyData, aliases)
+	filteredFormalSkills, extractedSkills := s.countSkills(ctx, skillData, aliases)
+	formalPercentages, extractedPercentages := s.skillPercentages(filteredFormalSkills, extractedSkills, len(skillData))
 
-	if err := s.dailyStatProvider.SaveStatDaily(ctx, profession.ID, totalFound, time.Now()); err != nil {
+	salary, hasSalary := salaryStat(vacancyData)
+	breakdown := vacancyBreakdown(vacancyData)
+	hasBreakdown := !isEmptyBreakdown(breakdown)
+
+	if err := s.dailyStatProvider.SaveStatDaily(ctx, profession.ID, area, totalFound, time.Now()); err != nil {
 		log.Warn("stat_daily_save_failed", slogx.Err(err))
 	} else {
 		log.Info("stat_daily_saved", "total_found", totalFound)
 	}
 
+	// the profession's data replaces what the session holds for it in one transaction: a reused public session
+	// never shows the profession half-saved, and a profession whose data is not saved completely fails, so that
+	// resuming the session scrapes it again
 	if saveToDB {
-		if err := s.statProvider.SaveStat(ctx, sessionID, profession.ID, totalFound); err != nil {
-			log.Warn("stat_save_failed", slogx.Err(err))
-		} else {
+		err := s.sessionProvider.InTx(ctx, func(ctx context.Context) error {
+			if replace {
+				if err := s.sessionProvider.ClearProfessionSession(ctx, sessionID, profession.ID, area); err != nil {
+					log.Error("profession_session_clear_failed", slogx.Err(err))
+					return fmt.Errorf("%s: clear session data: %w", op, err)
+				}
+			}
+
+			if err := s.statProvider.SaveStat(ctx, sessionID, profession.ID, area, totalFound); err != nil {
+				log.Error("stat_save_failed", slogx.Err(err))
+				return fmt.Errorf("%s: save stat: %w", op, err)
+			}
 			log.Info("stat_saved")
-		}
 
-		if err := s.skillsProvider.SaveFormalSkills(ctx, sessionID, profession.ID, filteredFormalSkills); err != nil {
-			log.Warn("formal_skills_save_failed", slogx.Err(err))
-		} else {
+			if hasSalary {
+				if err := s.statProvider.SaveSalaryStat(ctx, sessionID, profession.ID, area, salary); err != nil {
+					log.Error("salary_stat_save_failed", slogx.Err(err))
+					return fmt.Errorf("%s: save salary stat: %w", op, err)
+				}
+				log.Debug("salary_stat_saved", "sample_size", salary.SampleSize)
+			}
+
+			if hasBreakdown {
+				if err := s.statProvider.SaveVacancyBreakdown(ctx, sessionID, profession.ID, area, breakdown); err != nil {
+					log.Error("breakdown_save_failed", slogx.Err(err))
+					return fmt.Errorf("%s: save breakdown: %w", op, err)
+				}
+				log.Debug("breakdown_saved")
+			}
+
+			extractorVersion := s.extractor.Version()
+
+			if err := s.skillsProvider.SaveFormalSkills(ctx, sessionID, profession.ID, area, extractorVersion, filteredFormalSkills, formalPercentages); err != nil {
+				log.Error("formal_skills_save_failed", slogx.Err(err))
+				return fmt.Errorf("%s: save formal skills: %w", op, err)
+			}
 			log.Debug("formal_skills_saved", "skill_count", len(filteredFormalSkills))
-		}
 
-		if err := s.skillsProvider.SaveExtractedSkills(ctx, sessionID, profession.ID, extractedSkills); err != nil {
-			log.Warn("extracted_skills_save_failed", slogx.Err(err))
-		} else {
+			if err := s.skillsProvider.SaveExtractedSkills(ctx, sessionID, profession.ID, area, extractorVersion, extractedSkills, extractedPercentages); err != nil {
+				log.Error("extracted_skills_save_failed", slogx.Err(err))
+				return fmt.Errorf("%s: save extracted skills: %w", op, err)
+			}
 			log.Debug("extracted_skills_saved", "skill_count", len(extractedSkills))
+
+			if skillSalary := skillSalaries(skillData, filteredFormalSkills); len(skillSalary) > 0 {
+				if err := s.skillsProvider.SaveSkillSalaries(ctx, sessionID, profession.ID, area, skillSalary); err != nil {
+					log.Error("skill_salaries_save_failed", slogx.Err(err))
+					return fmt.Errorf("%s: save skill salaries: %w", op, err)
+				}
+				log.Debug("skill_salaries_saved", "skill_count", len(skillSalary))
+			}
+
+			if err := s.vacancyProvider.SaveVacancies(ctx, sessionID, profession.ID, area, vacancyData); err != nil {
+				log.Error("vacancies_save_failed", slogx.Err(err))
+				return fmt.Errorf("%s: save vacancies: %w", op, err)
+			}
+			log.Debug("vacancies_saved", "vacancy_count", len(vacancyData))
+
+			return nil
+		})
+		if err != nil {
+			return fetchStats, err
 		}
 	}
 
+	// a profession in flight when the run is cancelled fails even if its data got saved, so that neither the run
+	// nor resuming the session counts it as scraped
+	if ctx.Err() != nil {
+		log.Warn("profession_interrupted", slogx.Err(context.Cause(ctx)))
+		return fetchStats, fmt.Errorf("%s: interrupted: %w", op, context.Cause(ctx))
+	}
+
 	if s.cache != nil {
-		if err := s.saveToCache(ctx, profession, totalFound, filteredFormalSkills, extractedSkills); err != nil {
+		var salaryData *domain.SalaryStat
+		if hasSalary {
+			salaryData = &salary
+		}
+
+		var breakdownData *domain.VacancyBreakdown
+		if hasBreakdown {
+			breakdownData = &breakdown
+		}
+
+		formal := s.transformSkillsSort(filteredFormalSkills, formalPercentages)
+		extracted := s.transformSkillsSort(extractedSkills, extractedPercentages)
+
+		if err := s.saveToCache(ctx, profession, area, fetchStats, formal, extracted, salaryData, breakdownData); err != nil {
 			log.Warn("cache_save_failed", slogx.Err(err))
 		} else {
 			log.Debug("cache_saved")
 		}
 	}
 
-	return totalFound, nil
+	return fetchStats, nil
 }
 
+// saveProfessionRun records the outcome of a profession in the run. Failed professions are recorded too,
+// so that the run shows what was lost and why.
+func (s *Scraper) saveProfessionRun(
+	ctx context.Context,
+	runID uuid.UUID,
+	sessionID uuid.UUID,
+	profession domain.Profession,
+	area string,
+	startedAt time.Time,
+	fetchStats domain.FetchStats,
+	processErr error,
+) {
+	run := domain.ProfessionRun{
+		ProfessionID: profession.ID,
+		Area:         area,
+		SessionID:    sessionID,
+		Status:       domain.ProfessionRunStatusSuccess,
+		Stats:        fetchStats,
+		StartedAt:    startedAt,
+	}
+	if processErr != nil {
+		run.Status = domain.ProfessionRunStatusFailed
+		run.Error = processErr.Error()
+	}
+
+	// the run context may be cancelled by now, the outcome is recorded anyway
+	if err := s.statProvider.SaveProfessionRun(context.WithoutCancel(ctx), runID, run); err != nil {
+		loggerctx.FromContext(ctx).Warn("profession_run_save_failed", "profession_id", profession.ID, "area", area, slogx.Err(err))
+	}
+}
+
+// fetchVacancies merges vacancies of all sources, tagging each with its source name, and sums their fetch stats.
+// A failed source is skipped; an error is returned only when every source fails.
+func (s *Scraper) fetchVacancies(ctx context.Context, query, area string) ([]domain.VacancyData, domain.FetchStats, error) {
+	log := loggerctx.FromContext(ctx)
+
+	var (
+		result []domain.VacancyData
+		stats  domain.FetchStats
+		errs   []error
+	)
+
+	for _, supplier := range s.suppliers {
+		source := supplier.Name()
+
+		data, sourceStats, err := supplier.FetchDataProfession(ctx, query, area)
+		if err != nil {
+			log.Warn("source_fetch_failed", "source", source, slogx.Err(err))
+			errs = append(errs, fmt.Errorf("%s: %w", source, err))
+			continue
+		}
+
+		for i := range data {
+			data[i].Source = source
+		}
+
+		log.Debug("source_fetched", "source", source, "vacancy_count", len(data), "total_found", sourceStats.TotalFound)
+
+		result = append(result, data...)
+		stats.TotalFound += sourceStats.TotalFound
+		stats.IDsCollected += sourceStats.IDsCollected
+		stats.VacanciesFetched += sourceStats.VacanciesFetched
+		stats.PagesFailed += sourceStats.PagesFailed
+		stats.VacanciesFailed += sourceStats.VacanciesFailed
+	}
+
+	if len(errs) > 0 && len(errs) == len(s.suppliers) {
+		return nil, domain.FetchStats{}, errors.Join(errs...)
+	}
+
+	return result, stats, nil
+}
+
+// ReprocessSession recounts skills of a past archive session from its stored vacancies
+// with the current extractor version, replacing the session's skill rows. Vacancy sources are not called.
+func (s *Scraper) ReprocessSession(ctx context.Context, sessionID uuid.UUID) error {
+	const op = "service.scraper.ReprocessSession"
+	log := loggerctx.FromContext(ctx).With("op", op, "session_id", sessionID)
+
+	if _, err := s.sessionProvider.GetScrapingByID(ctx, sessionID); err != nil {
+		if errors.Is(err, domain.ErrScrapingNotFound) {
+			log.Warn("session_not_found")
+			return domain.ErrScrapingNotFound
+		}
+		log.Error("get_session_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	professionAreas, err := s.vacancyProvider.GetVacancyProfessionAreasBySession(ctx, sessionID)
+	if err != nil {
+		log.Error("get_session_professions_failed", slogx.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	extractorVersion := s.extractor.Version()
+	log.Info("reprocess_started", "profession_count", len(professionAreas), "extractor_version", extractorVersion)
+
+	var failed int
+	for _, pa := range professionAreas {
+		if ctx.Err() != nil {
+			log.Warn("reprocess_interrupted", slogx.Err(context.Cause(ctx)))
+			return fmt.Errorf("%s: %w", op, context.Cause(ctx))
+		}
+
+		if err := s.reprocessProfession(ctx, sessionID, pa.ProfessionID, pa.Area, extractorVersion); err != nil {
+			log.Error("profession_reprocess_failed", "profession_id", pa.ProfessionID, "area", pa.Area, slogx.Err(err))
+			failed++
+		}
+	}
+
+	log.Info("reprocess_completed", "profession_count", len(professionAreas), "profession_failed", failed)
+
+	if failed > 0 {
+		return fmt.Errorf("%s: %d of %d professions failed", op, failed, len(professionAreas))
+	}
+
+	return nil
+}
+
+func (s *Scraper) reprocessProfession(ctx context.Context, sessionID, professionID uuid.UUID, area, extractorVersion string) error {
+	const op = "service.scraper.reprocessProfession"
+
+	vacancyData, err := s.vacancyProvider.GetAllVacanciesByProfessionAndSession(ctx, professionID, sessionID, area)
+	if err != nil {
+		return fmt.Errorf("%s: get vacancies: %w", op, err)
+	}
+
+	aliases := s.skillAliases(ctx)
+	formalSkills, extractedSkills := s.countSkills(ctx, canonicalSkills(vacancyData, aliases), aliases)
+	formalPercentages, extractedPercentages := s.skillPercentages(formalSkills, extractedSkills, len(vacancyData))
+
+	if err := s.skillsProvider.ReplaceSkills(ctx, sessionID, professionID, area, extractorVersion,
+		formalSkills, formalPercentages, extractedSkills, extractedPercentages); err != nil {
+		return fmt.Errorf("%s: replace skills: %w", op, err)
+	}
+
+	loggerctx.FromContext(ctx).Debug("profession_reprocessed", "profession_id", professionID, "area", area,
+		"vacancy_count", len(vacancyData), "formal_count", len(formalSkills), "extracted_count", len(extractedSkills))
+
+	return nil
+}
+
+// countSkills returns formal skills mentioned at least twice and skills extracted from descriptions.
+// The key skills of data are expected to be canonical already, see canonicalSkills.
+func (s *Scraper) countSkills(ctx context.Context, data []domain.VacancyData, aliases map[string]string) (map[string]int, map[string]int) {
+	formalSkills := s.filterRareSkills(s.aggregateFormalSkills(data), 2)
+	extractedSkills := s.extractSkillsFromText(ctx, data, formalSkills, aliases)
+
+	return formalSkills, extractedSkills
+}
+
+// skillAliases returns the skill aliases, or nil when there are none to apply. Skills are better counted
+// without aliases than not at all, so a failure to load them is only logged.
+func (s *Scraper) skillAliases(ctx context.Context) map[string]string {
+	if s.aliases == nil {
+		return nil
+	}
+
+	aliases, err := s.aliases.Aliases(ctx)
+	if err != nil {
+		loggerctx.FromContext(ctx).Warn("skill_aliases_load_failed", slogx.Err(err))
+		return nil
+	}
+
+	return aliases
+}
+
+// aggregateFormalSkills counts the vacancies listing each key skill. A skill repeated in a vacancy is counted
+// once, so that its share of the vacancies never exceeds 100%.
 func (s *Scraper) aggregateFormalSkills(data []domain.VacancyData) map[string]int {
 	skills := make(map[string]int)
 	for _, d := range data {
+		seen := make(map[string]struct{}, len(d.Skills))
 		for _, skill := range d.Skills {
+			if _, ok := seen[skill]; ok {
+				continue
+			}
+			seen[skill] = struct{}{}
 			skills[skill]++
 		}
 	}
@@ -240,31 +945,89 @@ func (s *Scraper) filterRareSkills(skills map[string]int, minCount int) map[stri
 	return result
 }
 
-func (s *Scraper) extractSkillsFromText(ctx context.Context, data []domain.VacancyData, whiteList map[string]int) map[string]int {
+// extractSkillsFromText counts mentions of the white-listed skills in the descriptions, or the vacancies
+// mentioning them when the extractor counts documents. The aliases of a white-listed skill are looked for
+// too and counted as the skill itself.
+func (s *Scraper) extractSkillsFromText(
+	ctx context.Context,
+	data []domain.VacancyData,
+	whiteList map[string]int,
+	aliases map[string]string,
+) map[string]int {
 	log := loggerctx.FromContext(ctx)
 
 	result := make(map[string]int)
+
+	matcher, err := s.extractor.NewMatcher(withAliases(whiteList, aliases))
+	if err != nil {
+		log.Warn("matcher_build_failed", slogx.Err(err))
+		return result
+	}
+	countsDocuments := s.extractor.CountsDocuments()
+
 	for _, d := range data {
-		extracted, err := s.extractor.ExtractSkills(d.Description, whiteList, ngram)
+		extracted, err := s.extractor.Match(d.Description, matcher)
 		if err != nil {
 			log.Warn("extract_failed", slogx.Err(err), "description_preview", truncate(d.Description, 100))
 			continue
 		}
 
+		found := make(map[string]int, len(extracted))
 		for skill, count := range extracted {
+			found[canonicalSkill(skill, aliases)] += count
+		}
+
+		// a skill found under several aliases is still mentioned in one vacancy
+		for skill, count := range found {
+			if countsDocuments {
+				count = 1
+			}
 			result[skill] += count
 		}
 	}
 	return result
 }
 
-func (s *Scraper) transformSkillsSort(skills map[string]int) []domain.SkillResponse {
+// skillPercentages returns the shares of vacancies mentioning the formal and the extracted skills. Extracted
+// skills have no shares when the extractor counts mentions, as their counts are not numbers of vacancies.
+func (s *Scraper) skillPercentages(
+	formalSkills map[string]int,
+	extractedSkills map[string]int,
+	vacancyCount int,
+) (map[string]float64, map[string]float64) {
+	formal := vacancyPercentages(formalSkills, vacancyCount)
+	if !s.extractor.CountsDocuments() {
+		return formal, nil
+	}
+
+	return formal, vacancyPercentages(extractedSkills, vacancyCount)
+}
+
+// vacancyPercentages converts numbers of vacancies mentioning skills to percentages of vacancyCount rounded
+// to a tenth.
+func vacancyPercentages(skills map[string]int, vacancyCount int) map[string]float64 {
+	if vacancyCount == 0 {
+		return nil
+	}
+
+	result := make(map[string]float64, len(skills))
+	for skill, count := range skills {
+		result[skill] = math.Round(float64(count)/float64(vacancyCount)*1000) / 10
+	}
+	return result
+}
+
+func (s *Scraper) transformSkillsSort(skills map[string]int, percentages map[string]float64) []domain.SkillResponse {
 	result := make([]domain.SkillResponse, 0, len(skills))
 	for skill, count := range skills {
-		result = append(result, domain.SkillResponse{
+		response := domain.SkillResponse{
 			Skill: skill,
 			Count: int32(count),
-		})
+		}
+		if percentage, ok := percentages[skill]; ok {
+			response.Percentage = &percentage
+		}
+		result = append(result, response)
 	}
 
 	sort.Slice(result, func(i, j int) bool {
@@ -277,9 +1040,12 @@ func (s *Scraper) transformSkillsSort(skills map[string]int) []domain.SkillRespo
 func (s *Scraper) saveToCache(
 	ctx context.Context,
 	profession domain.Profession,
-	totalFound int,
-	formalSkills map[string]int,
-	extractedSkills map[string]int,
+	area string,
+	fetchStats domain.FetchStats,
+	formalSkills []domain.SkillResponse,
+	extractedSkills []domain.SkillResponse,
+	salary *domain.SalaryStat,
+	breakdown *domain.VacancyBreakdown,
 ) error {
 	if s.cache == nil {
 		return nil
@@ -288,10 +1054,14 @@ func (s *Scraper) saveToCache(
 	cacheData := &domain.ProfessionDetail{
 		ProfessionID:    profession.ID,
 		ProfessionName:  profession.Name,
+		Area:            area,
 		ScrapedAt:       time.Now().Format(time.RFC3339),
-		VacancyCount:    int32(totalFound),
-		FormalSkills:    s.transformSkillsSort(formalSkills),
-		ExtractedSkills: s.transformSkillsSort(extractedSkills),
+		VacancyCount:    int32(fetchStats.TotalFound),
+		FormalSkills:    formalSkills,
+		ExtractedSkills: extractedSkills,
+		Salary:          salary,
+		Breakdown:       breakdown,
+		Coverage:        &fetchStats,
 	}
 
 	return s.cache.SaveProfessionData(ctx, cacheData)
@@ -2,6 +2,10 @@ package scraper
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -21,35 +25,99 @@ type testDeps struct {
 	skillsProvider     *mocks.MockSkillsProvider
 	statProvider       *mocks.MockStatProvider
 	dailyStatProvider  *mocks.MockDailyStatProvider
+	vacancyProvider    *mocks.MockVacancyProvider
 	supplierPort       *mocks.MockSupplierPort
 	extractor          *mocks.MockExtractor
+	aliases            *mocks.MockSkillAliases
 	cache              *mocks.MockCacheProvider
+	jobs               *mocks.MockJobQueue
 }
 
 func newDeps(t *testing.T) testDeps {
 	t.Helper()
-	return testDeps{
+	d := testDeps{
 		professionProvider: mocks.NewMockProfessionProvider(t),
 		sessionProvider:    mocks.NewMockSessionProvider(t),
 		skillsProvider:     mocks.NewMockSkillsProvider(t),
 		statProvider:       mocks.NewMockStatProvider(t),
 		dailyStatProvider:  mocks.NewMockDailyStatProvider(t),
+		vacancyProvider:    mocks.NewMockVacancyProvider(t),
 		supplierPort:       mocks.NewMockSupplierPort(t),
 		extractor:          mocks.NewMockExtractor(t),
+		aliases:            mocks.NewMockSkillAliases(t),
 		cache:              mocks.NewMockCacheProvider(t),
+		jobs:               mocks.NewMockJobQueue(t),
 	}
+	d.supplierPort.EXPECT().Name().Return(domain.SourceHH).Maybe()
+	d.extractor.EXPECT().NewMatcher(mock.Anything).Return(nil, nil).Maybe()
+	d.extractor.EXPECT().CountsDocuments().Return(false).Maybe()
+	d.statProvider.EXPECT().SaveProfessionRun(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	d.sessionProvider.EXPECT().SetScrapingStatus(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	d.sessionProvider.EXPECT().InTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }).Maybe()
+	return d
 }
 
 func (d testDeps) scraper() *Scraper {
+	return d.concurrentScraper(1)
+}
+
+// concurrentScraper создаёт Scraper, обрабатывающий до concurrency профессий одновременно
+func (d testDeps) concurrentScraper(concurrency int) *Scraper {
+	return New(
+		d.professionProvider,
+		d.sessionProvider,
+		d.skillsProvider,
+		d.statProvider,
+		d.dailyStatProvider,
+		d.vacancyProvider,
+		[]SupplierPort{d.supplierPort},
+		d.extractor,
+		nil,
+		d.cache,
+		[]string{domain.DefaultArea},
+		concurrency,
+		nil,
+	)
+}
+
+// aliasedScraper создаёт Scraper, сводящий навыки к каноническим названиям по словарю aliases
+func (d testDeps) aliasedScraper(aliases map[string]string) *Scraper {
+	d.aliases.EXPECT().Aliases(mock.Anything).Return(aliases, nil)
+
+	return New(
+		d.professionProvider,
+		d.sessionProvider,
+		d.skillsProvider,
+		d.statProvider,
+		d.dailyStatProvider,
+		d.vacancyProvider,
+		[]SupplierPort{d.supplierPort},
+		d.extractor,
+		d.aliases,
+		d.cache,
+		[]string{domain.DefaultArea},
+		1,
+		nil,
+	)
+}
+
+// queuedScraper создаёт Scraper, передающий профессии воркерам через очередь заданий
+func (d testDeps) queuedScraper(areas ...string) *Scraper {
 	return New(
 		d.professionProvider,
 		d.sessionProvider,
 		d.skillsProvider,
 		d.statProvider,
 		d.dailyStatProvider,
-		d.supplierPort,
+		d.vacancyProvider,
+		[]SupplierPort{d.supplierPort},
 		d.extractor,
+		nil,
 		d.cache,
+		areas,
+		1,
+		d.jobs,
 	)
 }
 
@@ -98,6 +166,18 @@ func TestAggregateFormalSkills(t *testing.T) {
 				"go": 1,
 			},
 		},
+		{
+			// hh.ru не убирает повторы ключевых навыков вакансии
+			name: "repeated key skill counted once per vacancy",
+			data: []domain.VacancyData{
+				{Skills: []string{"go", "docker", "go"}},
+				{Skills: []string{"go"}},
+			},
+			expected: map[string]int{
+				"go":     2,
+				"docker": 1,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -175,6 +255,34 @@ func TestFilterRareSkills(t *testing.T) {
 	}
 }
 
+func TestVacancyPercentages(t *testing.T) {
+	tests := []struct {
+		name         string
+		skills       map[string]int
+		vacancyCount int
+		expected     map[string]float64
+	}{
+		{
+			name:         "rounded to a tenth",
+			skills:       map[string]int{"go": 3, "docker": 1, "kafka": 2},
+			vacancyCount: 3,
+			expected:     map[string]float64{"go": 100, "docker": 33.3, "kafka": 66.7},
+		},
+		{
+			name:         "no vacancies",
+			skills:       map[string]int{"go": 3},
+			vacancyCount: 0,
+			expected:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, vacancyPercentages(tt.skills, tt.vacancyCount))
+		})
+	}
+}
+
 func TestTransformSkillsSort(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -229,7 +337,7 @@ func TestTransformSkillsSort(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &Scraper{}
 
-			result := s.transformSkillsSort(tt.skills)
+			result := s.transformSkillsSort(tt.skills, nil)
 
 			// Используем ElementsMatch для случаев с одинаковым count
 			// порядок может быть нестабильным
@@ -274,30 +382,83 @@ func TestScraper_ProcessActiveProfessionsDaily_Success(t *testing.T) {
 
 	// Порядок вызовов важен для корректной работы пайплайна
 	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, 100, nil)
-	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, 100, mock.MatchedBy(func(t time.Time) bool {
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 100}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 100, mock.MatchedBy(func(t time.Time) bool {
 		return t.After(scrapedAt.Add(-time.Second)) && t.Before(scrapedAt.Add(time.Second))
 	})).Return(nil)
 	// Проверяем что description содержит ожидаемый текст
-	deps.extractor.EXPECT().ExtractSkills(
+	deps.extractor.EXPECT().Match(
 		mock.MatchedBy(func(text string) bool {
 			return len(text) > 0
 		}),
 		mock.Anything,
-		3,
 	).Return(map[string]int{"go": 50}, nil)
 	// Проверяем payload cache
 	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(data *domain.ProfessionDetail) bool {
 		return data.ProfessionID == professionID &&
 			data.ProfessionName == "Go Developer" &&
 			data.VacancyCount == 100 &&
+			data.Coverage != nil && data.Coverage.TotalFound == 100 &&
 			len(data.FormalSkills) > 0
 	})).Return(nil)
 
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsDaily(ctx)
+	err := scraperService.ProcessActiveProfessionsDaily(ctx, uuid.New())
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestScraper_ProcessActiveProfessionsDaily_MultipleAreas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	professions := []domain.Profession{
+		{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true},
+	}
+	vacancyData := []domain.VacancyData{
+		{Skills: []string{"go"}, Description: "Go developer"},
+	}
+
+	// Каждая профессия собирается по каждому региону из конфигурации
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 100}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "1").Return(vacancyData, domain.FetchStats{TotalFound: 40}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 100, mock.Anything).Return(nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "1", 40, mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(d *domain.ProfessionDetail) bool {
+		return d.Area == "113" && d.VacancyCount == 100
+	})).Return(nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(d *domain.ProfessionDetail) bool {
+		return d.Area == "1" && d.VacancyCount == 40
+	})).Return(nil)
+
+	scraperService := New(
+		deps.professionProvider,
+		deps.sessionProvider,
+		deps.skillsProvider,
+		deps.statProvider,
+		deps.dailyStatProvider,
+		deps.vacancyProvider,
+		[]SupplierPort{deps.supplierPort},
+		deps.extractor,
+		nil,
+		deps.cache,
+		[]string{"113", "1"},
+		1,
+		nil,
+	)
+
+	// Act
+	err := scraperService.ProcessActiveProfessionsDaily(ctx, uuid.New())
 
 	// Assert
 	require.NoError(t, err)
@@ -317,14 +478,14 @@ func TestScraper_ProcessActiveProfessionsDaily_GetProfessionsError(t *testing.T)
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsDaily(ctx)
+	err := scraperService.ProcessActiveProfessionsDaily(ctx, uuid.New())
 
 	// Assert
 	require.Error(t, err)
 	deps.sessionProvider.AssertNotCalled(t, "CreateScrapingSession")
 	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession")
 	deps.dailyStatProvider.AssertNotCalled(t, "SaveStatDaily")
-	deps.extractor.AssertNotCalled(t, "ExtractSkills")
+	deps.extractor.AssertNotCalled(t, "Match")
 	deps.cache.AssertNotCalled(t, "SaveProfessionData")
 }
 
@@ -348,20 +509,222 @@ func TestScraper_ProcessActiveProfessionsDaily_FetchDataError(t *testing.T) {
 
 	fetchError := assert.AnError
 	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, 0, fetchError)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, fetchError)
 
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsDaily(ctx)
+	err := scraperService.ProcessActiveProfessionsDaily(ctx, uuid.New())
 
 	// Assert
 	require.NoError(t, err) // Ошибка логируется, но не прерывает выполнение
 	deps.dailyStatProvider.AssertNotCalled(t, "SaveStatDaily")
-	deps.extractor.AssertNotCalled(t, "ExtractSkills")
+	deps.extractor.AssertNotCalled(t, "Match")
 	deps.cache.AssertNotCalled(t, "SaveProfessionData")
 }
 
+func TestScraper_ProcessActiveProfessionsDaily_CancelledBetweenProfessions(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	// Arrange
+	deps := newDeps(t)
+
+	professions := []domain.Profession{
+		{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true},
+		{ID: uuid.New(), Name: "Python Developer", VacancyQuery: "python developer", IsActive: true},
+	}
+
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	// запуск отменяется во время сбора первой профессии
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").
+		RunAndReturn(func(context.Context, string, string) ([]domain.VacancyData, domain.FetchStats, error) {
+			cancel(domain.ErrScrapingRunCancelled)
+			return nil, domain.FetchStats{}, context.Canceled
+		}).Once()
+
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessActiveProfessionsDaily(ctx, uuid.New())
+
+	// Assert: вторая профессия не обрабатывается
+	require.ErrorIs(t, err, domain.ErrScrapingRunCancelled)
+	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession", mock.Anything, "python developer", mock.Anything)
+	deps.dailyStatProvider.AssertNotCalled(t, "SaveStatDaily")
+}
+
+func TestScraper_ProcessActiveProfessionsArchive_CancelledDuringSaveFailsProfession(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	sessionID := uuid.New()
+	professions := []domain.Profession{{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}}
+	vacancyData := []domain.VacancyData{{ID: "1", Skills: []string{"go"}, Description: "Go developer"}}
+
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	// запуск отменяется во время сохранения профессии
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", vacancyData).
+		RunAndReturn(func(context.Context, uuid.UUID, uuid.UUID, string, []domain.VacancyData) error {
+			cancel(domain.ErrScrapingRunCancelled)
+			return nil
+		}).Once()
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 1}, nil)
+
+	runID := uuid.New()
+
+	// Act
+	err := deps.scraper().ProcessActiveProfessionsArchive(ctx, runID)
+
+	// Assert: прерванная профессия не считается собранной
+	require.ErrorIs(t, err, domain.ErrScrapingRunCancelled)
+	deps.statProvider.AssertCalled(t, "SaveProfessionRun", mock.Anything, runID, mock.MatchedBy(func(run domain.ProfessionRun) bool {
+		return run.ProfessionID == professionID && run.Status == domain.ProfessionRunStatusFailed &&
+			strings.Contains(run.Error, domain.ErrScrapingRunCancelled.Error())
+	}))
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusFailed)
+	deps.cache.AssertNotCalled(t, "SaveProfessionData", mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessActiveProfessionsDaily_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professions := make([]domain.Profession, 0, 4)
+	for _, name := range []string{"go", "python", "java", "rust"} {
+		professions = append(professions, domain.Profession{ID: uuid.New(), Name: name, VacancyQuery: name, IsActive: true})
+	}
+
+	var (
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+		// обе первые профессии должны оказаться в работе одновременно
+		bothStarted = make(chan struct{})
+		startedOnce sync.Once
+	)
+
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, mock.Anything, "113").
+		RunAndReturn(func(_ context.Context, query, _ string) ([]domain.VacancyData, domain.FetchStats, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				current := maxInFlight.Load()
+				if n <= current || maxInFlight.CompareAndSwap(current, n) {
+					break
+				}
+			}
+			if n == 2 {
+				startedOnce.Do(func() { close(bothStarted) })
+			}
+
+			select {
+			case <-bothStarted:
+			case <-time.After(5 * time.Second):
+				return nil, domain.FetchStats{}, errors.New("professions are not processed concurrently")
+			}
+
+			if query == "java" {
+				return nil, domain.FetchStats{}, errors.New("hh unavailable")
+			}
+			return nil, domain.FetchStats{TotalFound: 10}, nil
+		})
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, mock.Anything, "113", 10, mock.Anything).Return(nil).Times(3)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil).Times(3)
+
+	var (
+		mu       sync.Mutex
+		statuses = make(map[uuid.UUID]string)
+	)
+	statProvider := mocks.NewMockStatProvider(t)
+	statProvider.EXPECT().SaveProfessionRun(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ uuid.UUID, run domain.ProfessionRun) error {
+			mu.Lock()
+			defer mu.Unlock()
+			statuses[run.ProfessionID] = run.Status
+			return nil
+		}).Times(4)
+	deps.statProvider = statProvider
+
+	scraperService := deps.concurrentScraper(2)
+
+	// Act
+	err := scraperService.ProcessActiveProfessionsDaily(ctx, uuid.New())
+
+	// Assert: одновременно работают не больше двух профессий, исход каждой записан
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), maxInFlight.Load())
+	require.Len(t, statuses, 4)
+	for _, profession := range professions {
+		expected := domain.ProfessionRunStatusSuccess
+		if profession.Name == "java" {
+			expected = domain.ProfessionRunStatusFailed
+		}
+		assert.Equal(t, expected, statuses[profession.ID], profession.Name)
+	}
+}
+
+func TestScraper_ProcessActiveProfessionsDaily_PanicFailsOnlyProfession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professions := []domain.Profession{
+		{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true},
+		{ID: uuid.New(), Name: "Python Developer", VacancyQuery: "python developer", IsActive: true},
+	}
+
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").
+		RunAndReturn(func(context.Context, string, string) ([]domain.VacancyData, domain.FetchStats, error) {
+			panic("unexpected response")
+		})
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "python developer", "113").Return(nil, domain.FetchStats{TotalFound: 5}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professions[1].ID, "113", 5, mock.Anything).Return(nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
+
+	statProvider := mocks.NewMockStatProvider(t)
+	statProvider.EXPECT().SaveProfessionRun(mock.Anything, mock.Anything, mock.MatchedBy(func(run domain.ProfessionRun) bool {
+		return run.ProfessionID == professions[0].ID && run.Status == domain.ProfessionRunStatusFailed &&
+			strings.Contains(run.Error, "unexpected response")
+	})).Return(nil).Once()
+	statProvider.EXPECT().SaveProfessionRun(mock.Anything, mock.Anything, mock.MatchedBy(func(run domain.ProfessionRun) bool {
+		return run.ProfessionID == professions[1].ID && run.Status == domain.ProfessionRunStatusSuccess
+	})).Return(nil).Once()
+	deps.statProvider = statProvider
+
+	scraperService := deps.concurrentScraper(2)
+
+	// Act
+	err := scraperService.ProcessActiveProfessionsDaily(ctx, uuid.New())
+
+	// Assert
+	require.NoError(t, err)
+}
+
 func TestScraper_ProcessActiveProfessionsArchive_WithSession(t *testing.T) {
 	t.Parallel()
 
@@ -394,24 +757,30 @@ func TestScraper_ProcessActiveProfessionsArchive_WithSession(t *testing.T) {
 	// Порядок вызовов важен: сессия должна быть создана до fetch
 	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
 	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, 50, nil)
-	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, 50, mock.Anything).Return(nil)
-	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, 50).Return(nil)
-	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, formalSkills).Return(nil)
-	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, extractedSkills).Return(nil)
-	deps.extractor.EXPECT().ExtractSkills(mock.Anything, mock.Anything, 3).Return(map[string]int{"go": 10}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	// доля есть только у формальных навыков: извлечённые посчитаны по упоминаниям
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", formalSkills,
+		map[string]float64{"go": 100}).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", extractedSkills,
+		map[string]float64(nil)).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", vacancyData).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 10}, nil)
 	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
 
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsArchive(ctx)
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
 
 	// Assert
 	require.NoError(t, err)
 }
 
-func TestScraper_ProcessActiveProfessionsArchive_CreateSessionError(t *testing.T) {
+func TestScraper_ProcessActiveProfessionsArchive_SavesSalaryStat(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -419,35 +788,47 @@ func TestScraper_ProcessActiveProfessionsArchive_CreateSessionError(t *testing.T
 	// Arrange
 	deps := newDeps(t)
 
+	professionID := uuid.New()
+	sessionID := uuid.New()
+
 	professions := []domain.Profession{
-		{
-			ID:           uuid.New(),
-			Name:         "Go Developer",
-			VacancyQuery: "go developer",
-			IsActive:     true,
-		},
+		{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true},
 	}
 
-	sessionError := assert.AnError
+	vacancyData := []domain.VacancyData{
+		{Description: "a", Salary: &domain.Salary{From: 100000, Currency: domain.CurrencyRUB, RUB: 100000}},
+		{Description: "b", Salary: &domain.Salary{From: 200000, Currency: domain.CurrencyRUB, RUB: 200000}},
+		{Description: "c"},
+	}
+
+	expectedSalary := domain.SalaryStat{SampleSize: 2, P25: 125000, Median: 150000, P75: 175000}
+
 	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
-	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(uuid.Nil, sessionError)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 3}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 3, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 3).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveSalaryStat(ctx, sessionID, professionID, "113", expectedSalary).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", map[string]int{}, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", map[string]int{}, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", vacancyData).Return(nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(d *domain.ProfessionDetail) bool {
+		return d.Salary != nil && *d.Salary == expectedSalary
+	})).Return(nil)
 
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsArchive(ctx)
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
 
 	// Assert
-	require.Error(t, err)
-	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession")
-	deps.dailyStatProvider.AssertNotCalled(t, "SaveStatDaily")
-	deps.statProvider.AssertNotCalled(t, "SaveStat")
-	deps.skillsProvider.AssertNotCalled(t, "SaveFormalSkills")
-	deps.skillsProvider.AssertNotCalled(t, "SaveExtractedSkills")
-	deps.cache.AssertNotCalled(t, "SaveProfessionData")
+	require.NoError(t, err)
 }
 
-func TestScraper_ProcessActiveProfessionsArchive_SaveStatDailyError(t *testing.T) {
+func TestScraper_ProcessActiveProfessionsArchive_SavesBreakdown(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -459,41 +840,46 @@ func TestScraper_ProcessActiveProfessionsArchive_SaveStatDailyError(t *testing.T
 	sessionID := uuid.New()
 
 	professions := []domain.Profession{
-		{
-			ID:           professionID,
-			Name:         "Go Developer",
-			VacancyQuery: "go developer",
-			IsActive:     true,
-		},
+		{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true},
 	}
 
 	vacancyData := []domain.VacancyData{
-		{Skills: []string{"go"}, Description: "Go developer"},
-		{Skills: []string{"go"}, Description: "Go developer"},
+		{Description: "a", Experience: "between3And6", Schedule: "remote"},
+		{Description: "b", Experience: "between3And6"},
+	}
+
+	expectedBreakdown := domain.VacancyBreakdown{
+		Experience: []domain.BreakdownItem{{Value: "between3And6", Count: 2}},
+		Employment: []domain.BreakdownItem{},
+		Schedule:   []domain.BreakdownItem{{Value: "remote", Count: 1}},
+		Source:     []domain.BreakdownItem{{Value: domain.SourceHH, Count: 2}},
 	}
 
-	saveStatError := assert.AnError
 	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
 	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, 50, nil)
-	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, 50, mock.Anything).Return(saveStatError)
-	// Остальные вызовы продолжаются несмотря на ошибку SaveStatDaily
-	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, 50).Return(nil)
-	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, mock.Anything).Return(nil)
-	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, mock.Anything).Return(nil)
-	deps.extractor.EXPECT().ExtractSkills(mock.Anything, mock.Anything, 3).Return(map[string]int{"go": 10}, nil)
-	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 2}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 2, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 2).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", expectedBreakdown).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{}, nil)
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", map[string]int{}, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", map[string]int{}, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", vacancyData).Return(nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(d *domain.ProfessionDetail) bool {
+		return d.Breakdown != nil && len(d.Breakdown.Schedule) == 1 && d.Salary == nil
+	})).Return(nil)
 
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsArchive(ctx)
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
 
 	// Assert
-	require.NoError(t, err) // Ошибка логируется, но не прерывает выполнение
+	require.NoError(t, err)
 }
 
-func TestScraper_ProcessActiveProfessionsArchive_SaveCacheError(t *testing.T) {
+func TestScraper_ProcessActiveProfessionsArchive_RepeatedKeySkill(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -503,42 +889,72 @@ func TestScraper_ProcessActiveProfessionsArchive_SaveCacheError(t *testing.T) {
 
 	professionID := uuid.New()
 	sessionID := uuid.New()
+	professions := []domain.Profession{{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}}
+	// ключевой навык повторяется в вакансии, синонимов навыков нет
+	vacancyData := []domain.VacancyData{
+		{ID: "1", Skills: []string{"go", "go"}, Description: "Go developer"},
+		{ID: "2", Skills: []string{"go"}, Description: "Go developer"},
+	}
+
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 2}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 2, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 2).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1",
+		map[string]int{"go": 2}, map[string]float64{"go": 100}).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", vacancyData).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 1}, nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
+
+	// Act
+	err := deps.scraper().ProcessActiveProfessionsArchive(ctx, uuid.New())
+
+	// Assert: доля навыка не превышает 100%
+	require.NoError(t, err)
+}
+
+func TestScraper_ProcessActiveProfessionsArchive_CreateSessionError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
 
 	professions := []domain.Profession{
 		{
-			ID:           professionID,
+			ID:           uuid.New(),
 			Name:         "Go Developer",
 			VacancyQuery: "go developer",
 			IsActive:     true,
 		},
 	}
 
-	vacancyData := []domain.VacancyData{
-		{Skills: []string{"go"}, Description: "Go developer"},
-		{Skills: []string{"go"}, Description: "Go developer"},
-	}
-
-	cacheError := assert.AnError
+	sessionError := assert.AnError
 	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
-	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, 50, nil)
-	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, 50, mock.Anything).Return(nil)
-	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, 50).Return(nil)
-	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, mock.Anything).Return(nil)
-	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, mock.Anything).Return(nil)
-	deps.extractor.EXPECT().ExtractSkills(mock.Anything, mock.Anything, 3).Return(map[string]int{"go": 10}, nil)
-	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(cacheError)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(uuid.Nil, sessionError)
 
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsArchive(ctx)
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
 
 	// Assert
-	require.NoError(t, err) // Ошибка логируется, но не прерывает выполнение
+	require.Error(t, err)
+	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession")
+	deps.dailyStatProvider.AssertNotCalled(t, "SaveStatDaily")
+	deps.statProvider.AssertNotCalled(t, "SaveStat")
+	deps.skillsProvider.AssertNotCalled(t, "SaveFormalSkills")
+	deps.skillsProvider.AssertNotCalled(t, "SaveExtractedSkills")
+	deps.vacancyProvider.AssertNotCalled(t, "SaveVacancies")
+	deps.cache.AssertNotCalled(t, "SaveProfessionData")
 }
 
-func TestScraper_ProcessActiveProfessionsArchive_ExtractSkillsError(t *testing.T) {
+func TestScraper_ProcessActiveProfessionsArchive_SaveStatDailyError(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -563,28 +979,31 @@ func TestScraper_ProcessActiveProfessionsArchive_ExtractSkillsError(t *testing.T
 		{Skills: []string{"go"}, Description: "Go developer"},
 	}
 
-	extractError := assert.AnError
+	saveStatError := assert.AnError
 	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
 	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, 50, nil)
-	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, 50, mock.Anything).Return(nil)
-	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, 50).Return(nil)
-	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, mock.Anything).Return(nil)
-	// SaveExtractedSkills вызывается с пустыми навыками из-за ошибки extract
-	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, map[string]int{}).Return(nil)
-	deps.extractor.EXPECT().ExtractSkills(mock.Anything, mock.Anything, 3).Return(map[string]int{}, extractError)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(saveStatError)
+	// Остальные вызовы продолжаются несмотря на ошибку SaveStatDaily
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 10}, nil)
 	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
 
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsArchive(ctx)
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
 
 	// Assert
 	require.NoError(t, err) // Ошибка логируется, но не прерывает выполнение
 }
 
-func TestScraper_ProcessActiveProfessionsArchive_MultipleProfessions(t *testing.T) {
+func TestScraper_ProcessActiveProfessionsArchive_SaveVacanciesError(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -592,27 +1011,212 @@ func TestScraper_ProcessActiveProfessionsArchive_MultipleProfessions(t *testing.
 	// Arrange
 	deps := newDeps(t)
 
-	professionID1 := uuid.New()
-	professionID2 := uuid.New()
+	professionID := uuid.New()
 	sessionID := uuid.New()
 
-	// Две профессии для проверки цикла
 	professions := []domain.Profession{
 		{
-			ID:           professionID1,
+			ID:           professionID,
 			Name:         "Go Developer",
 			VacancyQuery: "go developer",
 			IsActive:     true,
 		},
-		{
-			ID:           professionID2,
-			Name:         "Python Developer",
-			VacancyQuery: "python developer",
-			IsActive:     true,
-		},
 	}
 
-	vacancyData1 := []domain.VacancyData{
+	vacancyData := []domain.VacancyData{
+		{ID: "1", Skills: []string{"go"}, Description: "Go developer"},
+		{ID: "2", Skills: []string{"go"}, Description: "Go developer"},
+	}
+
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", vacancyData).Return(assert.AnError)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 10}, nil)
+
+	runID := uuid.New()
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, runID)
+
+	// Assert: профессия с несохранёнными данными считается неуспешной, чтобы возобновление сессии собрало её заново
+	require.NoError(t, err)
+	deps.statProvider.AssertCalled(t, "SaveProfessionRun", mock.Anything, runID, mock.MatchedBy(func(run domain.ProfessionRun) bool {
+		return run.ProfessionID == professionID && run.Status == domain.ProfessionRunStatusFailed &&
+			strings.Contains(run.Error, "save vacancies")
+	}))
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusFailed)
+	deps.cache.AssertNotCalled(t, "SaveProfessionData", mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessActiveProfessionsArchive_SaveStatError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	sessionID := uuid.New()
+	professions := []domain.Profession{{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}}
+	vacancyData := []domain.VacancyData{{ID: "1", Skills: []string{"go"}, Description: "Go developer"}}
+
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(assert.AnError)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 1}, nil)
+
+	runID := uuid.New()
+
+	// Act
+	err := deps.scraper().ProcessActiveProfessionsArchive(ctx, runID)
+
+	// Assert: остальные данные профессии не сохраняются
+	require.NoError(t, err)
+	deps.statProvider.AssertCalled(t, "SaveProfessionRun", mock.Anything, runID, mock.MatchedBy(func(run domain.ProfessionRun) bool {
+		return run.Status == domain.ProfessionRunStatusFailed && strings.Contains(run.Error, "save stat")
+	}))
+	deps.skillsProvider.AssertNotCalled(t, "SaveFormalSkills", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything)
+	deps.vacancyProvider.AssertNotCalled(t, "SaveVacancies", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessActiveProfessionsArchive_SaveCacheError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	sessionID := uuid.New()
+
+	professions := []domain.Profession{
+		{
+			ID:           professionID,
+			Name:         "Go Developer",
+			VacancyQuery: "go developer",
+			IsActive:     true,
+		},
+	}
+
+	vacancyData := []domain.VacancyData{
+		{Skills: []string{"go"}, Description: "Go developer"},
+		{Skills: []string{"go"}, Description: "Go developer"},
+	}
+
+	cacheError := assert.AnError
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 10}, nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(cacheError)
+
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
+
+	// Assert
+	require.NoError(t, err) // Ошибка логируется, но не прерывает выполнение
+}
+
+func TestScraper_ProcessActiveProfessionsArchive_ExtractSkillsError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	sessionID := uuid.New()
+
+	professions := []domain.Profession{
+		{
+			ID:           professionID,
+			Name:         "Go Developer",
+			VacancyQuery: "go developer",
+			IsActive:     true,
+		},
+	}
+
+	vacancyData := []domain.VacancyData{
+		{Skills: []string{"go"}, Description: "Go developer"},
+		{Skills: []string{"go"}, Description: "Go developer"},
+	}
+
+	extractError := assert.AnError
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	// SaveExtractedSkills вызывается с пустыми навыками из-за ошибки extract
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", map[string]int{}, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{}, extractError)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
+
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
+
+	// Assert
+	require.NoError(t, err) // Ошибка логируется, но не прерывает выполнение
+}
+
+func TestScraper_ProcessActiveProfessionsArchive_MultipleProfessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID1 := uuid.New()
+	professionID2 := uuid.New()
+	sessionID := uuid.New()
+
+	// Две профессии для проверки цикла
+	professions := []domain.Profession{
+		{
+			ID:           professionID1,
+			Name:         "Go Developer",
+			VacancyQuery: "go developer",
+			IsActive:     true,
+		},
+		{
+			ID:           professionID2,
+			Name:         "Python Developer",
+			VacancyQuery: "python developer",
+			IsActive:     true,
+		},
+	}
+
+	vacancyData1 := []domain.VacancyData{
 		{Skills: []string{"go"}, Description: "Go developer"},
 		{Skills: []string{"go"}, Description: "Go developer"},
 	}
@@ -625,23 +1229,28 @@ func TestScraper_ProcessActiveProfessionsArchive_MultipleProfessions(t *testing.
 	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
 
 	// Первая профессия - успешно
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData1, 50, nil)
-	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID1, 50, mock.Anything).Return(nil)
-	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID1, 50).Return(nil)
-	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID1, mock.Anything).Return(nil)
-	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID1, mock.Anything).Return(nil)
-	deps.extractor.EXPECT().ExtractSkills(mock.Anything, mock.Anything, 3).Return(map[string]int{"go": 10}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData1, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID1, "113", 50, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID1, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID1, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID1, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID1, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID1, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 10}, nil)
 	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(data *domain.ProfessionDetail) bool {
 		return data.ProfessionID == professionID1 && data.VacancyCount == 50
 	})).Return(nil)
 
 	// Вторая профессия - успешно
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "python developer", "113").Return(vacancyData2, 75, nil)
-	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID2, 75, mock.Anything).Return(nil)
-	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID2, 75).Return(nil)
-	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID2, mock.Anything).Return(nil)
-	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID2, mock.Anything).Return(nil)
-	deps.extractor.EXPECT().ExtractSkills(mock.Anything, mock.Anything, 3).Return(map[string]int{"python": 15}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "python developer", "113").Return(vacancyData2, domain.FetchStats{TotalFound: 75}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID2, "113", 75, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID2, "113", 75).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID2, "113", mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID2, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID2, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID2, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"python": 15}, nil)
 	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(data *domain.ProfessionDetail) bool {
 		return data.ProfessionID == professionID2 && data.VacancyCount == 75
 	})).Return(nil)
@@ -649,7 +1258,7 @@ func TestScraper_ProcessActiveProfessionsArchive_MultipleProfessions(t *testing.
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsArchive(ctx)
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
 
 	// Assert
 	require.NoError(t, err)
@@ -694,27 +1303,31 @@ func TestScraper_ProcessActiveProfessionsArchive_MixedSuccess(t *testing.T) {
 	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
 
 	// Первая профессия - успешно
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, 50, nil)
-	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID1, 50, mock.Anything).Return(nil)
-	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID1, 50).Return(nil)
-	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID1, mock.Anything).Return(nil)
-	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID1, mock.Anything).Return(nil)
-	deps.extractor.EXPECT().ExtractSkills(mock.Anything, mock.Anything, 3).Return(map[string]int{"go": 10}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID1, "113", 50, mock.Anything).Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID1, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID1, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID1, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID1, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID1, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 10}, nil)
 	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(data *domain.ProfessionDetail) bool {
 		return data.ProfessionID == professionID1 && data.VacancyCount == 50
 	})).Return(nil)
 
 	// Вторая профессия - ошибка fetch
-	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "python developer", "113").Return(nil, 0, fetchError)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "python developer", "113").Return(nil, domain.FetchStats{}, fetchError)
 	// SaveStatDaily не вызывается при ошибке fetch
 
 	scraperService := deps.scraper()
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsArchive(ctx)
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, uuid.New())
 
 	// Assert
 	require.NoError(t, err) // Ошибка одной профессии не прерывает процесс
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusPartial)
 }
 
 func TestScraper_ProcessActiveProfessionsArchive_NilCache(t *testing.T) {
@@ -728,12 +1341,14 @@ func TestScraper_ProcessActiveProfessionsArchive_NilCache(t *testing.T) {
 	skillsProvider := mocks.NewMockSkillsProvider(t)
 	statProvider := mocks.NewMockStatProvider(t)
 	dailyStatProvider := mocks.NewMockDailyStatProvider(t)
+	vacancyProvider := mocks.NewMockVacancyProvider(t)
 	supplierPort := mocks.NewMockSupplierPort(t)
 	extractor := mocks.NewMockExtractor(t)
 	// cache = nil
 
 	professionID := uuid.New()
 	sessionID := uuid.New()
+	runID := uuid.New()
 
 	professions := []domain.Profession{
 		{
@@ -751,12 +1366,25 @@ func TestScraper_ProcessActiveProfessionsArchive_NilCache(t *testing.T) {
 
 	professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
 	sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
-	supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, 50, nil)
-	dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, 50, mock.Anything).Return(nil)
-	statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, 50).Return(nil)
-	skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, mock.Anything).Return(nil)
-	skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, mock.Anything).Return(nil)
-	extractor.EXPECT().ExtractSkills(mock.Anything, mock.Anything, 3).Return(map[string]int{"go": 10}, nil)
+	sessionProvider.EXPECT().SetScrapingStatus(mock.Anything, sessionID, domain.ScrapingStatusComplete).Return(nil)
+	sessionProvider.EXPECT().InTx(ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) })
+	supplierPort.EXPECT().Name().Return(domain.SourceHH)
+	supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	statProvider.EXPECT().SaveProfessionRun(mock.Anything, runID, mock.MatchedBy(func(run domain.ProfessionRun) bool {
+		return run.ProfessionID == professionID && run.SessionID == sessionID && run.Area == "113" &&
+			run.Status == domain.ProfessionRunStatusSuccess && run.Stats == domain.FetchStats{TotalFound: 50}
+	})).Return(nil)
+	dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(nil)
+	statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	extractor.EXPECT().Version().Return("ngram-v1")
+	skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", vacancyData).Return(nil)
+	extractor.EXPECT().NewMatcher(mock.Anything).Return(nil, nil)
+	extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 10}, nil)
+	extractor.EXPECT().CountsDocuments().Return(false)
 	// cache.SaveProfessionData НЕ вызывается
 
 	scraperService := New(
@@ -765,14 +1393,1001 @@ func TestScraper_ProcessActiveProfessionsArchive_NilCache(t *testing.T) {
 		skillsProvider,
 		statProvider,
 		dailyStatProvider,
-		supplierPort,
+		vacancyProvider,
+		[]SupplierPort{supplierPort},
 		extractor,
+		nil, // aliases == nil, навыки считаются как написаны в вакансиях
 		nil, // cache == nil
+		nil, // areas == nil, используется domain.DefaultArea
+		0,   // concurrency < 1, профессии обрабатываются по одной
+		nil, // jobs == nil, профессии обрабатываются в этом процессе
 	)
 
 	// Act
-	err := scraperService.ProcessActiveProfessionsArchive(ctx)
+	err := scraperService.ProcessActiveProfessionsArchive(ctx, runID)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+// ==================== saveProfessionRun ====================
+
+func TestScraper_ProcessProfessionArchive_ReplacesInLatestSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	sessionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+
+	vacancyData := []domain.VacancyData{
+		{Skills: []string{"go"}, Description: "Go developer needed"},
+		{Skills: []string{"go"}, Description: "Go developer needed"},
+	}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).Return(domain.Scraping{ID: sessionID}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	deps.sessionProvider.EXPECT().ClearProfessionSession(ctx, sessionID, professionID, "113").Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", map[string]int{"go": 2}, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", map[string]int{"go": 20}, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", vacancyData).Return(nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 10}, nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
+
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessProfessionArchive(ctx, uuid.New(), professionID)
+
+	// Assert: новая сессия не создаётся, остальные профессии не обрабатываются
+	require.NoError(t, err)
+	deps.sessionProvider.AssertNotCalled(t, "CreateScrapingSession", mock.Anything)
+	deps.professionProvider.AssertNotCalled(t, "GetActiveProfessions", mock.Anything)
+}
+
+// txCtxKey помечает контекст транзакции в тестах
+type txCtxKey struct{}
+
+func TestScraper_ProcessProfessionArchive_SaveErrorRollsBackReplace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	sessionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+	vacancyData := []domain.VacancyData{{Skills: []string{"go"}, Description: "Go developer needed"}}
+
+	// очистка и сохранение идут с контекстом транзакции; ошибка сохранения откатывает очистку
+	txCtx := context.WithValue(ctx, txCtxKey{}, true)
+	var txErr error
+	deps.sessionProvider = mocks.NewMockSessionProvider(t)
+	deps.sessionProvider.EXPECT().InTx(ctx, mock.Anything).
+		RunAndReturn(func(_ context.Context, fn func(ctx context.Context) error) error {
+			txErr = fn(txCtx)
+			return txErr
+		}).Once()
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).Return(domain.Scraping{ID: sessionID}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(vacancyData, domain.FetchStats{TotalFound: 50}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 50, mock.Anything).Return(nil)
+	deps.sessionProvider.EXPECT().ClearProfessionSession(txCtx, sessionID, professionID, "113").Return(nil)
+	deps.statProvider.EXPECT().SaveStat(txCtx, sessionID, professionID, "113", 50).Return(nil)
+	deps.statProvider.EXPECT().SaveVacancyBreakdown(txCtx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(txCtx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(txCtx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(txCtx, sessionID, professionID, "113", vacancyData).Return(assert.AnError)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 1}, nil)
+
+	// Act
+	err := deps.scraper().ProcessProfessionArchive(ctx, uuid.New(), professionID)
 
 	// Assert
+	require.Error(t, err)
+	require.ErrorIs(t, txErr, assert.AnError)
+	deps.cache.AssertNotCalled(t, "SaveProfessionData", mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessProfessionArchive_NoSessionYet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	sessionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).Return(domain.Scraping{}, domain.ErrScrapingNotFound)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 0, mock.Anything).Return(nil)
+	deps.sessionProvider.EXPECT().ClearProfessionSession(ctx, sessionID, professionID, "113").Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, professionID, "113", 0).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, professionID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, professionID, "113", mock.Anything).Return(nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
+
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessProfessionArchive(ctx, uuid.New(), professionID)
+
+	// Assert: сессия с одной профессией не становится последней
+	require.NoError(t, err)
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusPartial)
+}
+
+func TestScraper_ProcessProfessionArchive_FetchErrorKeepsSessionData(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.sessionProvider.EXPECT().GetLatestScraping(ctx).Return(domain.Scraping{ID: uuid.New()}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, errors.New("hh unavailable"))
+
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessProfessionArchive(ctx, uuid.New(), professionID)
+
+	// Assert: данные профессии в сессии не удаляются
+	require.Error(t, err)
+	deps.sessionProvider.AssertNotCalled(t, "ClearProfessionSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessProfessionDaily_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: false}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{TotalFound: 7}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 7, mock.Anything).Return(nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.MatchedBy(func(data *domain.ProfessionDetail) bool {
+		return data.ProfessionID == professionID && data.Area == "113"
+	})).Return(nil)
+
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessProfessionDaily(ctx, uuid.New(), professionID)
+
+	// Assert: сессии в базе не затрагиваются
 	require.NoError(t, err)
+	deps.sessionProvider.AssertNotCalled(t, "GetLatestScraping", mock.Anything)
+	deps.sessionProvider.AssertNotCalled(t, "ClearProfessionSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessProfessionDaily_ProfessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{}, domain.ErrProfessionNotFound)
+
+	scraperService := deps.scraper()
+
+	// Act
+	err := scraperService.ProcessProfessionDaily(ctx, uuid.New(), professionID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrProfessionNotFound)
+	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// ==================== ResumeSession ====================
+
+func TestScraper_ResumeSession_ScrapesOnlyMissing(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	done := domain.Profession{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+	missing := domain.Profession{ID: uuid.New(), Name: "Python Developer", VacancyQuery: "python developer", IsActive: true}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{ID: sessionID, Status: domain.ScrapingStatusFailed}, nil)
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return([]domain.Profession{done, missing}, nil)
+	deps.sessionProvider.EXPECT().GetSucceededProfessionAreas(ctx, sessionID).
+		Return([]domain.ProfessionArea{{ProfessionID: done.ID, Area: "113"}}, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "python developer", "113").Return(nil, domain.FetchStats{TotalFound: 5}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, missing.ID, "113", 5, mock.Anything).Return(nil)
+	deps.sessionProvider.EXPECT().ClearProfessionSession(ctx, sessionID, missing.ID, "113").Return(nil)
+	deps.statProvider.EXPECT().SaveStat(ctx, sessionID, missing.ID, "113", 5).Return(nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+	deps.skillsProvider.EXPECT().SaveFormalSkills(ctx, sessionID, missing.ID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.skillsProvider.EXPECT().SaveExtractedSkills(ctx, sessionID, missing.ID, "113", "ngram-v1", mock.Anything, mock.Anything).Return(nil)
+	deps.vacancyProvider.EXPECT().SaveVacancies(ctx, sessionID, missing.ID, "113", mock.Anything).Return(nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
+
+	// Act
+	err := deps.scraper().ResumeSession(ctx, uuid.New(), sessionID)
+
+	// Assert: собранная профессия не запрашивается повторно, сессия становится полной
+	require.NoError(t, err)
+	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession", mock.Anything, "go developer", mock.Anything)
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", ctx, sessionID, domain.ScrapingStatusRunning)
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusComplete)
+	deps.sessionProvider.AssertNotCalled(t, "CreateScrapingSession", mock.Anything)
+}
+
+func TestScraper_ResumeSession_StillFailing(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	profession := domain.Profession{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{ID: sessionID, Status: domain.ScrapingStatusRunning}, nil)
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return([]domain.Profession{profession}, nil)
+	deps.sessionProvider.EXPECT().GetSucceededProfessionAreas(ctx, sessionID).Return(nil, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, errors.New("hh unavailable"))
+
+	// Act
+	err := deps.scraper().ResumeSession(ctx, uuid.New(), sessionID)
+
+	// Assert: ошибка профессии не прерывает запуск, сессия остаётся неполной
+	require.NoError(t, err)
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusFailed)
+}
+
+func TestScraper_ResumeSession_AlreadyComplete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{ID: sessionID, Status: domain.ScrapingStatusComplete}, nil)
+
+	// Act
+	err := deps.scraper().ResumeSession(ctx, uuid.New(), sessionID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingComplete)
+	deps.professionProvider.AssertNotCalled(t, "GetActiveProfessions", mock.Anything)
+}
+
+func TestScraper_ResumeSession_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).Return(domain.Scraping{}, domain.ErrScrapingNotFound)
+
+	// Act
+	err := deps.scraper().ResumeSession(ctx, uuid.New(), sessionID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingNotFound)
+}
+
+func TestScraper_ResumeSession_Queued(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	sessionID := uuid.New()
+	profession := domain.Profession{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{ID: sessionID, Status: domain.ScrapingStatusPartial}, nil)
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return([]domain.Profession{profession}, nil)
+	deps.sessionProvider.EXPECT().GetSucceededProfessionAreas(ctx, sessionID).
+		Return([]domain.ProfessionArea{{ProfessionID: profession.ID, Area: "113"}}, nil)
+	deps.jobs.EXPECT().EnqueueScrapingJobs(ctx, mock.MatchedBy(func(jobs []domain.ScrapingJob) bool {
+		return len(jobs) == 1 && jobs[0].RunID == runID && jobs[0].SessionID == sessionID &&
+			jobs[0].ProfessionID == profession.ID && jobs[0].Area == "1" && jobs[0].SaveToDB && jobs[0].Replace
+	})).Return(nil).Once()
+
+	// Act
+	err := deps.queuedScraper("113", "1").ResumeSession(ctx, runID, sessionID)
+
+	// Assert: статус сессии выставит воркер, обработавший последнее задание
+	require.NoError(t, err)
+	deps.sessionProvider.AssertNotCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusComplete)
+}
+
+func TestTasksOutcome_SessionStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		outcome  tasksOutcome
+		expected string
+	}{
+		{name: "all succeeded", outcome: tasksOutcome{success: 3}, expected: domain.ScrapingStatusComplete},
+		{name: "nothing to scrape", outcome: tasksOutcome{}, expected: domain.ScrapingStatusComplete},
+		{name: "some failed", outcome: tasksOutcome{success: 2, failed: 1}, expected: domain.ScrapingStatusPartial},
+		{name: "all failed", outcome: tasksOutcome{failed: 3}, expected: domain.ScrapingStatusFailed},
+		{name: "interrupted", outcome: tasksOutcome{success: 2, interrupted: true}, expected: domain.ScrapingStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.outcome.sessionStatus())
+		})
+	}
+}
+
+// ==================== Job queue ====================
+
+func TestScraper_ProcessActiveProfessionsArchive_Queued(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	runID := uuid.New()
+	sessionID := uuid.New()
+	professions := []domain.Profession{
+		{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true},
+		{ID: uuid.New(), Name: "Python Developer", VacancyQuery: "python developer", IsActive: true},
+	}
+
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+
+	var queued []domain.ScrapingJob
+	deps.jobs.EXPECT().EnqueueScrapingJobs(ctx, mock.Anything).
+		Run(func(_ context.Context, jobs []domain.ScrapingJob) { queued = jobs }).
+		Return(nil).Once()
+
+	// Act
+	err := deps.queuedScraper("113", "1").ProcessActiveProfessionsArchive(ctx, runID)
+
+	// Assert: задание на каждую пару профессии и региона, вакансии в этом процессе не собираются
+	require.NoError(t, err)
+	require.Len(t, queued, 4)
+	for _, job := range queued {
+		assert.Equal(t, runID, job.RunID)
+		assert.Equal(t, sessionID, job.SessionID)
+		assert.True(t, job.SaveToDB)
+		assert.True(t, job.Replace)
+		assert.Equal(t, jobMaxAttempts, job.MaxAttempts)
+	}
+	assert.Equal(t, professions[0].ID, queued[0].ProfessionID)
+	assert.Equal(t, "113", queued[0].Area)
+	assert.Equal(t, "1", queued[1].Area)
+	assert.Equal(t, professions[1].ID, queued[3].ProfessionID)
+	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessProfessionDaily_Queued(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.jobs.EXPECT().EnqueueScrapingJobs(ctx, mock.MatchedBy(func(jobs []domain.ScrapingJob) bool {
+		return len(jobs) == 1 && jobs[0].ProfessionID == professionID && jobs[0].Area == "113" &&
+			!jobs[0].SaveToDB && !jobs[0].Replace && jobs[0].SessionID != uuid.Nil
+	})).Return(nil).Once()
+
+	// Act
+	err := deps.queuedScraper("113").ProcessProfessionDaily(ctx, uuid.New(), professionID)
+
+	// Assert
+	require.NoError(t, err)
+	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessActiveProfessionsDaily_EnqueueError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professions := []domain.Profession{{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}}
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.jobs.EXPECT().EnqueueScrapingJobs(ctx, mock.Anything).Return(errors.New("db unavailable")).Once()
+
+	// Act
+	err := deps.queuedScraper("113").ProcessActiveProfessionsDaily(ctx, uuid.New())
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestScraper_ProcessActiveProfessionsArchive_EnqueueErrorFailsSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	professions := []domain.Profession{{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}}
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(professions, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+	deps.jobs.EXPECT().EnqueueScrapingJobs(ctx, mock.Anything).Return(errors.New("db unavailable")).Once()
+
+	// Act
+	err := deps.queuedScraper("113").ProcessActiveProfessionsArchive(ctx, uuid.New())
+
+	// Assert: заданий нет, воркеры сессию не завершат
+	require.Error(t, err)
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusFailed)
+}
+
+func TestScraper_ProcessActiveProfessionsArchive_QueuedWithoutProfessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return(nil, nil)
+	deps.sessionProvider.EXPECT().CreateScrapingSession(ctx).Return(sessionID, nil)
+
+	// Act
+	err := deps.queuedScraper("113").ProcessActiveProfessionsArchive(ctx, uuid.New())
+
+	// Assert
+	require.NoError(t, err)
+	deps.jobs.AssertNotCalled(t, "EnqueueScrapingJobs", mock.Anything, mock.Anything)
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusComplete)
+}
+
+func TestScraper_ResumeSession_EnqueueErrorFailsSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	profession := domain.Profession{ID: uuid.New(), Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).
+		Return(domain.Scraping{ID: sessionID, Status: domain.ScrapingStatusPartial}, nil)
+	deps.professionProvider.EXPECT().GetActiveProfessions(ctx).Return([]domain.Profession{profession}, nil)
+	deps.sessionProvider.EXPECT().GetSucceededProfessionAreas(ctx, sessionID).Return(nil, nil)
+	deps.jobs.EXPECT().EnqueueScrapingJobs(ctx, mock.Anything).Return(errors.New("db unavailable")).Once()
+
+	// Act
+	err := deps.queuedScraper("113").ResumeSession(ctx, uuid.New(), sessionID)
+
+	// Assert: сессия не остаётся в статусе running
+	require.Error(t, err)
+	deps.sessionProvider.AssertCalled(t, "SetScrapingStatus", mock.Anything, sessionID, domain.ScrapingStatusFailed)
+}
+
+func TestScraper_ProcessJob_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+	job := domain.ScrapingJob{
+		ID:           uuid.New(),
+		RunID:        uuid.New(),
+		SessionID:    uuid.New(),
+		ProfessionID: professionID,
+		Area:         "113",
+		Attempts:     1,
+		MaxAttempts:  3,
+	}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{TotalFound: 7}, nil)
+	deps.dailyStatProvider.EXPECT().SaveStatDaily(ctx, professionID, "113", 7, mock.Anything).Return(nil)
+	deps.cache.EXPECT().SaveProfessionData(ctx, mock.Anything).Return(nil)
+
+	// Act
+	err := deps.scraper().ProcessJob(ctx, job)
+
+	// Assert
+	require.NoError(t, err)
+	deps.statProvider.AssertCalled(t, "SaveProfessionRun", mock.Anything, job.RunID, mock.MatchedBy(func(run domain.ProfessionRun) bool {
+		return run.ProfessionID == professionID && run.SessionID == job.SessionID &&
+			run.Status == domain.ProfessionRunStatusSuccess && run.Stats.TotalFound == 7
+	}))
+}
+
+func TestScraper_ProcessJob_RetriedAttemptNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+	job := domain.ScrapingJob{ID: uuid.New(), RunID: uuid.New(), ProfessionID: professionID, Area: "113", Attempts: 1, MaxAttempts: 3}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, errors.New("hh unavailable"))
+
+	// Act
+	err := deps.scraper().ProcessJob(ctx, job)
+
+	// Assert: попытка будет повторена, в запуске профессия не отмечается
+	require.Error(t, err)
+	deps.statProvider.AssertNotCalled(t, "SaveProfessionRun", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessJob_LastAttemptRecorded(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+	job := domain.ScrapingJob{ID: uuid.New(), RunID: uuid.New(), ProfessionID: professionID, Area: "113", Attempts: 3, MaxAttempts: 3}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, errors.New("hh unavailable"))
+
+	// Act
+	err := deps.scraper().ProcessJob(ctx, job)
+
+	// Assert
+	require.Error(t, err)
+	deps.statProvider.AssertCalled(t, "SaveProfessionRun", mock.Anything, job.RunID, mock.MatchedBy(func(run domain.ProfessionRun) bool {
+		return run.Status == domain.ProfessionRunStatusFailed && run.Error != ""
+	}))
+}
+
+func TestScraper_ProcessJob_StoppedWorkerNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(domain.ErrWorkerStopped)
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	profession := domain.Profession{ID: professionID, Name: "Go Developer", VacancyQuery: "go developer", IsActive: true}
+	job := domain.ScrapingJob{ID: uuid.New(), RunID: uuid.New(), ProfessionID: professionID, Area: "113", Attempts: 3, MaxAttempts: 3}
+
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(profession, nil)
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, context.Canceled)
+
+	// Act: воркер останавливается во время последней попытки и возвращает задание в очередь
+	err := deps.scraper().ProcessJob(ctx, job)
+
+	// Assert
+	require.Error(t, err)
+	deps.statProvider.AssertNotCalled(t, "SaveProfessionRun", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScraper_ProcessJob_ProfessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	professionID := uuid.New()
+	deps.professionProvider.EXPECT().GetProfessionByID(ctx, professionID).Return(domain.Profession{}, domain.ErrProfessionNotFound)
+
+	// Act
+	err := deps.scraper().ProcessJob(ctx, domain.ScrapingJob{ID: uuid.New(), ProfessionID: professionID, Area: "113", Attempts: 1, MaxAttempts: 3})
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrProfessionNotFound)
+}
+
+func TestScraper_SaveProfessionRun(t *testing.T) {
+	t.Parallel()
+
+	profession := domain.Profession{ID: uuid.New(), Name: "Go Developer"}
+	startedAt := time.Now()
+
+	t.Run("успешная профессия", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctx := context.Background()
+		runID, sessionID := uuid.New(), uuid.New()
+		statProvider := mocks.NewMockStatProvider(t)
+		statProvider.EXPECT().SaveProfessionRun(mock.Anything, runID, domain.ProfessionRun{
+			ProfessionID: profession.ID,
+			Area:         "113",
+			SessionID:    sessionID,
+			Status:       domain.ProfessionRunStatusSuccess,
+			Stats:        domain.FetchStats{TotalFound: 50, VacanciesFetched: 48},
+			StartedAt:    startedAt,
+		}).Return(nil).Once()
+
+		s := &Scraper{statProvider: statProvider}
+
+		// Act
+		s.saveProfessionRun(ctx, runID, sessionID, profession, "113", startedAt,
+			domain.FetchStats{TotalFound: 50, VacanciesFetched: 48}, nil)
+	})
+
+	t.Run("ошибка профессии записывается в запуск", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctx := context.Background()
+		runID, sessionID := uuid.New(), uuid.New()
+		statProvider := mocks.NewMockStatProvider(t)
+		statProvider.EXPECT().SaveProfessionRun(mock.Anything, runID, domain.ProfessionRun{
+			ProfessionID: profession.ID,
+			Area:         "113",
+			SessionID:    sessionID,
+			Status:       domain.ProfessionRunStatusFailed,
+			Error:        "fetch vacancy data: hh unavailable",
+			StartedAt:    startedAt,
+		}).Return(nil).Once()
+
+		s := &Scraper{statProvider: statProvider}
+
+		// Act
+		s.saveProfessionRun(ctx, runID, sessionID, profession, "113", startedAt,
+			domain.FetchStats{}, errors.New("fetch vacancy data: hh unavailable"))
+	})
+
+	t.Run("ошибка сохранения не прерывает запуск", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		ctx := context.Background()
+		statProvider := mocks.NewMockStatProvider(t)
+		statProvider.EXPECT().SaveProfessionRun(mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError).Once()
+
+		s := &Scraper{statProvider: statProvider}
+
+		// Act & Assert
+		assert.NotPanics(t, func() {
+			s.saveProfessionRun(ctx, uuid.New(), uuid.New(), profession, "113", startedAt, domain.FetchStats{}, nil)
+		})
+	})
+}
+
+// ==================== fetchVacancies ====================
+
+func TestScraper_FetchVacancies_MergesSources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	fileSource := mocks.NewMockSupplierPort(t)
+	fileSource.EXPECT().Name().Return(domain.SourceFile)
+
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "1").
+		Return([]domain.VacancyData{{ID: "1"}, {ID: "2"}}, domain.FetchStats{
+			TotalFound:       40,
+			IDsCollected:     30,
+			VacanciesFetched: 2,
+			PagesFailed:      1,
+			VacanciesFailed:  28,
+		}, nil)
+	fileSource.EXPECT().FetchDataProfession(ctx, "go developer", "1").
+		Return([]domain.VacancyData{{ID: "1"}}, domain.FetchStats{TotalFound: 1, IDsCollected: 1, VacanciesFetched: 1}, nil)
+
+	scraperService := deps.scraper()
+	scraperService.suppliers = append(scraperService.suppliers, fileSource)
+
+	// Act
+	data, stats, err := scraperService.fetchVacancies(ctx, "go developer", "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.FetchStats{
+		TotalFound:       41,
+		IDsCollected:     31,
+		VacanciesFetched: 3,
+		PagesFailed:      1,
+		VacanciesFailed:  28,
+	}, stats)
+	assert.Equal(t, []domain.VacancyData{
+		{ID: "1", Source: domain.SourceHH},
+		{ID: "2", Source: domain.SourceHH},
+		{ID: "1", Source: domain.SourceFile},
+	}, data)
+}
+
+func TestScraper_FetchVacancies_SourceErrorSkipped(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	fileSource := mocks.NewMockSupplierPort(t)
+	fileSource.EXPECT().Name().Return(domain.SourceFile)
+
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, assert.AnError)
+	fileSource.EXPECT().FetchDataProfession(ctx, "go developer", "113").
+		Return([]domain.VacancyData{{ID: "1"}}, domain.FetchStats{TotalFound: 1}, nil)
+
+	scraperService := deps.scraper()
+	scraperService.suppliers = append(scraperService.suppliers, fileSource)
+
+	// Act
+	data, stats, err := scraperService.fetchVacancies(ctx, "go developer", "113")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.TotalFound)
+	assert.Equal(t, []domain.VacancyData{{ID: "1", Source: domain.SourceFile}}, data)
+}
+
+func TestScraper_FetchVacancies_AllSourcesFail(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	fileSource := mocks.NewMockSupplierPort(t)
+	fileSource.EXPECT().Name().Return(domain.SourceFile)
+
+	deps.supplierPort.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, assert.AnError)
+	fileSource.EXPECT().FetchDataProfession(ctx, "go developer", "113").Return(nil, domain.FetchStats{}, assert.AnError)
+
+	scraperService := deps.scraper()
+	scraperService.suppliers = append(scraperService.suppliers, fileSource)
+
+	// Act
+	data, stats, err := scraperService.fetchVacancies(ctx, "go developer", "113")
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), domain.SourceFile)
+	assert.Zero(t, stats)
+	assert.Nil(t, data)
+}
+
+// ==================== ReprocessSession ====================
+
+func TestScraper_ReprocessSession_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	professionID := uuid.New()
+
+	vacancyData := []domain.VacancyData{
+		{ID: "1", Skills: []string{"go", "sql"}, Description: "Go developer"},
+		{ID: "2", Skills: []string{"go"}, Description: "Go developer"},
+	}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).Return(domain.Scraping{ID: sessionID}, nil)
+	deps.vacancyProvider.EXPECT().GetVacancyProfessionAreasBySession(ctx, sessionID).
+		Return([]domain.ProfessionArea{{ProfessionID: professionID, Area: "113"}}, nil)
+	deps.vacancyProvider.EXPECT().GetAllVacanciesByProfessionAndSession(ctx, professionID, sessionID, "113").Return(vacancyData, nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v2")
+	deps.extractor.EXPECT().NewMatcher(map[string]int{"go": 2}).Return(nil, nil)
+	deps.extractor.EXPECT().Match("Go developer", mock.Anything).Return(map[string]int{"go": 1}, nil)
+	// sql встречается один раз и отфильтровывается, как при обычном сборе
+	deps.skillsProvider.EXPECT().ReplaceSkills(ctx, sessionID, professionID, "113", "ngram-v2",
+		map[string]int{"go": 2}, map[string]float64{"go": 100}, map[string]int{"go": 2}, map[string]float64(nil)).Return(nil)
+
+	// Act
+	err := deps.scraper().ReprocessSession(ctx, sessionID)
+
+	// Assert
+	require.NoError(t, err)
+	deps.supplierPort.AssertNotCalled(t, "FetchDataProfession")
+}
+
+func TestScraper_ReprocessSession_AppliesAliases(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	professionID := uuid.New()
+
+	vacancyData := []domain.VacancyData{
+		{ID: "1", Skills: []string{"golang", "go", "postgres"}, Description: "Golang и Go"},
+		{ID: "2", Skills: []string{"go", "pg"}, Description: "Golang и Go"},
+	}
+	aliases := map[string]string{"golang": "go", "postgres": "postgresql", "pg": "postgresql"}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).Return(domain.Scraping{ID: sessionID}, nil)
+	deps.vacancyProvider.EXPECT().GetVacancyProfessionAreasBySession(ctx, sessionID).
+		Return([]domain.ProfessionArea{{ProfessionID: professionID, Area: "113"}}, nil)
+	deps.vacancyProvider.EXPECT().GetAllVacanciesByProfessionAndSession(ctx, professionID, sessionID, "113").Return(vacancyData, nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v2")
+	// синонимы навыков из белого списка тоже ищутся в описании
+	deps.extractor.EXPECT().NewMatcher(map[string]int{"go": 2, "golang": 2, "postgresql": 2, "postgres": 2, "pg": 2}).
+		Return(nil, nil)
+	deps.extractor.EXPECT().Match("Golang и Go", mock.Anything).Return(map[string]int{"golang": 1, "go": 1}, nil)
+	// golang и go в одной вакансии считаются одним навыком
+	deps.skillsProvider.EXPECT().ReplaceSkills(ctx, sessionID, professionID, "113", "ngram-v2",
+		map[string]int{"go": 2, "postgresql": 2}, mock.Anything, map[string]int{"go": 4}, mock.Anything).Return(nil)
+
+	// Act
+	err := deps.aliasedScraper(aliases).ReprocessSession(ctx, sessionID)
+
+	// Assert
+	require.NoError(t, err)
+	// сохранённые вакансии не меняются
+	assert.Equal(t, []string{"golang", "go", "postgres"}, vacancyData[0].Skills)
+}
+
+func TestScraper_ReprocessSession_CountsDocuments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+	deps.extractor = mocks.NewMockExtractor(t)
+
+	sessionID := uuid.New()
+	professionID := uuid.New()
+
+	vacancyData := []domain.VacancyData{
+		{ID: "1", Skills: []string{"go", "docker"}, Description: "Golang и Go, снова Go"},
+		{ID: "2", Skills: []string{"go", "docker"}, Description: "Go"},
+		{ID: "3", Skills: []string{"go"}, Description: "Docker"},
+	}
+	aliases := map[string]string{"golang": "go"}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).Return(domain.Scraping{ID: sessionID}, nil)
+	deps.vacancyProvider.EXPECT().GetVacancyProfessionAreasBySession(ctx, sessionID).
+		Return([]domain.ProfessionArea{{ProfessionID: professionID, Area: "113"}}, nil)
+	deps.vacancyProvider.EXPECT().GetAllVacanciesByProfessionAndSession(ctx, professionID, sessionID, "113").Return(vacancyData, nil)
+	deps.extractor.EXPECT().Version().Return("ngram-df-v1")
+	deps.extractor.EXPECT().CountsDocuments().Return(true)
+	deps.extractor.EXPECT().NewMatcher(mock.Anything).Return(nil, nil)
+	deps.extractor.EXPECT().Match("Golang и Go, снова Go", mock.Anything).Return(map[string]int{"golang": 1, "go": 1}, nil)
+	deps.extractor.EXPECT().Match("Go", mock.Anything).Return(map[string]int{"go": 1}, nil)
+	deps.extractor.EXPECT().Match("Docker", mock.Anything).Return(map[string]int{"docker": 1}, nil)
+	// go и его синоним golang в первой вакансии — одна вакансия с навыком go
+	deps.skillsProvider.EXPECT().ReplaceSkills(ctx, sessionID, professionID, "113", "ngram-df-v1",
+		map[string]int{"go": 3, "docker": 2}, map[string]float64{"go": 100, "docker": 66.7},
+		map[string]int{"go": 2, "docker": 1}, map[string]float64{"go": 66.7, "docker": 33.3}).Return(nil)
+
+	// Act
+	err := deps.aliasedScraper(aliases).ReprocessSession(ctx, sessionID)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestScraper_ReprocessSession_SessionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).Return(domain.Scraping{}, domain.ErrScrapingNotFound)
+
+	// Act
+	err := deps.scraper().ReprocessSession(ctx, sessionID)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrScrapingNotFound)
+}
+
+func TestScraper_ReprocessSession_PartialFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Arrange
+	deps := newDeps(t)
+
+	sessionID := uuid.New()
+	professionID1 := uuid.New()
+	professionID2 := uuid.New()
+
+	vacancyData := []domain.VacancyData{
+		{ID: "1", Skills: []string{"go"}, Description: "Go developer"},
+		{ID: "2", Skills: []string{"go"}, Description: "Go developer"},
+	}
+
+	deps.sessionProvider.EXPECT().GetScrapingByID(ctx, sessionID).Return(domain.Scraping{ID: sessionID}, nil)
+	deps.vacancyProvider.EXPECT().GetVacancyProfessionAreasBySession(ctx, sessionID).
+		Return([]domain.ProfessionArea{
+			{ProfessionID: professionID1, Area: "113"},
+			{ProfessionID: professionID2, Area: "1"},
+		}, nil)
+	deps.extractor.EXPECT().Version().Return("ngram-v1")
+
+	// Первая профессия падает на чтении вакансий, вторая обрабатывается
+	deps.vacancyProvider.EXPECT().GetAllVacanciesByProfessionAndSession(ctx, professionID1, sessionID, "113").
+		Return(nil, assert.AnError)
+	deps.vacancyProvider.EXPECT().GetAllVacanciesByProfessionAndSession(ctx, professionID2, sessionID, "1").
+		Return(vacancyData, nil)
+	deps.extractor.EXPECT().Match(mock.Anything, mock.Anything).Return(map[string]int{"go": 1}, nil)
+	deps.skillsProvider.EXPECT().ReplaceSkills(ctx, sessionID, professionID2, "1", "ngram-v1", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	// Act
+	err := deps.scraper().ReprocessSession(ctx, sessionID)
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 of 2 professions failed")
 }
@@ -0,0 +1,238 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"psa/internal/domain"
+	"psa/pkg/logger/loggerctx"
+	"psa/pkg/logger/slogx"
+)
+
+const (
+	// pollInterval is the pause before claiming again once the queue is empty.
+	pollInterval = 5 * time.Second
+
+	retryBaseDelay = time.Minute
+	retryMaxDelay  = 30 * time.Minute
+)
+
+type JobProvider interface {
+	ClaimScrapingJob(ctx context.Context, staleBefore time.Time) (domain.ScrapingJob, error)
+	CompleteScrapingJob(ctx context.Context, id uuid.UUID) error
+	RetryScrapingJob(ctx context.Context, id uuid.UUID, runAfter time.Time, errText string) error
+	ReleaseScrapingJob(ctx context.Context, id uuid.UUID, errText string) error
+	FailScrapingJob(ctx context.Context, id uuid.UUID, errText string) error
+	FailStaleScrapingJobs(ctx context.Context, staleBefore time.Time) ([]domain.ScrapingJob, error)
+}
+
+type RunFinisher interface {
+	FinishScrapingRun(ctx context.Context, id uuid.UUID, status, errText string) error
+}
+
+type SessionFinisher interface {
+	FinishQueuedScraping(ctx context.Context, sessionID uuid.UUID, runID uuid.UUID) error
+}
+
+type JobProcessor interface {
+	ProcessJob(ctx context.Context, job domain.ScrapingJob) error
+}
+
+// Worker consumes the scraping job queue. Several workers, in this process or in others, share the queue:
+// a job is claimed by one of them only. A failed job is retried with exponential backoff until it runs out
+// of attempts; the worker ending the last job of a run completes the run and sets the status of its archive session.
+type Worker struct {
+	jobs         JobProvider
+	runs         RunFinisher
+	sessions     SessionFinisher
+	processor    JobProcessor
+	concurrency  int
+	jobTimeout   time.Duration
+	leaseTimeout time.Duration
+}
+
+// New builds the worker. jobTimeout bounds a single attempt of a job. leaseTimeout is the time after which a running
+// job is considered abandoned by a crashed worker and is claimed again; it must be longer than jobTimeout, so that
+// a live worker never loses its job.
+func New(jobs JobProvider, runs RunFinisher, sessions SessionFinisher, processor JobProcessor, concurrency int,
+	jobTimeout, leaseTimeout time.Duration) *Worker {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &Worker{
+		jobs:         jobs,
+		runs:         runs,
+		sessions:     sessions,
+		processor:    processor,
+		concurrency:  concurrency,
+		jobTimeout:   jobTimeout,
+		leaseTimeout: leaseTimeout,
+	}
+}
+
+// Run processes jobs with w.concurrency goroutines until ctx is done. Once it is, no more jobs are claimed;
+// the jobs in flight are interrupted with domain.ErrWorkerStopped and returned to the queue without spending
+// an attempt.
+func (w *Worker) Run(ctx context.Context) {
+	log := loggerctx.FromContext(ctx)
+	log.Info("worker_started", "concurrency", w.concurrency)
+
+	var wg sync.WaitGroup
+	for range w.concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.loop(ctx)
+		}()
+	}
+	wg.Wait()
+
+	log.Info("worker_stopped")
+}
+
+func (w *Worker) loop(ctx context.Context) {
+	for ctx.Err() == nil {
+		if w.processNext(ctx) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// processNext claims a due job and processes it. It reports whether a job was claimed.
+func (w *Worker) processNext(ctx context.Context) bool {
+	const op = "service.worker.processNext"
+	log := loggerctx.FromContext(ctx).With("op", op)
+
+	staleBefore := time.Now().Add(-w.leaseTimeout)
+	w.failStaleJobs(ctx, staleBefore)
+
+	job, err := w.jobs.ClaimScrapingJob(ctx, staleBefore)
+	if err != nil {
+		if !errors.Is(err, domain.ErrNoScrapingJob) && ctx.Err() == nil {
+			log.Error("job_claim_failed", slogx.Err(err))
+		}
+		return false
+	}
+
+	log = log.With("job_id", job.ID, "run_id", job.RunID, "profession_id", job.ProfessionID, "area", job.Area,
+		"attempt", job.Attempts)
+	log.Info("job_started")
+
+	// the job is interrupted with domain.ErrWorkerStopped once the worker stops, so that it is not taken for failed
+	jobCtx, cancelJob := context.WithCancelCause(context.WithoutCancel(loggerctx.WithLogger(ctx, log)))
+	defer cancelJob(nil)
+	stop := context.AfterFunc(ctx, func() { cancelJob(domain.ErrWorkerStopped) })
+	defer stop()
+
+	jobCtx, cancel := context.WithTimeout(jobCtx, w.jobTimeout)
+	defer cancel()
+
+	processErr := w.process(jobCtx, job)
+	stopping := ctx.Err() != nil
+
+	// the outcome is recorded even when the worker is stopping
+	ctx = context.WithoutCancel(ctx)
+
+	switch {
+	case processErr == nil:
+		if err := w.jobs.CompleteScrapingJob(ctx, job.ID); err != nil {
+			log.Error("job_complete_failed", slogx.Err(err))
+			return true
+		}
+		log.Info("job_completed")
+	case stopping:
+		// the worker is stopping: the job goes back to the queue for another worker, keeping its attempts
+		if err := w.jobs.ReleaseScrapingJob(ctx, job.ID, processErr.Error()); err != nil {
+			log.Error("job_release_failed", slogx.Err(err))
+		}
+		log.Warn("job_released", slogx.Err(processErr))
+		return true
+	case errors.Is(processErr, domain.ErrProfessionNotFound) || job.Attempts >= job.MaxAttempts:
+		if err := w.jobs.FailScrapingJob(ctx, job.ID, processErr.Error()); err != nil {
+			log.Error("job_fail_failed", slogx.Err(err))
+			return true
+		}
+		log.Error("job_failed", slogx.Err(processErr))
+	default:
+		runAfter := time.Now().Add(backoff(job.Attempts))
+		if err := w.jobs.RetryScrapingJob(ctx, job.ID, runAfter, processErr.Error()); err != nil {
+			log.Error("job_retry_failed", slogx.Err(err))
+		}
+		log.Warn("job_retry_scheduled", "run_after", runAfter, slogx.Err(processErr))
+		return true
+	}
+
+	w.finishRun(ctx, job)
+
+	return true
+}
+
+// failStaleJobs fails the jobs abandoned by crashed workers on their last attempt, as they are never claimed again.
+func (w *Worker) failStaleJobs(ctx context.Context, staleBefore time.Time) {
+	log := loggerctx.FromContext(ctx)
+
+	jobs, err := w.jobs.FailStaleScrapingJobs(ctx, staleBefore)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error("stale_jobs_fail_failed", slogx.Err(err))
+		}
+		return
+	}
+
+	for _, job := range jobs {
+		log.Error("job_failed", "job_id", job.ID, "run_id", job.RunID, "profession_id", job.ProfessionID,
+			"area", job.Area, "attempt", job.Attempts, "error", job.LastError)
+		w.finishRun(ctx, job)
+	}
+}
+
+// finishRun finishes the run of the ended job and sets the status of its archive session. Both are finished
+// only when this was the last job of the run.
+func (w *Worker) finishRun(ctx context.Context, job domain.ScrapingJob) {
+	log := loggerctx.FromContext(ctx)
+
+	if job.SaveToDB {
+		if err := w.sessions.FinishQueuedScraping(ctx, job.SessionID, job.RunID); err != nil {
+			log.Warn("session_finish_failed", "session_id", job.SessionID, slogx.Err(err))
+		}
+	}
+	if err := w.runs.FinishScrapingRun(ctx, job.RunID, domain.ScrapingRunStatusCompleted, ""); err != nil {
+		log.Warn("scraping_run_finish_failed", "run_id", job.RunID, slogx.Err(err))
+	}
+}
+
+// process runs the job, turning a panic into an error so that one job does not bring the worker down.
+func (w *Worker) process(ctx context.Context, job domain.ScrapingJob) (err error) {
+	const op = "service.worker.process"
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			loggerctx.FromContext(ctx).Error("job_panic", "panic", rec, "stack", string(debug.Stack()))
+			err = fmt.Errorf("%s: panic: %v", op, rec)
+		}
+	}()
+
+	return w.processor.ProcessJob(ctx, job)
+}
+
+// backoff returns the delay before the next attempt: retryBaseDelay doubled with every failed attempt,
+// up to retryMaxDelay.
+func backoff(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMaxDelay)
+}
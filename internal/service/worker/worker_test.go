@@ -0,0 +1,336 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"psa/internal/domain"
+	"psa/internal/service/worker/mocks"
+)
+
+const (
+	testJobTimeout   = 45 * time.Minute
+	testLeaseTimeout = 90 * time.Minute
+)
+
+// testDeps содержит зависимости для тестирования Worker
+type testDeps struct {
+	jobs      *mocks.MockJobProvider
+	runs      *mocks.MockRunFinisher
+	sessions  *mocks.MockSessionFinisher
+	processor *mocks.MockJobProcessor
+}
+
+func newDeps(t *testing.T) testDeps {
+	t.Helper()
+	d := testDeps{
+		jobs:      mocks.NewMockJobProvider(t),
+		runs:      mocks.NewMockRunFinisher(t),
+		sessions:  mocks.NewMockSessionFinisher(t),
+		processor: mocks.NewMockJobProcessor(t),
+	}
+	d.jobs.EXPECT().FailStaleScrapingJobs(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	return d
+}
+
+func (d testDeps) worker() *Worker {
+	return New(d.jobs, d.runs, d.sessions, d.processor, 1, testJobTimeout, testLeaseTimeout)
+}
+
+func queuedJob(attempts int) domain.ScrapingJob {
+	return domain.ScrapingJob{
+		ID:           uuid.New(),
+		RunID:        uuid.New(),
+		SessionID:    uuid.New(),
+		ProfessionID: uuid.New(),
+		Area:         domain.DefaultArea,
+		SaveToDB:     true,
+		Status:       domain.ScrapingJobStatusRunning,
+		Attempts:     attempts,
+		MaxAttempts:  3,
+	}
+}
+
+func TestWorker_ProcessNext_EmptyQueue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(domain.ScrapingJob{}, domain.ErrNoScrapingJob).Once()
+
+	// Act
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert
+	assert.False(t, claimed)
+}
+
+func TestWorker_ProcessNext_FailsStaleJobsOnLastAttempt(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+	deps.jobs = mocks.NewMockJobProvider(t)
+
+	stale := queuedJob(3)
+	stale.Status = domain.ScrapingJobStatusFailed
+	deps.jobs.EXPECT().FailStaleScrapingJobs(ctx, mock.MatchedBy(func(staleBefore time.Time) bool {
+		return staleBefore.Before(time.Now().Add(-testLeaseTimeout + time.Minute))
+	})).Return([]domain.ScrapingJob{stale}, nil).Once()
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(domain.ScrapingJob{}, domain.ErrNoScrapingJob).Once()
+	deps.sessions.EXPECT().FinishQueuedScraping(ctx, stale.SessionID, stale.RunID).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(ctx, stale.RunID, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+
+	// Act
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert: брошенное задание без оставшихся попыток завершает запуск и сессию
+	assert.False(t, claimed)
+}
+
+func TestWorker_ProcessNext_Success(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	job := queuedJob(1)
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.MatchedBy(func(staleBefore time.Time) bool {
+		return staleBefore.Before(time.Now().Add(-testLeaseTimeout + time.Minute))
+	})).Return(job, nil).Once()
+	deps.processor.EXPECT().ProcessJob(mock.Anything, job).Return(nil).Once()
+	deps.jobs.EXPECT().CompleteScrapingJob(mock.Anything, job.ID).Return(nil).Once()
+	deps.sessions.EXPECT().FinishQueuedScraping(mock.Anything, job.SessionID, job.RunID).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, job.RunID, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+
+	// Act
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert
+	assert.True(t, claimed)
+}
+
+func TestWorker_ProcessNext_JobTimeout(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	job := queuedJob(1)
+	var deadline time.Time
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(job, nil).Once()
+	deps.processor.EXPECT().ProcessJob(mock.Anything, job).RunAndReturn(func(ctx context.Context, _ domain.ScrapingJob) error {
+		deadline, _ = ctx.Deadline()
+		return nil
+	}).Once()
+	deps.jobs.EXPECT().CompleteScrapingJob(mock.Anything, job.ID).Return(nil).Once()
+	deps.sessions.EXPECT().FinishQueuedScraping(mock.Anything, job.SessionID, job.RunID).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, job.RunID, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+
+	// Act
+	deps.worker().processNext(ctx)
+
+	// Assert: попытка задания ограничена переданным в New временем
+	assert.WithinDuration(t, time.Now().Add(testJobTimeout), deadline, time.Minute)
+}
+
+func TestWorker_ProcessNext_RetryWithBackoff(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	job := queuedJob(2)
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(job, nil).Once()
+	deps.processor.EXPECT().ProcessJob(mock.Anything, job).Return(errors.New("hh unavailable")).Once()
+
+	var runAfter time.Time
+	deps.jobs.EXPECT().RetryScrapingJob(mock.Anything, job.ID, mock.Anything, "hh unavailable").
+		Run(func(_ context.Context, _ uuid.UUID, at time.Time, _ string) { runAfter = at }).
+		Return(nil).Once()
+
+	start := time.Now()
+
+	// Act
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert: вторая неудачная попытка откладывает задание на 2 минуты, запуск не завершается
+	assert.True(t, claimed)
+	assert.WithinDuration(t, start.Add(2*time.Minute), runAfter, 5*time.Second)
+	deps.runs.AssertNotCalled(t, "FinishScrapingRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	deps.sessions.AssertNotCalled(t, "FinishQueuedScraping", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorker_ProcessNext_DailyJobKeepsSessions(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	job := queuedJob(1)
+	job.SaveToDB = false
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(job, nil).Once()
+	deps.processor.EXPECT().ProcessJob(mock.Anything, job).Return(nil).Once()
+	deps.jobs.EXPECT().CompleteScrapingJob(mock.Anything, job.ID).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, job.RunID, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+
+	// Act
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert: у ежедневного задания временная сессия, в базе её нет
+	assert.True(t, claimed)
+	deps.sessions.AssertNotCalled(t, "FinishQueuedScraping", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorker_ProcessNext_LastAttemptFails(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	job := queuedJob(3)
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(job, nil).Once()
+	deps.processor.EXPECT().ProcessJob(mock.Anything, job).Return(errors.New("hh unavailable")).Once()
+	deps.jobs.EXPECT().FailScrapingJob(mock.Anything, job.ID, "hh unavailable").Return(nil).Once()
+	deps.sessions.EXPECT().FinishQueuedScraping(mock.Anything, job.SessionID, job.RunID).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, job.RunID, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+
+	// Act
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert
+	assert.True(t, claimed)
+	deps.jobs.AssertNotCalled(t, "RetryScrapingJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorker_ProcessNext_ProfessionNotFoundIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	job := queuedJob(1)
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(job, nil).Once()
+	deps.processor.EXPECT().ProcessJob(mock.Anything, job).Return(domain.ErrProfessionNotFound).Once()
+	deps.jobs.EXPECT().FailScrapingJob(mock.Anything, job.ID, domain.ErrProfessionNotFound.Error()).Return(nil).Once()
+	deps.sessions.EXPECT().FinishQueuedScraping(mock.Anything, job.SessionID, job.RunID).Return(nil).Once()
+	deps.runs.EXPECT().FinishScrapingRun(mock.Anything, job.RunID, domain.ScrapingRunStatusCompleted, "").Return(nil).Once()
+
+	// Act
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert
+	assert.True(t, claimed)
+}
+
+func TestWorker_ProcessNext_PanicIsRetried(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx := context.Background()
+	deps := newDeps(t)
+
+	job := queuedJob(1)
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(job, nil).Once()
+	deps.processor.EXPECT().ProcessJob(mock.Anything, job).RunAndReturn(func(context.Context, domain.ScrapingJob) error {
+		panic("nil map")
+	}).Once()
+	deps.jobs.EXPECT().RetryScrapingJob(mock.Anything, job.ID, mock.Anything, mock.Anything).Return(nil).Once()
+
+	// Act
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert
+	assert.True(t, claimed)
+}
+
+func TestWorker_ProcessNext_StoppingReleasesJob(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	deps := newDeps(t)
+
+	job := queuedJob(3)
+	var cause error
+	deps.jobs.EXPECT().ClaimScrapingJob(ctx, mock.Anything).Return(job, nil).Once()
+	deps.processor.EXPECT().ProcessJob(mock.Anything, job).RunAndReturn(func(ctx context.Context, _ domain.ScrapingJob) error {
+		cancel()
+		<-ctx.Done()
+		cause = context.Cause(ctx)
+		return ctx.Err()
+	}).Once()
+	deps.jobs.EXPECT().ReleaseScrapingJob(mock.Anything, job.ID, context.Canceled.Error()).Return(nil).Once()
+
+	// Act: воркер останавливается во время обработки последней попытки
+	claimed := deps.worker().processNext(ctx)
+
+	// Assert: задание возвращается в очередь, а не считается проваленным, и обработчик видит причину остановки
+	assert.True(t, claimed)
+	require.ErrorIs(t, cause, domain.ErrWorkerStopped)
+	deps.jobs.AssertNotCalled(t, "FailScrapingJob", mock.Anything, mock.Anything, mock.Anything)
+	deps.jobs.AssertNotCalled(t, "RetryScrapingJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorker_Run_StopsOnCancel(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	deps := newDeps(t)
+
+	deps.jobs.EXPECT().ClaimScrapingJob(mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, time.Time) (domain.ScrapingJob, error) {
+			cancel()
+			return domain.ScrapingJob{}, domain.ErrNoScrapingJob
+		})
+
+	done := make(chan struct{})
+
+	// Act
+	go func() {
+		New(deps.jobs, deps.runs, deps.sessions, deps.processor, 3, testJobTimeout, testLeaseTimeout).Run(ctx)
+		close(done)
+	}()
+
+	// Assert
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not stop")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: time.Minute},
+		{attempts: 2, expected: 2 * time.Minute},
+		{attempts: 3, expected: 4 * time.Minute},
+		{attempts: 5, expected: 16 * time.Minute},
+		{attempts: 6, expected: 30 * time.Minute},
+		{attempts: 20, expected: 30 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, backoff(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
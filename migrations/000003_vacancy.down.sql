@@ -0,0 +1 @@
+DROP TABLE IF EXISTS vacancy CASCADE;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- Таблица сырых вакансий архивной сессии сбора
+CREATE TABLE vacancy
+(
+    external_id      VARCHAR(32) NOT NULL,
+    profession_id    UUID        NOT NULL REFERENCES profession (id) ON DELETE CASCADE,
+    scraped_at_id    UUID        NOT NULL REFERENCES scraping (id) ON DELETE CASCADE,
+    description      TEXT        NOT NULL,
+    description_hash CHAR(64)    NOT NULL,
+    key_skills       TEXT[]      NOT NULL DEFAULT '{}',
+    published_at     TIMESTAMPTZ,
+    PRIMARY KEY (scraped_at_id, profession_id, external_id)
+);
+
+CREATE INDEX idx_vacancy_external_id ON vacancy (external_id);
@@ -0,0 +1,2 @@
+ALTER TABLE skill_extracted DROP COLUMN IF EXISTS extractor_version;
+ALTER TABLE skill_formal DROP COLUMN IF EXISTS extractor_version;
@@ -0,0 +1,6 @@
+-- Версия экстрактора, которой посчитаны навыки сессии
+ALTER TABLE skill_formal
+    ADD COLUMN extractor_version VARCHAR(32) NOT NULL DEFAULT 'ngram-v1';
+
+ALTER TABLE skill_extracted
+    ADD COLUMN extractor_version VARCHAR(32) NOT NULL DEFAULT 'ngram-v1';
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS stat_salary CASCADE;
+
+ALTER TABLE vacancy
+    DROP COLUMN IF EXISTS salary_rub,
+    DROP COLUMN IF EXISTS salary_gross,
+    DROP COLUMN IF EXISTS salary_currency,
+    DROP COLUMN IF EXISTS salary_to,
+    DROP COLUMN IF EXISTS salary_from;
\ No newline at end of file
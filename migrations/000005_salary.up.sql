@@ -0,0 +1,21 @@
+-- Зарплатная вилка вакансии в исходной валюте и её оценка в рублях на момент сбора
+ALTER TABLE vacancy
+    ADD COLUMN salary_from     INTEGER,
+    ADD COLUMN salary_to       INTEGER,
+    ADD COLUMN salary_currency VARCHAR(8),
+    ADD COLUMN salary_gross    BOOLEAN,
+    ADD COLUMN salary_rub      INTEGER;
+
+-- Таблица зарплатной статистики профессии по архивной сессии (в рублях)
+CREATE TABLE stat_salary
+(
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    profession_id UUID    NOT NULL REFERENCES profession (id) ON DELETE CASCADE,
+    scraped_at_id UUID    NOT NULL REFERENCES scraping (id) ON DELETE CASCADE,
+    sample_size   INTEGER NOT NULL,
+    p25           INTEGER NOT NULL,
+    median        INTEGER NOT NULL,
+    p75           INTEGER NOT NULL
+);
+
+CREATE INDEX idx_stat_salary_scraped_profession ON stat_salary (scraped_at_id, profession_id);
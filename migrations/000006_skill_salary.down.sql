@@ -0,0 +1 @@
+DROP TABLE IF EXISTS skill_salary CASCADE;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- Таблица медианных зарплат вакансий с формальным навыком и без него по архивной сессии (в рублях)
+CREATE TABLE skill_salary
+(
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    profession_id  UUID         NOT NULL REFERENCES profession (id) ON DELETE CASCADE,
+    scraped_at_id  UUID         NOT NULL REFERENCES scraping (id) ON DELETE CASCADE,
+    skill          VARCHAR(255) NOT NULL,
+    with_count     INTEGER      NOT NULL,
+    with_median    INTEGER      NOT NULL,
+    without_count  INTEGER      NOT NULL,
+    without_median INTEGER      NOT NULL
+);
+
+CREATE INDEX idx_skill_salary_scraped_profession ON skill_salary (scraped_at_id, profession_id);
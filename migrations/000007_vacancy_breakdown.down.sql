@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS stat_breakdown CASCADE;
+
+ALTER TABLE vacancy
+    DROP COLUMN IF EXISTS schedule,
+    DROP COLUMN IF EXISTS employment,
+    DROP COLUMN IF EXISTS experience;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- Требуемый опыт, тип занятости и график работы вакансии (идентификаторы справочников hh.ru)
+ALTER TABLE vacancy
+    ADD COLUMN experience VARCHAR(32),
+    ADD COLUMN employment VARCHAR(32),
+    ADD COLUMN schedule   VARCHAR(32);
+
+-- Таблица распределения вакансий профессии по опыту, занятости и графику по архивной сессии
+CREATE TABLE stat_breakdown
+(
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    profession_id UUID        NOT NULL REFERENCES profession (id) ON DELETE CASCADE,
+    scraped_at_id UUID        NOT NULL REFERENCES scraping (id) ON DELETE CASCADE,
+    dimension     VARCHAR(16) NOT NULL,
+    value         VARCHAR(32) NOT NULL,
+    count         INTEGER     NOT NULL
+);
+
+CREATE INDEX idx_stat_breakdown_scraped_profession ON stat_breakdown (scraped_at_id, profession_id);
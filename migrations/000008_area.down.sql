@@ -0,0 +1,39 @@
+DROP INDEX IF EXISTS idx_stat_daily_profession_area_date;
+CREATE INDEX idx_stat_daily_profession_date ON stat_daily (profession_id, scraped_at);
+
+DELETE
+FROM vacancy a
+    USING vacancy b
+WHERE a.scraped_at_id = b.scraped_at_id
+  AND a.profession_id = b.profession_id
+  AND a.external_id = b.external_id
+  AND a.area > b.area;
+
+ALTER TABLE vacancy
+    DROP CONSTRAINT vacancy_pkey;
+ALTER TABLE vacancy
+    ADD PRIMARY KEY (scraped_at_id, profession_id, external_id);
+
+ALTER TABLE vacancy
+    DROP COLUMN IF EXISTS area;
+
+ALTER TABLE stat_breakdown
+    DROP COLUMN IF EXISTS area;
+
+ALTER TABLE skill_salary
+    DROP COLUMN IF EXISTS area;
+
+ALTER TABLE stat_salary
+    DROP COLUMN IF EXISTS area;
+
+ALTER TABLE skill_extracted
+    DROP COLUMN IF EXISTS area;
+
+ALTER TABLE skill_formal
+    DROP COLUMN IF EXISTS area;
+
+ALTER TABLE stat_daily
+    DROP COLUMN IF EXISTS area;
+
+ALTER TABLE stat
+    DROP COLUMN IF EXISTS area;
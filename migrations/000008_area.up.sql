@@ -0,0 +1,34 @@
+-- Регион hh.ru (идентификатор справочника areas), по которому собраны данные.
+-- Данные, собранные до появления регионов, относятся ко всей России (113)
+ALTER TABLE stat
+    ADD COLUMN area VARCHAR(16) NOT NULL DEFAULT '113';
+
+ALTER TABLE stat_daily
+    ADD COLUMN area VARCHAR(16) NOT NULL DEFAULT '113';
+
+ALTER TABLE skill_formal
+    ADD COLUMN area VARCHAR(16) NOT NULL DEFAULT '113';
+
+ALTER TABLE skill_extracted
+    ADD COLUMN area VARCHAR(16) NOT NULL DEFAULT '113';
+
+ALTER TABLE stat_salary
+    ADD COLUMN area VARCHAR(16) NOT NULL DEFAULT '113';
+
+ALTER TABLE skill_salary
+    ADD COLUMN area VARCHAR(16) NOT NULL DEFAULT '113';
+
+ALTER TABLE stat_breakdown
+    ADD COLUMN area VARCHAR(16) NOT NULL DEFAULT '113';
+
+ALTER TABLE vacancy
+    ADD COLUMN area VARCHAR(16) NOT NULL DEFAULT '113';
+
+-- Вакансии Москвы и Санкт-Петербурга попадают и в выдачу по всей России, поэтому вакансия хранится по каждому региону
+ALTER TABLE vacancy
+    DROP CONSTRAINT vacancy_pkey;
+ALTER TABLE vacancy
+    ADD PRIMARY KEY (scraped_at_id, profession_id, area, external_id);
+
+DROP INDEX IF EXISTS idx_stat_daily_profession_date;
+CREATE INDEX idx_stat_daily_profession_area_date ON stat_daily (profession_id, area, scraped_at);
@@ -0,0 +1,9 @@
+-- Справочник регионов hh.ru (страны, регионы, города), синхронизируется из /areas
+CREATE TABLE area
+(
+    id        VARCHAR(16) PRIMARY KEY,
+    parent_id VARCHAR(16),
+    name      TEXT NOT NULL
+);
+
+CREATE INDEX idx_area_parent ON area (parent_id);
@@ -0,0 +1,11 @@
+DELETE
+FROM vacancy
+WHERE source <> 'hh';
+
+ALTER TABLE vacancy
+    DROP CONSTRAINT vacancy_pkey;
+ALTER TABLE vacancy
+    ADD PRIMARY KEY (scraped_at_id, profession_id, area, external_id);
+
+ALTER TABLE vacancy
+    DROP COLUMN IF EXISTS source;
@@ -0,0 +1,9 @@
+-- Источник вакансии (площадка), из которого она получена: hh — hh.ru, file — локальная выгрузка
+ALTER TABLE vacancy
+    ADD COLUMN source VARCHAR(16) NOT NULL DEFAULT 'hh';
+
+-- Идентификаторы вакансий разных площадок могут совпадать
+ALTER TABLE vacancy
+    DROP CONSTRAINT vacancy_pkey;
+ALTER TABLE vacancy
+    ADD PRIMARY KEY (scraped_at_id, profession_id, area, source, external_id);
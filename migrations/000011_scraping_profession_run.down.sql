@@ -0,0 +1 @@
+DROP TABLE IF EXISTS scraping_profession_run CASCADE;
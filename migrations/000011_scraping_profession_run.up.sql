@@ -0,0 +1,18 @@
+-- Таблица выборки профессии по региону в каждом запуске scraping: сколько вакансий нашёл поиск, сколько ID
+-- собрано со страниц выдачи, по скольким вакансиям получены данные и сколько страниц и вакансий потеряно из-за ошибок.
+-- session_id — сессия запуска; у архивного запуска это запись scraping, у дневного — временный ID без записи
+CREATE TABLE scraping_profession_run
+(
+    id                UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    session_id        UUID        NOT NULL,
+    profession_id     UUID        NOT NULL REFERENCES profession (id) ON DELETE CASCADE,
+    area              VARCHAR(16) NOT NULL,
+    total_found       INTEGER     NOT NULL,
+    ids_collected     INTEGER     NOT NULL,
+    vacancies_fetched INTEGER     NOT NULL,
+    pages_failed      INTEGER     NOT NULL,
+    vacancies_failed  INTEGER     NOT NULL,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_scraping_profession_run_session_profession ON scraping_profession_run (session_id, profession_id, area);
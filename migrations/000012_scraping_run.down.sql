@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_scraping_profession_run_run;
+
+ALTER TABLE scraping_profession_run
+    DROP COLUMN IF EXISTS started_at,
+    DROP COLUMN IF EXISTS error,
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS run_id;
+
+DROP TABLE IF EXISTS scraping_run;
@@ -0,0 +1,25 @@
+-- Таблица запусков scraping: режим, источник запуска (cron или вручную администратором), время начала и окончания,
+-- итоговый статус и текст ошибки
+CREATE TABLE scraping_run
+(
+    id           UUID PRIMARY KEY,
+    mode         VARCHAR(32) NOT NULL,
+    trigger      VARCHAR(16) NOT NULL,
+    triggered_by UUID        REFERENCES users (id) ON DELETE SET NULL,
+    status       VARCHAR(16) NOT NULL,
+    error        TEXT        NOT NULL DEFAULT '',
+    started_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at  TIMESTAMPTZ
+);
+
+CREATE INDEX idx_scraping_run_started_at ON scraping_run (started_at DESC);
+
+-- Результат обработки профессии привязывается к запуску: статус, текст ошибки и время начала
+-- (время окончания — created_at). У записей до этой миграции запуска нет
+ALTER TABLE scraping_profession_run
+    ADD COLUMN run_id     UUID REFERENCES scraping_run (id) ON DELETE CASCADE,
+    ADD COLUMN status     VARCHAR(16) NOT NULL DEFAULT 'success',
+    ADD COLUMN error      TEXT        NOT NULL DEFAULT '',
+    ADD COLUMN started_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX idx_scraping_profession_run_run ON scraping_profession_run (run_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_scraping_job_run;
+DROP INDEX IF EXISTS idx_scraping_job_due;
+
+DROP TABLE IF EXISTS scraping_job;
@@ -0,0 +1,25 @@
+-- Очередь заданий scraping: одно задание — сбор одной профессии в одном регионе в рамках запуска.
+-- Задания забирают воркеры (SELECT ... FOR UPDATE SKIP LOCKED), неудачное задание возвращается в очередь
+-- с задержкой run_after, пока не исчерпаны попытки. locked_at — время, когда задание забрал воркер:
+-- задание, зависшее в статусе running дольше срока аренды (воркер упал), забирается повторно
+CREATE TABLE scraping_job
+(
+    id            UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    run_id        UUID        NOT NULL REFERENCES scraping_run (id) ON DELETE CASCADE,
+    session_id    UUID        NOT NULL,
+    profession_id UUID        NOT NULL REFERENCES profession (id) ON DELETE CASCADE,
+    area          VARCHAR(16) NOT NULL,
+    save_to_db    BOOLEAN     NOT NULL,
+    replace       BOOLEAN     NOT NULL DEFAULT FALSE,
+    status        VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts      INTEGER     NOT NULL DEFAULT 0,
+    max_attempts  INTEGER     NOT NULL,
+    run_after     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    locked_at     TIMESTAMPTZ,
+    last_error    TEXT        NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_scraping_job_due ON scraping_job (run_after) WHERE status = 'pending';
+CREATE INDEX idx_scraping_job_run ON scraping_job (run_id, status);
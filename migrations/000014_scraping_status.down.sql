@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_scraping_profession_run_session;
+
+ALTER TABLE scraping
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,11 @@
+-- Статус сессии scraping: running — сбор идёт (или процесс упал), complete — собраны все профессии,
+-- partial — часть профессий не собрана, failed — сбор прерван. Последней сессией считается последняя полная.
+-- Сессии до этой миграции считаются полными
+ALTER TABLE scraping
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'complete';
+
+ALTER TABLE scraping
+    ALTER COLUMN status SET DEFAULT 'running';
+
+-- Профессии, успешно собранные в сессию, — по ним продолжение сбора находит недостающие
+CREATE INDEX idx_scraping_profession_run_session ON scraping_profession_run (session_id, status);
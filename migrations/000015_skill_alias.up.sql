@@ -0,0 +1,26 @@
+-- Синонимы навыков: вариант написания (alias) перед подсчётом навыков заменяется каноническим названием (skill).
+-- Оба значения хранятся в нижнем регистре, как навыки вакансий. Канонический навык сам не может быть синонимом,
+-- поэтому цепочек синонимов не бывает
+CREATE TABLE skill_alias
+(
+    id         UUID PRIMARY KEY      DEFAULT gen_random_uuid(),
+    alias      VARCHAR(255) NOT NULL UNIQUE,
+    skill      VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CHECK (alias <> skill)
+);
+
+INSERT INTO skill_alias (alias, skill)
+VALUES ('golang', 'go'),
+       ('go lang', 'go'),
+       ('postgres', 'postgresql'),
+       ('pg', 'postgresql'),
+       ('postgre sql', 'postgresql'),
+       ('k8s', 'kubernetes'),
+       ('js', 'javascript'),
+       ('ts', 'typescript'),
+       ('react.js', 'react'),
+       ('reactjs', 'react'),
+       ('vue.js', 'vue'),
+       ('vuejs', 'vue'),
+       ('nodejs', 'node.js');
@@ -0,0 +1,6 @@
+ALTER TABLE skill_extracted
+    DROP COLUMN IF EXISTS skill_id;
+ALTER TABLE skill_formal
+    DROP COLUMN IF EXISTS skill_id;
+
+DROP TABLE IF EXISTS skill;
@@ -0,0 +1,104 @@
+-- Каталог навыков: категория навыка и необязательный родительский навык (django → python).
+-- Названия совпадают с каноническими названиями навыков в skill_formal и skill_extracted (нижний регистр)
+CREATE TABLE skill
+(
+    id        UUID PRIMARY KEY      DEFAULT gen_random_uuid(),
+    name      VARCHAR(255) NOT NULL UNIQUE,
+    category  VARCHAR(32)  NOT NULL CHECK (category IN ('language', 'framework', 'database', 'cloud', 'devops',
+                                                        'tool', 'methodology', 'soft_skill')),
+    parent_id UUID REFERENCES skill (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_skill_parent ON skill (parent_id);
+
+INSERT INTO skill (name, category)
+VALUES ('go', 'language'),
+       ('python', 'language'),
+       ('java', 'language'),
+       ('kotlin', 'language'),
+       ('javascript', 'language'),
+       ('typescript', 'language'),
+       ('php', 'language'),
+       ('c#', 'language'),
+       ('c++', 'language'),
+       ('swift', 'language'),
+       ('ruby', 'language'),
+       ('rust', 'language'),
+       ('scala', 'language'),
+       ('sql', 'language'),
+       ('bash', 'language'),
+       ('postgresql', 'database'),
+       ('mysql', 'database'),
+       ('mongodb', 'database'),
+       ('redis', 'database'),
+       ('clickhouse', 'database'),
+       ('oracle', 'database'),
+       ('elasticsearch', 'database'),
+       ('aws', 'cloud'),
+       ('azure', 'cloud'),
+       ('google cloud platform', 'cloud'),
+       ('yandex cloud', 'cloud'),
+       ('docker', 'devops'),
+       ('kubernetes', 'devops'),
+       ('ci/cd', 'devops'),
+       ('terraform', 'devops'),
+       ('ansible', 'devops'),
+       ('linux', 'devops'),
+       ('nginx', 'devops'),
+       ('prometheus', 'devops'),
+       ('grafana', 'devops'),
+       ('git', 'tool'),
+       ('jira', 'tool'),
+       ('confluence', 'tool'),
+       ('figma', 'tool'),
+       ('kafka', 'tool'),
+       ('rabbitmq', 'tool'),
+       ('agile', 'methodology'),
+       ('scrum', 'methodology'),
+       ('kanban', 'methodology'),
+       ('tdd', 'methodology'),
+       ('ооп', 'methodology'),
+       ('bpmn', 'methodology'),
+       ('uml', 'methodology'),
+       ('работа в команде', 'soft_skill'),
+       ('коммуникабельность', 'soft_skill'),
+       ('ответственность', 'soft_skill'),
+       ('аналитическое мышление', 'soft_skill'),
+       ('грамотная речь', 'soft_skill'),
+       ('английский язык', 'soft_skill');
+
+INSERT INTO skill (name, category, parent_id)
+SELECT child.name, child.category, parent.id
+FROM (VALUES ('django', 'framework', 'python'),
+             ('flask', 'framework', 'python'),
+             ('fastapi', 'framework', 'python'),
+             ('pandas', 'framework', 'python'),
+             ('spring', 'framework', 'java'),
+             ('react', 'framework', 'javascript'),
+             ('vue', 'framework', 'javascript'),
+             ('node.js', 'framework', 'javascript'),
+             ('angular', 'framework', 'typescript'),
+             ('laravel', 'framework', 'php'),
+             ('symfony', 'framework', 'php'),
+             ('.net', 'framework', 'c#'),
+             ('asp.net', 'framework', 'c#'),
+             ('gin', 'framework', 'go'),
+             ('gitlab ci', 'devops', 'ci/cd'),
+             ('jenkins', 'devops', 'ci/cd')) AS child (name, category, parent)
+         JOIN skill parent ON parent.name = child.parent;
+
+-- Навыки профессий ссылаются на каталог; навык, которого нет в каталоге, остаётся без ссылки
+ALTER TABLE skill_formal
+    ADD COLUMN skill_id UUID REFERENCES skill (id) ON DELETE SET NULL;
+ALTER TABLE skill_extracted
+    ADD COLUMN skill_id UUID REFERENCES skill (id) ON DELETE SET NULL;
+
+UPDATE skill_formal sf
+SET skill_id = s.id
+FROM skill s
+WHERE sf.skill = s.name;
+
+UPDATE skill_extracted se
+SET skill_id = s.id
+FROM skill s
+WHERE se.skill = s.name;
@@ -0,0 +1,4 @@
+ALTER TABLE skill_extracted
+    DROP COLUMN IF EXISTS percentage;
+ALTER TABLE skill_formal
+    DROP COLUMN IF EXISTS percentage;
@@ -0,0 +1,6 @@
+-- Доля вакансий сбора (в процентах), в которых встречается навык.
+-- NULL, если счётчик навыка — число упоминаний, а не вакансий, и у строк, сохранённых до появления доли
+ALTER TABLE skill_formal
+    ADD COLUMN percentage DOUBLE PRECISION;
+ALTER TABLE skill_extracted
+    ADD COLUMN percentage DOUBLE PRECISION;